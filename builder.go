@@ -0,0 +1,49 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package hi
+
+// ResourceBuilder builds a resource of a specific type. Properties are
+// specified by paths that consist of property names that are separated by
+// dots. Items of lists are specified by their zero-based index in square
+// brackets (e.g. name[0].given[1]). Elements on the path that do not exist
+// are created. An index that equals the number of items of a list appends
+// an item. Values of primitives are specified as string, bool, float64, int,
+// int64 or json.Number and values of elements and resources as struct
+// accessors.
+//
+// The first error is returned by Build. Invocations after an error has
+// occurred have no effect.
+type ResourceBuilder interface {
+	// Set sets the value at the path.
+	Set(path string, value interface{}) ResourceBuilder
+	// Add appends the value to the list at the path.
+	Add(path string, value interface{}) ResourceBuilder
+	// Build returns the resource or the first error that has occurred.
+	Build() (DynResourceAccessor, error)
+}
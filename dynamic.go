@@ -80,3 +80,21 @@ type DynPrimitiveAccessor interface {
 type DynResourceAccessor interface {
 	DynStructAccessor
 }
+
+// DynStructMutator modifies the data of a struct. All modifications are
+// checked against the type definitions. Values of primitives are specified
+// as string, bool, float64, int, int64 or json.Number. Elements and
+// resources are specified as accessors of the same FHIR version whose data
+// is used by reference.
+type DynStructMutator interface {
+	DynStructAccessor
+	SetPrimitive(name string, value interface{}) error
+	SetElement(name string, value DynStructAccessor) error
+	ClearProp(name string) error
+	AppendItem(name string, value interface{}) error
+	InsertItem(name string, index int, value interface{}) error
+	SetItem(name string, index int, value interface{}) error
+	RemoveItem(name string, index int) error
+	MoveItem(name string, source int, destination int) error
+	SetPrimitiveExtensions(name string, index int, extensions []DynElementAccessor) error
+}
@@ -33,11 +33,12 @@ go 1.18
 require (
 	github.com/healthiop/hipath v0.0.0-20230102231444-83c2f6f0e7aa
 	github.com/stretchr/testify v1.8.1
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
+	github.com/antlr/antlr4 v0.0.0-20210103211933-547fd7cc5eb0 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/shopspring/decimal v1.3.1 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,67 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package common
+
+// ConstraintSeverity is the severity of a constraint that is not satisfied.
+type ConstraintSeverity string
+
+const (
+	ErrorConstraintSeverity   ConstraintSeverity = "error"
+	WarningConstraintSeverity ConstraintSeverity = "warning"
+)
+
+// Constraint is an invariant of a type that is defined by a FHIRPath
+// expression. The expression is evaluated on each value of the type and must
+// result in true.
+type Constraint struct {
+	key        string
+	severity   ConstraintSeverity
+	human      string
+	expression string
+}
+
+func NewConstraint(key string, severity ConstraintSeverity, human string, expression string) *Constraint {
+	return &Constraint{key, severity, human, expression}
+}
+
+func (c *Constraint) Key() string {
+	return c.key
+}
+
+func (c *Constraint) Severity() ConstraintSeverity {
+	return c.severity
+}
+
+func (c *Constraint) Human() string {
+	return c.human
+}
+
+func (c *Constraint) Expression() string {
+	return c.expression
+}
@@ -32,33 +32,38 @@ import (
 	"fmt"
 	"github.com/healthiop/hi"
 	"regexp"
+	"sort"
+	"sync"
 )
 
 type TypeKind int
 type SimpleType int
 
 const (
-	Base64BinaryTypeName = "base64Binary"
-	BooleanTypeName      = "boolean"
-	CodeTypeName         = "code"
-	DateTypeName         = "date"
-	DateTimeTypeName     = "dateTime"
-	DecimalTypeName      = "decimal"
-	ElementTypeName      = "Element"
-	IDTypeName           = "id"
-	InstantTypeName      = "instant"
-	IntegerTypeName      = "integer"
-	MarkdownTypeName     = "markdown"
-	OIDTypeName          = "oid"
-	PositiveIntTypeName  = "positiveInt"
-	QuantityTypeName     = "Quantity"
-	ResourceTypeName     = "Resource"
-	StringTypeName       = "string"
-	SIDTypeName          = "sid"
-	TimeTypeName         = "time"
-	UnsignedIntTypeName  = "unsignedInt"
-	URITypeName          = "uri"
-	UUIDTypeName         = "uuid"
+	BackboneElementTypeName = "BackboneElement"
+	Base64BinaryTypeName    = "base64Binary"
+	BooleanTypeName         = "boolean"
+	CodeTypeName            = "code"
+	DateTypeName            = "date"
+	DateTimeTypeName        = "dateTime"
+	DecimalTypeName         = "decimal"
+	DomainResourceTypeName  = "DomainResource"
+	ElementTypeName         = "Element"
+	ExtensionTypeName       = "Extension"
+	IDTypeName              = "id"
+	InstantTypeName         = "instant"
+	IntegerTypeName         = "integer"
+	MarkdownTypeName        = "markdown"
+	OIDTypeName             = "oid"
+	PositiveIntTypeName     = "positiveInt"
+	QuantityTypeName        = "Quantity"
+	ResourceTypeName        = "Resource"
+	StringTypeName          = "string"
+	SIDTypeName             = "sid"
+	TimeTypeName            = "time"
+	UnsignedIntTypeName     = "unsignedInt"
+	URITypeName             = "uri"
+	UUIDTypeName            = "uuid"
 
 	StringSimpleType SimpleType = SimpleType(hi.StringSimpleType)
 	NumberSimpleType SimpleType = SimpleType(hi.NumberSimpleType)
@@ -71,10 +76,12 @@ const (
 )
 
 type TypeDefContainer struct {
-	symbolicVersion string
-	versionString   string
-	typeDefs        map[string]TypeDefAccessor
-	elementTypeDef  StructTypeDefAccessor
+	lock             sync.RWMutex
+	symbolicVersion  string
+	versionString    string
+	typeDefs         map[string]TypeDefAccessor
+	elementTypeDef   StructTypeDefAccessor
+	renamedTypeNames map[string][]string
 }
 
 type typeDef struct {
@@ -93,8 +100,10 @@ type TypeDefAccessor interface {
 
 type primitiveTypeDef struct {
 	typeDef
-	pattern    *regexp.Regexp
-	simpleType SimpleType
+	patternSource string
+	patternOnce   sync.Once
+	pattern       *regexp.Regexp
+	simpleType    SimpleType
 }
 
 type PrimitiveTypeDefAccessor interface {
@@ -107,25 +116,32 @@ type structTypeDef struct {
 	typeDef
 	typeKind    TypeKind
 	anonymous   bool
+	propsLoader func() ([]*PropDef, []*Constraint)
+	propsOnce   sync.Once
 	props       []*PropDef
 	propsByName map[string]*PropDef
 	choiceNames map[string]interface{}
+	constraints []*Constraint
 }
 
 type StructTypeDefAccessor interface {
 	TypeDefAccessor
 	PropByName(name string) *PropDef
+	Props() []*PropDef
+	Constraints() []*Constraint
 }
 
 type InitializableStructTypeDefAccessor interface {
 	StructTypeDefAccessor
 	InitProps(props []*PropDef)
+	InitConstraints(constraints []*Constraint)
 }
 
 type PropDef struct {
 	name    string
 	typeDef TypeDefAccessor
 	choice  string
+	min     int
 	array   bool
 	enum    []string
 }
@@ -135,7 +151,35 @@ func NewTypeDefContainer(symbolicVersion string, versionString string, typeDefs
 	if !ok {
 		panic("valid element type definition must have been defined")
 	}
-	return &TypeDefContainer{symbolicVersion, versionString, typeDefs, elementTypeDef}
+	return &TypeDefContainer{
+		symbolicVersion: symbolicVersion,
+		versionString:   versionString,
+		typeDefs:        typeDefs,
+		elementTypeDef:  elementTypeDef,
+	}
+}
+
+// InitRenamedTypeNames sets the type names of other FHIR versions that have been
+// renamed in this version. The keys are the names of the other versions, the values
+// the names of the corresponding types of this version.
+func (t *TypeDefContainer) InitRenamedTypeNames(renamedTypeNames map[string][]string) {
+	if t.renamedTypeNames != nil {
+		panic(fmt.Sprintf("renamed type names of version %s have already been initialized", t.versionString))
+	}
+	t.renamedTypeNames = renamedTypeNames
+}
+
+// InitConstraints sets the constraints of the structure types of this version.
+// The keys are the internal names of the types. Constraints of base types
+// must not be repeated for derived types.
+func (t *TypeDefContainer) InitConstraints(constraints map[string][]*Constraint) {
+	for name, c := range constraints {
+		typeDef, ok := t.TypeByName(name).(InitializableStructTypeDefAccessor)
+		if !ok {
+			panic(fmt.Sprintf("constraints of version %s reference undefined structure type: %s", t.versionString, name))
+		}
+		typeDef.InitConstraints(c)
+	}
 }
 
 func (t *TypeDefContainer) SymbolicVersion() string {
@@ -151,12 +195,38 @@ func (t *TypeDefContainer) ElementType() StructTypeDefAccessor {
 }
 
 func (t *TypeDefContainer) TypeByName(name string) TypeDefAccessor {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
 	if t.typeDefs == nil {
 		return nil
 	}
 	return t.typeDefs[name]
 }
 
+// TypeNames returns the sorted names of all types of this container.
+func (t *TypeDefContainer) TypeNames() []string {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	names := make([]string, 0, len(t.typeDefs))
+	for name := range t.typeDefs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RenamedTypeNames returns the names of the types of this version that correspond
+// to the specified type name of another FHIR version. If the type has not been
+// renamed, nil is returned.
+func (t *TypeDefContainer) RenamedTypeNames(name string) []string {
+	if t.renamedTypeNames == nil {
+		return nil
+	}
+	return t.renamedTypeNames[name]
+}
+
 func (t *TypeDefContainer) MandatoryTypeByName(name string) TypeDefAccessor {
 	typeDef := t.TypeByName(name)
 	if typeDef == nil {
@@ -179,15 +249,37 @@ func (t *TypeDefContainer) MandatoryStructTypeByName(name string) StructTypeDefA
 
 func NewPrimitiveTypeDef(name string, base TypeDefAccessor, pattern *regexp.Regexp, simpleType SimpleType) PrimitiveTypeDefAccessor {
 	return &primitiveTypeDef{
-		typeDef{
+		typeDef: typeDef{
 			name,
 			base,
 		},
-		pattern,
-		simpleType,
+		pattern:    pattern,
+		simpleType: simpleType,
 	}
 }
 
+// NewLazyPrimitiveTypeDef creates a primitive type definition whose pattern
+// is compiled when it is accessed for the first time.
+func NewLazyPrimitiveTypeDef(name string, base TypeDefAccessor, pattern string, simpleType SimpleType) PrimitiveTypeDefAccessor {
+	return &primitiveTypeDef{
+		typeDef: typeDef{
+			name,
+			base,
+		},
+		patternSource: pattern,
+		simpleType:    simpleType,
+	}
+}
+
+// NewLazyStructTypeDef creates a structure type definition whose properties
+// and constraints are loaded with the specified function when they are
+// accessed for the first time.
+func NewLazyStructTypeDef(internalName string, base TypeDefAccessor, anonymous bool, propsLoader func() ([]*PropDef, []*Constraint)) StructTypeDefAccessor {
+	t := NewStructTypeDef(internalName, base, anonymous).(*structTypeDef)
+	t.propsLoader = propsLoader
+	return t
+}
+
 func NewStructTypeDef(internalName string, base TypeDefAccessor, anonymous bool) InitializableStructTypeDefAccessor {
 	var typeKind TypeKind
 	if base == nil {
@@ -248,6 +340,11 @@ func (p *primitiveTypeDef) Name() string {
 }
 
 func (p *primitiveTypeDef) Pattern() *regexp.Regexp {
+	if len(p.patternSource) > 0 {
+		p.patternOnce.Do(func() {
+			p.pattern = regexp.MustCompile(p.patternSource)
+		})
+	}
 	return p.pattern
 }
 
@@ -256,6 +353,7 @@ func (p *primitiveTypeDef) SimpleType() SimpleType {
 }
 
 func (t *structTypeDef) InitProps(props []*PropDef) {
+	t.loadProps()
 	if t.props != nil {
 		panic(fmt.Sprintf("properties of type %s have already been initialized", t.internalName))
 	}
@@ -264,6 +362,14 @@ func (t *structTypeDef) InitProps(props []*PropDef) {
 	t.choiceNames = choiceNames(props)
 }
 
+func (t *structTypeDef) InitConstraints(constraints []*Constraint) {
+	t.loadProps()
+	if t.constraints != nil {
+		panic(fmt.Sprintf("constraints of type %s have already been initialized", t.internalName))
+	}
+	t.constraints = constraints
+}
+
 func (t *structTypeDef) TypeKind() TypeKind {
 	return t.typeKind
 }
@@ -279,13 +385,41 @@ func (t *structTypeDef) Name() string {
 	return t.internalName
 }
 
+// Props returns the properties of the type including the ones of its base
+// types in the order in which they have been defined. The properties of a
+// choice are ordered like the types of the choice.
+func (t *structTypeDef) Props() []*PropDef {
+	t.loadProps()
+	return t.props
+}
+
 func (t *structTypeDef) PropByName(name string) *PropDef {
+	t.loadProps()
 	if t.propsByName == nil {
 		return nil
 	}
 	return t.propsByName[name]
 }
 
+// Constraints returns the constraints that are defined by this type. The
+// constraints of the base types are not included.
+func (t *structTypeDef) Constraints() []*Constraint {
+	t.loadProps()
+	return t.constraints
+}
+
+func (t *structTypeDef) loadProps() {
+	if t.propsLoader != nil {
+		t.propsOnce.Do(func() {
+			props, constraints := t.propsLoader()
+			t.props = props
+			t.constraints = constraints
+			t.propsByName = propsByName(props)
+			t.choiceNames = choiceNames(props)
+		})
+	}
+}
+
 func propsByName(props []*PropDef) map[string]*PropDef {
 	propsByName := make(map[string]*PropDef)
 	for _, p := range props {
@@ -307,8 +441,8 @@ func choiceNames(props []*PropDef) map[string]interface{} {
 	return choices
 }
 
-func NewPropDef(name string, typeDef TypeDefAccessor, choice string, array bool, enum []string) *PropDef {
-	return &PropDef{name, typeDef, choice, array, enum}
+func NewPropDef(name string, typeDef TypeDefAccessor, choice string, min int, array bool, enum []string) *PropDef {
+	return &PropDef{name, typeDef, choice, min, array, enum}
 }
 
 func (p *PropDef) Name() string {
@@ -323,6 +457,11 @@ func (p *PropDef) Choice() string {
 	return p.choice
 }
 
+// Min returns the minimum cardinality of the property.
+func (p *PropDef) Min() int {
+	return p.min
+}
+
 func (p *PropDef) Array() bool {
 	return p.array
 }
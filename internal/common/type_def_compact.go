@@ -0,0 +1,374 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package common
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// The compact format of type definitions consists of a header, a table of all
+// strings, a table of all types (ordered so that base types precede derived
+// types) and a section with the properties and constraints of all structure
+// types. All
+// numbers are encoded as unsigned varints. References to strings and types are
+// indexes into the tables, optional references are incremented by one (zero
+// is used for no reference). The properties of a type are only decoded when
+// they are accessed for the first time.
+
+const (
+	compactMagic         = "HITD"
+	compactFormatVersion = 3
+
+	compactPrimitiveFlag = 0b_01
+	compactAnonymousFlag = 0b_10
+	compactArrayFlag     = 0b_01
+)
+
+// MarshalCompactTypeDefs returns the compact binary representation of all
+// type definitions of the specified container. Renamed type names are not
+// included.
+func MarshalCompactTypeDefs(t *TypeDefContainer) []byte {
+	t.lock.RLock()
+	typeDefs := make([]TypeDefAccessor, 0, len(t.typeDefs))
+	for _, td := range t.typeDefs {
+		typeDefs = append(typeDefs, td)
+	}
+	t.lock.RUnlock()
+
+	sort.Slice(typeDefs, func(i, j int) bool {
+		di, dj := typeDefDepth(typeDefs[i]), typeDefDepth(typeDefs[j])
+		if di != dj {
+			return di < dj
+		}
+		return typeDefs[i].InternalName() < typeDefs[j].InternalName()
+	})
+	typeIndexes := make(map[string]int, len(typeDefs))
+	for i, td := range typeDefs {
+		typeIndexes[td.InternalName()] = i
+	}
+
+	w := &compactWriter{stringIndexes: make(map[string]int)}
+	var typeSection, propsSection bytes.Buffer
+	for _, td := range typeDefs {
+		w.uvarint(&typeSection, w.stringIndex(td.InternalName()))
+		var flags int
+		if td.TypeKind() == PrimitiveTypeKind {
+			flags |= compactPrimitiveFlag
+		}
+		if td.Anonymous() {
+			flags |= compactAnonymousFlag
+		}
+		w.uvarint(&typeSection, flags)
+		if td.Base() == nil {
+			w.uvarint(&typeSection, 0)
+		} else {
+			w.uvarint(&typeSection, typeIndexes[td.Base().InternalName()]+1)
+		}
+
+		switch v := td.(type) {
+		case PrimitiveTypeDefAccessor:
+			if v.Pattern() == nil {
+				w.uvarint(&typeSection, 0)
+			} else {
+				w.uvarint(&typeSection, w.stringIndex(v.Pattern().String())+1)
+			}
+			w.uvarint(&typeSection, int(v.SimpleType()))
+		case StructTypeDefAccessor:
+			w.uvarint(&typeSection, propsSection.Len())
+			props := v.Props()
+			w.uvarint(&propsSection, len(props))
+			for _, p := range props {
+				w.uvarint(&propsSection, w.stringIndex(p.Name()))
+				w.uvarint(&propsSection, typeIndexes[p.Type().InternalName()])
+				if len(p.Choice()) == 0 {
+					w.uvarint(&propsSection, 0)
+				} else {
+					w.uvarint(&propsSection, w.stringIndex(p.Choice())+1)
+				}
+				w.uvarint(&propsSection, p.Min())
+				if p.Array() {
+					w.uvarint(&propsSection, compactArrayFlag)
+				} else {
+					w.uvarint(&propsSection, 0)
+				}
+				w.uvarint(&propsSection, len(p.Enum()))
+				for _, code := range p.Enum() {
+					w.uvarint(&propsSection, w.stringIndex(code))
+				}
+			}
+			constraints := v.Constraints()
+			w.uvarint(&propsSection, len(constraints))
+			for _, c := range constraints {
+				w.uvarint(&propsSection, w.stringIndex(c.Key()))
+				w.uvarint(&propsSection, w.stringIndex(string(c.Severity())))
+				w.uvarint(&propsSection, w.stringIndex(c.Human()))
+				w.uvarint(&propsSection, w.stringIndex(c.Expression()))
+			}
+		}
+	}
+
+	var out bytes.Buffer
+	out.WriteString(compactMagic)
+	w.uvarint(&out, compactFormatVersion)
+	w.string(&out, t.symbolicVersion)
+	w.string(&out, t.versionString)
+	w.uvarint(&out, len(w.strings))
+	for _, s := range w.strings {
+		w.string(&out, s)
+	}
+	w.uvarint(&out, len(typeDefs))
+	out.Write(typeSection.Bytes())
+	w.uvarint(&out, propsSection.Len())
+	out.Write(propsSection.Bytes())
+	return out.Bytes()
+}
+
+// NewCompactTypeDefContainer creates a type definition container from the
+// compact binary representation that has been created by
+// MarshalCompactTypeDefs. The specified data must not be modified afterwards
+// since the properties of the structure types are decoded lazily.
+func NewCompactTypeDefContainer(data []byte) (*TypeDefContainer, error) {
+	if !bytes.HasPrefix(data, []byte(compactMagic)) {
+		return nil, fmt.Errorf("data contains no compact type definitions")
+	}
+	r := &compactReader{data: data, pos: len(compactMagic)}
+	if v := r.uvarint(); v != compactFormatVersion {
+		return nil, fmt.Errorf("unsupported format version of compact type definitions: %d", v)
+	}
+	symbolicVersion := r.string()
+	versionString := r.string()
+
+	stringCount := r.uvarint()
+	if r.err == nil && stringCount > len(data) {
+		return nil, fmt.Errorf("invalid string count in compact type definitions: %d", stringCount)
+	}
+	strings := make([]string, 0, stringCount)
+	for i := 0; i < stringCount && r.err == nil; i++ {
+		strings = append(strings, r.string())
+	}
+	r.strings = strings
+
+	typeCount := r.uvarint()
+	if r.err == nil && typeCount > len(data) {
+		return nil, fmt.Errorf("invalid type count in compact type definitions: %d", typeCount)
+	}
+	typeDefs := make([]TypeDefAccessor, 0, typeCount)
+	propsOffsets := make([]int, 0, typeCount)
+	for i := 0; i < typeCount && r.err == nil; i++ {
+		name := r.stringRef()
+		flags := r.uvarint()
+		var base TypeDefAccessor
+		if baseRef := r.uvarint(); baseRef > 0 {
+			if baseRef > len(typeDefs) {
+				r.fail("base type of %s has not been defined before", name)
+				break
+			}
+			base = typeDefs[baseRef-1]
+		}
+
+		if flags&compactPrimitiveFlag != 0 {
+			var pattern string
+			if patternRef := r.uvarint(); patternRef > 0 {
+				pattern = r.stringByIndex(patternRef - 1)
+			}
+			simpleType := SimpleType(r.uvarint())
+			typeDefs = append(typeDefs, NewLazyPrimitiveTypeDef(name, base, pattern, simpleType))
+			propsOffsets = append(propsOffsets, -1)
+		} else {
+			if base == nil && name != ElementTypeName && name != ResourceTypeName {
+				r.fail("not a root type: %s", name)
+				break
+			}
+			offset := r.uvarint()
+			typeDefs = append(typeDefs, NewLazyStructTypeDef(name, base, flags&compactAnonymousFlag != 0,
+				newCompactPropsLoader(r, &typeDefs, offset)))
+			propsOffsets = append(propsOffsets, offset)
+		}
+	}
+	propsLength := r.uvarint()
+	if r.err != nil {
+		return nil, r.err
+	}
+	if propsLength != len(data)-r.pos {
+		return nil, fmt.Errorf("invalid length of properties in compact type definitions: %d", propsLength)
+	}
+	r.propsStart = r.pos
+	for _, offset := range propsOffsets {
+		if offset >= propsLength {
+			return nil, fmt.Errorf("invalid offset of properties in compact type definitions: %d", offset)
+		}
+	}
+
+	typeDefsByName := make(map[string]TypeDefAccessor, len(typeDefs))
+	for _, td := range typeDefs {
+		typeDefsByName[td.InternalName()] = td
+	}
+	if _, ok := typeDefsByName[ElementTypeName].(StructTypeDefAccessor); !ok {
+		return nil, fmt.Errorf("compact type definitions contain no element type")
+	}
+	return NewTypeDefContainer(symbolicVersion, versionString, typeDefsByName), nil
+}
+
+func newCompactPropsLoader(r *compactReader, typeDefs *[]TypeDefAccessor, offset int) func() ([]*PropDef, []*Constraint) {
+	return func() ([]*PropDef, []*Constraint) {
+		pr := &compactReader{data: r.data, pos: r.propsStart + offset, strings: r.strings}
+		count := pr.uvarint()
+		props := make([]*PropDef, 0, count)
+		for i := 0; i < count && pr.err == nil; i++ {
+			name := pr.stringRef()
+			typeIndex := pr.uvarint()
+			var choice string
+			if choiceRef := pr.uvarint(); choiceRef > 0 {
+				choice = pr.stringByIndex(choiceRef - 1)
+			}
+			min := pr.uvarint()
+			array := pr.uvarint()&compactArrayFlag != 0
+			var enum []string
+			if enumCount := pr.uvarint(); enumCount > 0 && enumCount <= len(pr.data) {
+				enum = make([]string, 0, enumCount)
+				for j := 0; j < enumCount; j++ {
+					enum = append(enum, pr.stringRef())
+				}
+			}
+			if typeIndex >= len(*typeDefs) {
+				pr.fail("invalid type of property %s", name)
+				break
+			}
+			props = append(props, NewPropDef(name, (*typeDefs)[typeIndex], choice, min, array, enum))
+		}
+		var constraints []*Constraint
+		if count := pr.uvarint(); count > 0 && count <= len(pr.data) {
+			constraints = make([]*Constraint, 0, count)
+			for i := 0; i < count && pr.err == nil; i++ {
+				key := pr.stringRef()
+				severity := ConstraintSeverity(pr.stringRef())
+				human := pr.stringRef()
+				constraints = append(constraints, NewConstraint(key, severity, human, pr.stringRef()))
+			}
+		}
+		if pr.err != nil {
+			panic(fmt.Sprintf("invalid properties in compact type definitions: %v", pr.err))
+		}
+		return props, constraints
+	}
+}
+
+func typeDefDepth(td TypeDefAccessor) int {
+	depth := 0
+	for b := td.Base(); b != nil; b = b.Base() {
+		depth++
+	}
+	return depth
+}
+
+type compactWriter struct {
+	strings       []string
+	stringIndexes map[string]int
+	buf           [binary.MaxVarintLen64]byte
+}
+
+func (w *compactWriter) stringIndex(s string) int {
+	if i, found := w.stringIndexes[s]; found {
+		return i
+	}
+	i := len(w.strings)
+	w.strings = append(w.strings, s)
+	w.stringIndexes[s] = i
+	return i
+}
+
+func (w *compactWriter) uvarint(b *bytes.Buffer, v int) {
+	n := binary.PutUvarint(w.buf[:], uint64(v))
+	b.Write(w.buf[:n])
+}
+
+func (w *compactWriter) string(b *bytes.Buffer, s string) {
+	w.uvarint(b, len(s))
+	b.WriteString(s)
+}
+
+type compactReader struct {
+	data       []byte
+	pos        int
+	strings    []string
+	propsStart int
+	err        error
+}
+
+func (r *compactReader) fail(format string, a ...interface{}) {
+	if r.err == nil {
+		r.err = fmt.Errorf(format, a...)
+	}
+}
+
+func (r *compactReader) uvarint() int {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.data[r.pos:])
+	if n <= 0 || v > math.MaxInt32 {
+		r.fail("invalid number at position %d", r.pos)
+		return 0
+	}
+	r.pos += n
+	return int(v)
+}
+
+func (r *compactReader) string() string {
+	l := r.uvarint()
+	if r.err != nil {
+		return ""
+	}
+	if l > len(r.data)-r.pos {
+		r.fail("invalid string length at position %d", r.pos)
+		return ""
+	}
+	s := string(r.data[r.pos : r.pos+l])
+	r.pos += l
+	return s
+}
+
+func (r *compactReader) stringRef() string {
+	return r.stringByIndex(r.uvarint())
+}
+
+func (r *compactReader) stringByIndex(i int) string {
+	if r.err != nil {
+		return ""
+	}
+	if i >= len(r.strings) {
+		r.fail("invalid string reference %d", i)
+		return ""
+	}
+	return r.strings[i]
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package common
+
+import (
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+)
+
+func newCompactTestTypeDefContainer() *TypeDefContainer {
+	elementTypeDef := NewStructTypeDef(ElementTypeName, nil, false)
+	stringTypeDef := NewPrimitiveTypeDef(StringTypeName, elementTypeDef, regexp.MustCompile("^\\S+$"), StringSimpleType)
+	booleanTypeDef := NewPrimitiveTypeDef(BooleanTypeName, elementTypeDef, nil, BoolSimpleType)
+	extensionTypeDef := NewStructTypeDef("Extension", elementTypeDef, false)
+	resourceTypeDef := NewStructTypeDef(ResourceTypeName, nil, false)
+	patientTypeDef := NewStructTypeDef("Patient", resourceTypeDef, false)
+	contactTypeDef := NewStructTypeDef("Patient_Contact", elementTypeDef, true)
+
+	elementTypeDef.InitProps([]*PropDef{
+		NewPropDef("id", stringTypeDef, "", 0, false, nil),
+		NewPropDef("extension", extensionTypeDef, "", 0, true, nil),
+	})
+	extensionTypeDef.InitProps([]*PropDef{
+		NewPropDef("id", stringTypeDef, "", 0, false, nil),
+		NewPropDef("extension", extensionTypeDef, "", 0, true, nil),
+		NewPropDef("valueBoolean", booleanTypeDef, "value", 0, false, nil),
+		NewPropDef("valueString", stringTypeDef, "value", 0, false, nil),
+	})
+	resourceTypeDef.InitProps([]*PropDef{
+		NewPropDef("id", stringTypeDef, "", 0, false, nil),
+	})
+	patientTypeDef.InitProps([]*PropDef{
+		NewPropDef("id", stringTypeDef, "", 0, false, nil),
+		NewPropDef("contact", contactTypeDef, "", 0, true, nil),
+		NewPropDef("gender", stringTypeDef, "", 1, false, []string{"male", "female"}),
+	})
+	contactTypeDef.InitProps([]*PropDef{
+		NewPropDef("id", stringTypeDef, "", 0, false, nil),
+		NewPropDef("name", stringTypeDef, "", 0, false, nil),
+	})
+	contactTypeDef.InitConstraints([]*Constraint{
+		NewConstraint("pat-1", ErrorConstraintSeverity, "SHALL at least contain a contact's details", "name.exists()"),
+		NewConstraint("pat-2", WarningConstraintSeverity, "", "id.exists()"),
+	})
+
+	return NewTypeDefContainer("R1", "2.3.1", map[string]TypeDefAccessor{
+		ElementTypeName:   elementTypeDef,
+		StringTypeName:    stringTypeDef,
+		BooleanTypeName:   booleanTypeDef,
+		"Extension":       extensionTypeDef,
+		ResourceTypeName:  resourceTypeDef,
+		"Patient":         patientTypeDef,
+		"Patient_Contact": contactTypeDef,
+	})
+}
+
+func TestCompactTypeDefsRoundTrip(t *testing.T) {
+	original := newCompactTestTypeDefContainer()
+	tdc, err := NewCompactTypeDefContainer(MarshalCompactTypeDefs(original))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "R1", tdc.SymbolicVersion())
+	assert.Equal(t, "2.3.1", tdc.VersionString())
+	assert.Equal(t, original.TypeNames(), tdc.TypeNames())
+	for _, name := range original.TypeNames() {
+		o, c := original.TypeByName(name), tdc.TypeByName(name)
+		if assert.NotNil(t, c, name) {
+			assert.Equal(t, o.InternalName(), c.InternalName())
+			assert.Equal(t, o.TypeKind(), c.TypeKind())
+			assert.Equal(t, o.Anonymous(), c.Anonymous())
+			if o.Base() == nil {
+				assert.Nil(t, c.Base())
+			} else if assert.NotNil(t, c.Base()) {
+				assert.Same(t, tdc.TypeByName(o.Base().InternalName()), c.Base())
+			}
+		}
+	}
+
+	patientTypeDef := tdc.MandatoryStructTypeByName("Patient")
+	if assert.Len(t, patientTypeDef.Props(), 3) {
+		gender := patientTypeDef.PropByName("gender")
+		assert.Same(t, tdc.TypeByName(StringTypeName), gender.Type())
+		assert.Equal(t, []string{"male", "female"}, gender.Enum())
+		assert.Equal(t, 1, gender.Min())
+		contact := patientTypeDef.PropByName("contact")
+		assert.True(t, contact.Array())
+		assert.Equal(t, 0, contact.Min())
+		assert.Same(t, tdc.TypeByName("Patient_Contact"), contact.Type())
+		assert.True(t, contact.Type().Anonymous())
+	}
+	assert.Nil(t, patientTypeDef.Constraints())
+	assert.Equal(t, []*Constraint{
+		NewConstraint("pat-1", ErrorConstraintSeverity, "SHALL at least contain a contact's details", "name.exists()"),
+		NewConstraint("pat-2", WarningConstraintSeverity, "", "id.exists()"),
+	}, tdc.MandatoryStructTypeByName("Patient_Contact").Constraints())
+	valueString := tdc.MandatoryStructTypeByName("Extension").PropByName("valueString")
+	assert.Equal(t, "value", valueString.Choice())
+
+	stringTypeDef := tdc.MandatoryTypeByName(StringTypeName).(PrimitiveTypeDefAccessor)
+	if assert.NotNil(t, stringTypeDef.Pattern()) {
+		assert.Equal(t, "^\\S+$", stringTypeDef.Pattern().String())
+	}
+	assert.Equal(t, StringSimpleType, stringTypeDef.SimpleType())
+	booleanTypeDef := tdc.MandatoryTypeByName(BooleanTypeName).(PrimitiveTypeDefAccessor)
+	assert.Nil(t, booleanTypeDef.Pattern())
+	assert.Equal(t, BoolSimpleType, booleanTypeDef.SimpleType())
+}
+
+func TestCompactTypeDefsLazy(t *testing.T) {
+	tdc, err := NewCompactTypeDefContainer(MarshalCompactTypeDefs(newCompactTestTypeDefContainer()))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	patientTypeDef := tdc.TypeByName("Patient").(*structTypeDef)
+	assert.Nil(t, patientTypeDef.props, "properties must not have been loaded")
+	assert.NotNil(t, patientTypeDef.PropByName("gender"))
+	assert.Len(t, patientTypeDef.props, 3)
+	assert.Nil(t, tdc.TypeByName("Patient_Contact").(*structTypeDef).props,
+		"properties of other types must not have been loaded")
+	contactTypeDef := tdc.TypeByName("Patient_Contact").(*structTypeDef)
+	assert.Nil(t, contactTypeDef.constraints, "constraints must not have been loaded")
+	assert.Len(t, contactTypeDef.Constraints(), 2)
+
+	stringTypeDef := tdc.TypeByName(StringTypeName).(*primitiveTypeDef)
+	assert.Nil(t, stringTypeDef.pattern, "pattern must not have been compiled")
+	assert.NotNil(t, stringTypeDef.Pattern())
+}
+
+func TestCompactTypeDefsInitPropsLoaded(t *testing.T) {
+	tdc, err := NewCompactTypeDefContainer(MarshalCompactTypeDefs(newCompactTestTypeDefContainer()))
+	if !assert.NoError(t, err) {
+		return
+	}
+	patientTypeDef := tdc.TypeByName("Patient").(InitializableStructTypeDefAccessor)
+	assert.Panics(t, func() { patientTypeDef.InitProps([]*PropDef{}) })
+	contactTypeDef := tdc.TypeByName("Patient_Contact").(InitializableStructTypeDefAccessor)
+	assert.Panics(t, func() { contactTypeDef.InitConstraints([]*Constraint{}) })
+}
+
+func TestNewCompactTypeDefContainerInvalidMagic(t *testing.T) {
+	tdc, err := NewCompactTypeDefContainer([]byte("TEST"))
+	assert.Nil(t, tdc)
+	if assert.Error(t, err) {
+		assert.Equal(t, "data contains no compact type definitions", err.Error())
+	}
+}
+
+func TestNewCompactTypeDefContainerInvalidVersion(t *testing.T) {
+	tdc, err := NewCompactTypeDefContainer([]byte("HITD\x01"))
+	assert.Nil(t, tdc)
+	if assert.Error(t, err) {
+		assert.Equal(t, "unsupported format version of compact type definitions: 1", err.Error())
+	}
+}
+
+func TestNewCompactTypeDefContainerTruncated(t *testing.T) {
+	data := MarshalCompactTypeDefs(newCompactTestTypeDefContainer())
+	for _, l := range []int{5, 20, len(data) / 2, len(data) - 1} {
+		tdc, err := NewCompactTypeDefContainer(data[:l])
+		assert.Nil(t, tdc)
+		assert.Error(t, err)
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package common
+
+import "fmt"
+
+// StructTypeRegistration contains an additional structure type and the
+// properties that are defined by the type itself.
+type StructTypeRegistration struct {
+	TypeDef InitializableStructTypeDefAccessor
+	Props   []*PropDef
+}
+
+// RegisterStructType registers an additional structure type (e.g. a custom
+// resource type or a logical model) with the properties that are defined by
+// the type itself. See RegisterStructTypes.
+func (t *TypeDefContainer) RegisterStructType(typeDef InitializableStructTypeDefAccessor, props []*PropDef) error {
+	return t.RegisterStructTypes([]StructTypeRegistration{{typeDef, props}})
+}
+
+// RegisterStructTypes registers additional structure types (e.g. custom
+// resource types or logical models) at once. The base type of each type must
+// be Element, BackboneElement or DomainResource (or a type that is derived
+// from them) and must be defined by this container or by one of the
+// registrations. The properties of the base type are inherited. The types of
+// the properties must be defined by this container or by one of the
+// registrations, which allows types that reference each other. Either all
+// types are registered or none of them.
+func (t *TypeDefContainer) RegisterStructTypes(registrations []StructTypeRegistration) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	registered := make(map[string]TypeDefAccessor, len(registrations))
+	ownProps := make(map[TypeDefAccessor][]*PropDef, len(registrations))
+	for _, r := range registrations {
+		name := r.TypeDef.InternalName()
+		if len(name) == 0 {
+			return fmt.Errorf("type name must not be empty")
+		}
+		if t.typeDefs[name] != nil || registered[name] != nil {
+			return fmt.Errorf("type has already been defined: %s", name)
+		}
+		if r.TypeDef.Props() != nil {
+			return fmt.Errorf("properties of type %s have already been initialized", name)
+		}
+		registered[name] = r.TypeDef
+		ownProps[r.TypeDef] = r.Props
+	}
+
+	defined := func(typeDef TypeDefAccessor) bool {
+		name := typeDef.InternalName()
+		return t.typeDefs[name] == typeDef || registered[name] == typeDef
+	}
+	var allProps func(typeDef TypeDefAccessor) []*PropDef
+	allProps = func(typeDef TypeDefAccessor) []*PropDef {
+		if props, found := ownProps[typeDef]; found {
+			baseProps := allProps(typeDef.Base())
+			result := make([]*PropDef, 0, len(baseProps)+len(props))
+			return append(append(result, baseProps...), props...)
+		}
+		if structTypeDef, ok := typeDef.(StructTypeDefAccessor); ok {
+			return structTypeDef.Props()
+		}
+		return nil
+	}
+
+	for _, r := range registrations {
+		if err := checkStructTypeRegistration(r, allProps(r.TypeDef.Base()), defined); err != nil {
+			return err
+		}
+	}
+	for _, r := range registrations {
+		r.TypeDef.InitProps(allProps(r.TypeDef))
+	}
+	if t.typeDefs == nil {
+		t.typeDefs = make(map[string]TypeDefAccessor, len(registered))
+	}
+	for name, typeDef := range registered {
+		t.typeDefs[name] = typeDef
+	}
+	return nil
+}
+
+func checkStructTypeRegistration(r StructTypeRegistration, baseProps []*PropDef, defined func(TypeDefAccessor) bool) error {
+	name := r.TypeDef.InternalName()
+	base := r.TypeDef.Base()
+	if base == nil || !defined(base) {
+		return fmt.Errorf("base type of %s has not been defined", name)
+	}
+	if _, ok := base.(StructTypeDefAccessor); !ok || base.TypeKind() == PrimitiveTypeKind ||
+		!(base.ExtendsTypeName(ElementTypeName) || base.ExtendsTypeName(DomainResourceTypeName)) {
+		return fmt.Errorf("base type of %s must be derived from %s, %s or %s: %s",
+			name, ElementTypeName, BackboneElementTypeName, DomainResourceTypeName, base.InternalName())
+	}
+
+	propNames := make(map[string]bool, len(baseProps)+len(r.Props))
+	for _, p := range baseProps {
+		propNames[p.Name()] = true
+	}
+	for _, p := range r.Props {
+		if len(p.Name()) == 0 {
+			return fmt.Errorf("property name of type %s must not be empty", name)
+		}
+		if propNames[p.Name()] {
+			return fmt.Errorf("property %s of type %s has already been defined", p.Name(), name)
+		}
+		propNames[p.Name()] = true
+		if p.Type() == nil || !defined(p.Type()) {
+			return fmt.Errorf("type of property %s of type %s has not been defined", p.Name(), name)
+		}
+	}
+	return nil
+}
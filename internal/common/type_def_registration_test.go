@@ -0,0 +1,251 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package common
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+)
+
+func newTestTypeDefContainer() *TypeDefContainer {
+	elementTypeDef := NewStructTypeDef(ElementTypeName, nil, false)
+	stringTypeDef := NewPrimitiveTypeDef(StringTypeName, elementTypeDef, nil, StringSimpleType)
+	backboneElementTypeDef := NewStructTypeDef(BackboneElementTypeName, elementTypeDef, false)
+	resourceTypeDef := NewStructTypeDef(ResourceTypeName, nil, false)
+	domainResourceTypeDef := NewStructTypeDef(DomainResourceTypeName, resourceTypeDef, false)
+	bundleTypeDef := NewStructTypeDef("Bundle", resourceTypeDef, false)
+
+	elementTypeDef.InitProps([]*PropDef{
+		NewPropDef("id", stringTypeDef, "", 0, false, nil),
+	})
+	backboneElementTypeDef.InitProps([]*PropDef{
+		NewPropDef("id", stringTypeDef, "", 0, false, nil),
+	})
+	resourceTypeDef.InitProps([]*PropDef{
+		NewPropDef("id", stringTypeDef, "", 0, false, nil),
+	})
+	domainResourceTypeDef.InitProps([]*PropDef{
+		NewPropDef("id", stringTypeDef, "", 0, false, nil),
+		NewPropDef("contained", resourceTypeDef, "", 0, true, nil),
+	})
+	bundleTypeDef.InitProps([]*PropDef{
+		NewPropDef("id", stringTypeDef, "", 0, false, nil),
+	})
+
+	return NewTypeDefContainer("R1", "2.3.1", map[string]TypeDefAccessor{
+		ElementTypeName:         elementTypeDef,
+		StringTypeName:          stringTypeDef,
+		BackboneElementTypeName: backboneElementTypeDef,
+		ResourceTypeName:        resourceTypeDef,
+		DomainResourceTypeName:  domainResourceTypeDef,
+		"Bundle":                bundleTypeDef,
+	})
+}
+
+func TestRegisterStructTypeResource(t *testing.T) {
+	tdc := newTestTypeDefContainer()
+	td := NewStructTypeDef("Task", tdc.MandatoryTypeByName(DomainResourceTypeName), false)
+	err := tdc.RegisterStructType(td, []*PropDef{
+		NewPropDef("status", tdc.MandatoryTypeByName(StringTypeName), "", 0, false, []string{"open", "closed"}),
+	})
+	if assert.NoError(t, err) {
+		assert.Same(t, td, tdc.TypeByName("Task"))
+		assert.Equal(t, ResourceTypeKind, td.TypeKind())
+		if assert.Len(t, td.Props(), 3) {
+			assert.Equal(t, "id", td.Props()[0].Name())
+			assert.Equal(t, "contained", td.Props()[1].Name())
+			assert.Equal(t, "status", td.Props()[2].Name())
+		}
+		assert.Equal(t, []string{"open", "closed"}, td.PropByName("status").Enum())
+	}
+}
+
+func TestRegisterStructTypeLogicalModel(t *testing.T) {
+	tdc := newTestTypeDefContainer()
+	td := NewStructTypeDef("Address2", tdc.ElementType(), false)
+	err := tdc.RegisterStructType(td, []*PropDef{
+		NewPropDef("line", tdc.MandatoryTypeByName(StringTypeName), "", 0, true, nil),
+	})
+	if assert.NoError(t, err) {
+		assert.Same(t, td, tdc.TypeByName("Address2"))
+		assert.Equal(t, ElementTypeKind, td.TypeKind())
+		assert.NotNil(t, td.PropByName("id"))
+		assert.NotNil(t, td.PropByName("line"))
+	}
+}
+
+func TestRegisterStructTypesReferencingEachOther(t *testing.T) {
+	tdc := newTestTypeDefContainer()
+	taskTypeDef := NewStructTypeDef("Task", tdc.MandatoryTypeByName(DomainResourceTypeName), false)
+	stepTypeDef := NewStructTypeDef("Task_Step", tdc.MandatoryTypeByName(BackboneElementTypeName), true)
+	subTaskTypeDef := NewStructTypeDef("SubTask", taskTypeDef, false)
+	err := tdc.RegisterStructTypes([]StructTypeRegistration{
+		{subTaskTypeDef, []*PropDef{
+			NewPropDef("parent", taskTypeDef, "", 0, false, nil),
+		}},
+		{taskTypeDef, []*PropDef{
+			NewPropDef("step", stepTypeDef, "", 0, true, nil),
+		}},
+		{stepTypeDef, []*PropDef{
+			NewPropDef("step", stepTypeDef, "", 0, true, nil),
+			NewPropDef("name", tdc.MandatoryTypeByName(StringTypeName), "", 0, false, nil),
+		}},
+	})
+	if assert.NoError(t, err) {
+		assert.Same(t, taskTypeDef, tdc.TypeByName("Task"))
+		assert.Same(t, stepTypeDef, tdc.TypeByName("Task_Step"))
+		assert.Same(t, subTaskTypeDef, tdc.TypeByName("SubTask"))
+		assert.Same(t, stepTypeDef, stepTypeDef.PropByName("step").Type())
+		assert.Same(t, stepTypeDef, subTaskTypeDef.PropByName("step").Type())
+		assert.Len(t, subTaskTypeDef.Props(), 4)
+	}
+}
+
+var registerStructTypeErrorTests = []struct {
+	name    string
+	typeDef func(tdc *TypeDefContainer) InitializableStructTypeDefAccessor
+	props   func(tdc *TypeDefContainer) []*PropDef
+	message string
+}{
+	{"already defined",
+		func(tdc *TypeDefContainer) InitializableStructTypeDefAccessor {
+			return NewStructTypeDef("Bundle", tdc.MandatoryTypeByName(DomainResourceTypeName), false)
+		},
+		nil,
+		"type has already been defined: Bundle"},
+	{"empty name",
+		func(tdc *TypeDefContainer) InitializableStructTypeDefAccessor {
+			return NewStructTypeDef("", tdc.ElementType(), false)
+		},
+		nil,
+		"type name must not be empty"},
+	{"resource base",
+		func(tdc *TypeDefContainer) InitializableStructTypeDefAccessor {
+			return NewStructTypeDef("Task", tdc.MandatoryTypeByName(ResourceTypeName), false)
+		},
+		nil,
+		"base type of Task must be derived from Element, BackboneElement or DomainResource: Resource"},
+	{"primitive base",
+		func(tdc *TypeDefContainer) InitializableStructTypeDefAccessor {
+			return NewStructTypeDef("string2", tdc.MandatoryTypeByName(StringTypeName), false)
+		},
+		nil,
+		"base type of string2 must be derived from Element, BackboneElement or DomainResource: string"},
+	{"foreign base",
+		func(tdc *TypeDefContainer) InitializableStructTypeDefAccessor {
+			return NewStructTypeDef("Task", newTestTypeDefContainer().MandatoryTypeByName(DomainResourceTypeName), false)
+		},
+		nil,
+		"base type of Task has not been defined"},
+	{"inherited prop",
+		func(tdc *TypeDefContainer) InitializableStructTypeDefAccessor {
+			return NewStructTypeDef("Task", tdc.MandatoryTypeByName(DomainResourceTypeName), false)
+		},
+		func(tdc *TypeDefContainer) []*PropDef {
+			return []*PropDef{NewPropDef("id", tdc.MandatoryTypeByName(StringTypeName), "", 0, false, nil)}
+		},
+		"property id of type Task has already been defined"},
+	{"empty prop name",
+		func(tdc *TypeDefContainer) InitializableStructTypeDefAccessor {
+			return NewStructTypeDef("Task", tdc.MandatoryTypeByName(DomainResourceTypeName), false)
+		},
+		func(tdc *TypeDefContainer) []*PropDef {
+			return []*PropDef{NewPropDef("", tdc.MandatoryTypeByName(StringTypeName), "", 0, false, nil)}
+		},
+		"property name of type Task must not be empty"},
+	{"undefined prop type",
+		func(tdc *TypeDefContainer) InitializableStructTypeDefAccessor {
+			return NewStructTypeDef("Task", tdc.MandatoryTypeByName(DomainResourceTypeName), false)
+		},
+		func(tdc *TypeDefContainer) []*PropDef {
+			return []*PropDef{NewPropDef("code", NewPrimitiveTypeDef("code", tdc.ElementType(), nil, StringSimpleType), "", 0, false, nil)}
+		},
+		"type of property code of type Task has not been defined"},
+}
+
+func TestRegisterStructTypeErrors(t *testing.T) {
+	for _, tt := range registerStructTypeErrorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			tdc := newTestTypeDefContainer()
+			var props []*PropDef
+			if tt.props != nil {
+				props = tt.props(tdc)
+			}
+			err := tdc.RegisterStructType(tt.typeDef(tdc), props)
+			if assert.Error(t, err) {
+				assert.Equal(t, tt.message, err.Error())
+			}
+		})
+	}
+}
+
+func TestRegisterStructTypeInitialized(t *testing.T) {
+	tdc := newTestTypeDefContainer()
+	td := NewStructTypeDef("Task", tdc.MandatoryTypeByName(DomainResourceTypeName), false)
+	td.InitProps([]*PropDef{})
+	err := tdc.RegisterStructType(td, nil)
+	if assert.Error(t, err) {
+		assert.Equal(t, "properties of type Task have already been initialized", err.Error())
+	}
+}
+
+func TestRegisterStructTypesAtomic(t *testing.T) {
+	tdc := newTestTypeDefContainer()
+	taskTypeDef := NewStructTypeDef("Task", tdc.MandatoryTypeByName(DomainResourceTypeName), false)
+	invalidTypeDef := NewStructTypeDef("Invalid", tdc.MandatoryTypeByName(ResourceTypeName), false)
+	err := tdc.RegisterStructTypes([]StructTypeRegistration{
+		{taskTypeDef, nil},
+		{invalidTypeDef, nil},
+	})
+	assert.Error(t, err)
+	assert.Nil(t, tdc.TypeByName("Task"))
+	assert.Nil(t, tdc.TypeByName("Invalid"))
+	assert.Nil(t, taskTypeDef.Props())
+}
+
+func TestRegisterStructTypeConcurrent(t *testing.T) {
+	tdc := newTestTypeDefContainer()
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			name := fmt.Sprintf("Task%d", i)
+			td := NewStructTypeDef(name, tdc.MandatoryTypeByName(DomainResourceTypeName), false)
+			assert.NoError(t, tdc.RegisterStructType(td, nil))
+			assert.Same(t, td, tdc.TypeByName(name))
+		}(i)
+	}
+	wg.Wait()
+	for i := 0; i < 20; i++ {
+		assert.NotNil(t, tdc.TypeByName(fmt.Sprintf("Task%d", i)))
+	}
+}
@@ -56,7 +56,7 @@ func TestTypeDefContainerVersionString(t *testing.T) {
 }
 
 func TestNewTypeDefContainerTypeByNameUninitialized(t *testing.T) {
-	tdc := TypeDefContainer{"R1", "2.3.1", nil, nil}
+	tdc := TypeDefContainer{symbolicVersion: "R1", versionString: "2.3.1"}
 	assert.Nil(t, tdc.TypeByName("Element"))
 }
 
@@ -195,3 +195,58 @@ func TestNewStructTypeElementAnonymous(t *testing.T) {
 	assert.True(t, td2.Anonymous())
 	assert.Equal(t, ElementTypeKind, td2.TypeKind())
 }
+
+func TestTypeDefContainerRenamedTypeNames(t *testing.T) {
+	tdc := NewTypeDefContainer("R1", "2.3.1", map[string]TypeDefAccessor{
+		"Element": NewStructTypeDef("Element", nil, false),
+	})
+	tdc.InitRenamedTypeNames(map[string][]string{
+		"ServiceRequest": {"ProcedureRequest", "ReferralRequest"},
+	})
+	assert.Equal(t, []string{"ProcedureRequest", "ReferralRequest"}, tdc.RenamedTypeNames("ServiceRequest"))
+	assert.Nil(t, tdc.RenamedTypeNames("Patient"))
+}
+
+func TestTypeDefContainerRenamedTypeNamesUninitialized(t *testing.T) {
+	tdc := NewTypeDefContainer("R1", "2.3.1", map[string]TypeDefAccessor{
+		"Element": NewStructTypeDef("Element", nil, false),
+	})
+	assert.Nil(t, tdc.RenamedTypeNames("ServiceRequest"))
+}
+
+func TestTypeDefContainerRenamedTypeNamesInitialized(t *testing.T) {
+	tdc := NewTypeDefContainer("R1", "2.3.1", map[string]TypeDefAccessor{
+		"Element": NewStructTypeDef("Element", nil, false),
+	})
+	tdc.InitRenamedTypeNames(map[string][]string{})
+	assert.Panics(t, func() { tdc.InitRenamedTypeNames(map[string][]string{}) })
+}
+
+func TestTypeDefContainerInitConstraints(t *testing.T) {
+	td := NewStructTypeDef("Element", nil, false)
+	tdc := NewTypeDefContainer("R1", "2.3.1", map[string]TypeDefAccessor{
+		"Element": td,
+	})
+	c := NewConstraint("ele-1", ErrorConstraintSeverity, "All FHIR elements must have a @value or children",
+		"hasValue() or (children().count() > id.count())")
+	tdc.InitConstraints(map[string][]*Constraint{"Element": {c}})
+	if assert.Len(t, td.Constraints(), 1) {
+		assert.Same(t, c, td.Constraints()[0])
+		assert.Equal(t, "ele-1", c.Key())
+		assert.Equal(t, ErrorConstraintSeverity, c.Severity())
+		assert.Equal(t, "All FHIR elements must have a @value or children", c.Human())
+		assert.Equal(t, "hasValue() or (children().count() > id.count())", c.Expression())
+	}
+	assert.Panics(t, func() {
+		tdc.InitConstraints(map[string][]*Constraint{"Element": {c}})
+	})
+}
+
+func TestTypeDefContainerInitConstraintsUndefined(t *testing.T) {
+	tdc := NewTypeDefContainer("R1", "2.3.1", map[string]TypeDefAccessor{
+		"Element": NewStructTypeDef("Element", nil, false),
+	})
+	assert.Panics(t, func() {
+		tdc.InitConstraints(map[string][]*Constraint{"Test": {}})
+	})
+}
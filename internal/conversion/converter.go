@@ -0,0 +1,230 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package conversion
+
+import (
+	"fmt"
+	"github.com/healthiop/hi"
+	"github.com/healthiop/hi/internal/common"
+	"github.com/healthiop/hi/internal/dynamic"
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/healthiop/hi/internal/r5"
+	"github.com/healthiop/hi/internal/stu3"
+)
+
+type IssueKind int
+
+const (
+	// LostIssueKind marks an element that has no counterpart in the target version.
+	LostIssueKind IssueKind = iota + 1
+	// UnmappableIssueKind marks a value that cannot be represented in the target version.
+	UnmappableIssueKind
+	// AmbiguousIssueKind marks a resource type that corresponds to more than one
+	// resource type of the target version. The first of them is used.
+	AmbiguousIssueKind
+)
+
+// Issue describes an element of the source resource that has not been
+// converted. The path is a FHIRPath expression relative to the resource.
+type Issue struct {
+	Kind    IssueKind
+	Path    string
+	Message string
+}
+
+// ResourceReport contains the issues of a single converted resource. The
+// location is the FHIRPath expression of the resource inside the converted
+// root resource (e.g. Bundle.entry[0].resource).
+type ResourceReport struct {
+	Location           string
+	ResourceType       string
+	TargetResourceType string
+	Issues             []Issue
+}
+
+// Result contains the converted resource data and a report for the converted
+// root resource and each of its contained or nested resources.
+type Result struct {
+	Data    map[string]interface{}
+	Reports []*ResourceReport
+}
+
+// Converter converts resource data from one FHIR version to another. Versions
+// that are not adjacent are converted through the versions in between. A
+// conversion to the same version results in a copy of the data that contains
+// only the defined elements.
+type Converter struct {
+	source *common.TypeDefContainer
+	target *common.TypeDefContainer
+	steps  []*step
+}
+
+var versionSequence = []func() *common.TypeDefContainer{
+	stu3.TypeDefContainer,
+	r4.TypeDefContainer,
+	r5.TypeDefContainer,
+}
+
+// NewConverter returns a converter from the source to the target version.
+// An error is returned if there is no conversion between the versions.
+func NewConverter(source *common.TypeDefContainer, target *common.TypeDefContainer) (*Converter, error) {
+	sourceIndex := versionIndex(source)
+	if sourceIndex < 0 {
+		return nil, fmt.Errorf("conversion from FHIR version not supported: %s", source.SymbolicVersion())
+	}
+	targetIndex := versionIndex(target)
+	if targetIndex < 0 {
+		return nil, fmt.Errorf("conversion to FHIR version not supported: %s", target.SymbolicVersion())
+	}
+
+	if sourceIndex == targetIndex {
+		return &Converter{source, target, []*step{newStep(source, target)}}, nil
+	}
+
+	var steps []*step
+	for i := sourceIndex; i != targetIndex; {
+		var next int
+		if i < targetIndex {
+			next = i + 1
+		} else {
+			next = i - 1
+		}
+		steps = append(steps, newStep(versionSequence[i](), versionSequence[next]()))
+		i = next
+	}
+	return &Converter{source, target, steps}, nil
+}
+
+func versionIndex(c *common.TypeDefContainer) int {
+	for i, f := range versionSequence {
+		if f() == c {
+			return i
+		}
+	}
+	return -1
+}
+
+func (c *Converter) Source() *common.TypeDefContainer {
+	return c.source
+}
+
+func (c *Converter) Target() *common.TypeDefContainer {
+	return c.target
+}
+
+// Convert converts the specified resource data to the target version. The
+// specified data is not modified. An error is returned if the data contains
+// no resource type or if the resource type has no counterpart in the target
+// version.
+func (c *Converter) Convert(data map[string]interface{}) (*Result, error) {
+	if _, ok := data[resourceTypePropName].(string); !ok {
+		return nil, fmt.Errorf("data contains no resource type")
+	}
+
+	// locations of all steps are based on the resource type of the source
+	// version in order to merge the reports of the same resources
+	rootName := data[resourceTypePropName].(string)
+	result := &Result{Data: data}
+	for i, s := range c.steps {
+		converted, reports, err := s.convert(rootName, result.Data)
+		if err != nil {
+			return nil, err
+		}
+		result.Data = converted
+		if i == 0 {
+			result.Reports = reports
+		} else {
+			result.Reports = mergeReports(result.Reports, reports)
+		}
+	}
+	return result, nil
+}
+
+// ConvertResource converts the specified dynamic resource to the target
+// version. The resource must have been created for the source version.
+func (c *Converter) ConvertResource(resource hi.DynResourceAccessor) (hi.DynResourceAccessor, []*ResourceReport, error) {
+	if resource.VersionString() != c.source.VersionString() {
+		return nil, nil, fmt.Errorf("resource has FHIR version %s instead of %s",
+			resource.VersionString(), c.source.VersionString())
+	}
+	dataRetriever, ok := resource.(dynamic.DynDataRetriever)
+	if !ok {
+		return nil, nil, fmt.Errorf("resource data cannot be accessed: %T", resource)
+	}
+
+	result, err := c.Convert(dataRetriever.Data())
+	if err != nil {
+		return nil, nil, err
+	}
+	converted, err := dynamic.NewDynResource(c.target, result.Data, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return converted, result.Reports, nil
+}
+
+// Lossless returns if none of the reports contains an issue.
+func (r *Result) Lossless() bool {
+	for _, report := range r.Reports {
+		if len(report.Issues) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Report returns the report of the resource at the specified location. If
+// there is no such report, nil is returned.
+func (r *Result) Report(location string) *ResourceReport {
+	for _, report := range r.Reports {
+		if report.Location == location {
+			return report
+		}
+	}
+	return nil
+}
+
+func mergeReports(reports []*ResourceReport, additional []*ResourceReport) []*ResourceReport {
+	for _, a := range additional {
+		var existing *ResourceReport
+		for _, r := range reports {
+			if r.Location == a.Location {
+				existing = r
+				break
+			}
+		}
+		if existing == nil {
+			reports = append(reports, a)
+		} else {
+			existing.TargetResourceType = a.TargetResourceType
+			existing.Issues = append(existing.Issues, a.Issues...)
+		}
+	}
+	return reports
+}
@@ -0,0 +1,494 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package conversion
+
+import (
+	"encoding/json"
+	"github.com/healthiop/hi/internal/common"
+	"github.com/healthiop/hi/internal/dynamic"
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/healthiop/hi/internal/r5"
+	"github.com/healthiop/hi/internal/stu3"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewConverterSteps(t *testing.T) {
+	c, err := NewConverter(stu3.TypeDefContainer(), r5.TypeDefContainer())
+	if assert.NoError(t, err) && assert.Len(t, c.steps, 2) {
+		assert.Same(t, stu3.TypeDefContainer(), c.Source())
+		assert.Same(t, r5.TypeDefContainer(), c.Target())
+		assert.Same(t, r4.TypeDefContainer(), c.steps[0].target)
+		assert.Same(t, r4.TypeDefContainer(), c.steps[1].source)
+	}
+}
+
+func TestConvertMedicationStatementSTU3ToR4(t *testing.T) {
+	result := convert(t, stu3.TypeDefContainer(), r4.TypeDefContainer(), "medication_statement_stu3.json.golden")
+	assert.Equal(t, readTestData(t, "medication_statement_r4.json.golden"), result.Data)
+	assert.True(t, result.Lossless())
+	if assert.Len(t, result.Reports, 1) {
+		assert.Equal(t, "MedicationStatement", result.Reports[0].Location)
+		assert.Equal(t, "MedicationStatement", result.Reports[0].ResourceType)
+		assert.Equal(t, "MedicationStatement", result.Reports[0].TargetResourceType)
+	}
+}
+
+func TestConvertMedicationStatementR4ToSTU3(t *testing.T) {
+	result := convert(t, r4.TypeDefContainer(), stu3.TypeDefContainer(), "medication_statement_r4.json.golden")
+	assert.Equal(t, readTestData(t, "medication_statement_stu3.json.golden"), result.Data)
+	assert.True(t, result.Lossless())
+}
+
+func TestConvertMedicationStatementR4ToSTU3Unknown(t *testing.T) {
+	c, err := NewConverter(r4.TypeDefContainer(), stu3.TypeDefContainer())
+	if !assert.NoError(t, err) {
+		return
+	}
+	data := readTestData(t, "medication_statement_r4.json.golden")
+	data["status"] = "unknown"
+	result, err := c.Convert(data)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "unk", result.Data["taken"])
+		assert.NotContains(t, result.Data, "status")
+		assert.NotContains(t, result.Data, "reasonNotTaken")
+		assert.Equal(t, []Issue{
+			{UnmappableIssueKind, "MedicationStatement.status", "status unknown cannot be represented"},
+			{UnmappableIssueKind, "MedicationStatement.statusReason", "status reason can only be represented for medications that have not been taken"},
+		}, result.Reports[0].Issues)
+	}
+}
+
+func TestConvertMedicationStatementSTU3ToR5(t *testing.T) {
+	result := convert(t, stu3.TypeDefContainer(), r5.TypeDefContainer(), "medication_statement_stu3.json.golden")
+	assert.Equal(t, readTestData(t, "medication_usage_r5.json.golden"), result.Data)
+	assert.True(t, result.Lossless())
+	if assert.Len(t, result.Reports, 1) {
+		assert.Equal(t, "MedicationStatement", result.Reports[0].ResourceType)
+		assert.Equal(t, "MedicationUsage", result.Reports[0].TargetResourceType)
+	}
+}
+
+func TestConvertMedicationUsageR5ToR4(t *testing.T) {
+	result := convert(t, r5.TypeDefContainer(), r4.TypeDefContainer(), "medication_usage_r5.json.golden")
+	assert.Equal(t, readTestData(t, "medication_statement_r4.json.golden"), result.Data)
+	assert.True(t, result.Lossless())
+}
+
+func TestConvertObservationSTU3ToR4(t *testing.T) {
+	result := convert(t, stu3.TypeDefContainer(), r4.TypeDefContainer(), "observation_stu3.json.golden")
+	assert.Equal(t, readTestData(t, "observation_r4.json.golden"), result.Data)
+	if assert.Len(t, result.Reports, 1) {
+		assert.Equal(t, []Issue{
+			{LostIssueKind, "Observation.related[1]", "related observation of type replaces cannot be represented"},
+		}, result.Reports[0].Issues)
+	}
+}
+
+func TestConvertObservationR4ToSTU3(t *testing.T) {
+	result := convert(t, r4.TypeDefContainer(), stu3.TypeDefContainer(), "observation_r4.json.golden")
+	expected := readTestData(t, "observation_stu3.json.golden")
+	expected["related"] = []interface{}{
+		expected["related"].([]interface{})[0],
+		expected["related"].([]interface{})[2],
+	}
+	assert.Equal(t, expected, result.Data)
+	assert.True(t, result.Lossless())
+}
+
+func TestConvertObservationR4ToSTU3Lost(t *testing.T) {
+	c, err := NewConverter(r4.TypeDefContainer(), stu3.TypeDefContainer())
+	if !assert.NoError(t, err) {
+		return
+	}
+	data := readTestData(t, "observation_r4.json.golden")
+	data["interpretation"] = []interface{}{
+		map[string]interface{}{"text": "normal"},
+		map[string]interface{}{"text": "low"},
+	}
+	data["focus"] = []interface{}{map[string]interface{}{"reference": "Device/dev1"}}
+	data["note"] = append(data["note"].([]interface{}), map[string]interface{}{"text": "second"})
+	result, err := c.Convert(data)
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]interface{}{"text": "normal"}, result.Data["interpretation"])
+		assert.Equal(t, []Issue{
+			{LostIssueKind, "Observation.note[1]", "only the text of a single note can be represented as comment"},
+			{LostIssueKind, "Observation.focus", "element is undefined in FHIR STU3"},
+			{LostIssueKind, "Observation.interpretation[1]", "element allows only a single value"},
+		}, result.Reports[0].Issues)
+	}
+}
+
+func TestConvertEncounterR4ToR5(t *testing.T) {
+	result := convert(t, r4.TypeDefContainer(), r5.TypeDefContainer(), "encounter_r4.json.golden")
+	assert.Equal(t, readTestData(t, "encounter_r5.json.golden"), result.Data)
+	assert.True(t, result.Lossless())
+}
+
+func TestConvertEncounterR5ToR4(t *testing.T) {
+	result := convert(t, r5.TypeDefContainer(), r4.TypeDefContainer(), "encounter_r5.json.golden")
+	expected := readTestData(t, "encounter_r4.json.golden")
+	expected["statusHistory"].([]interface{})[0].(map[string]interface{})["status"] = "in-progress"
+	assert.Equal(t, expected, result.Data)
+	assert.True(t, result.Lossless())
+}
+
+func TestConvertEncounterR4ToSTU3(t *testing.T) {
+	result := convert(t, r4.TypeDefContainer(), stu3.TypeDefContainer(), "encounter_r4.json.golden")
+	assert.Equal(t, []interface{}{map[string]interface{}{"text": "headache"}}, result.Data["reason"])
+	diagnosis := result.Data["diagnosis"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"text": "chief complaint"}, diagnosis["role"])
+	assert.Equal(t, []Issue{
+		{LostIssueKind, "Encounter.reasonReference", "element is undefined in FHIR STU3"},
+	}, result.Reports[0].Issues)
+}
+
+func TestConvertBundleSTU3ToR4(t *testing.T) {
+	result := convert(t, stu3.TypeDefContainer(), r4.TypeDefContainer(), "bundle_stu3.json.golden")
+	entries := result.Data["entry"].([]interface{})
+	if assert.Len(t, entries, 3) {
+		assert.Equal(t, "ServiceRequest", entries[0].(map[string]interface{})["resource"].(map[string]interface{})["resourceType"])
+		assert.NotContains(t, entries[1].(map[string]interface{}), "resource")
+	}
+
+	if assert.Len(t, result.Reports, 3) {
+		assert.Equal(t, &ResourceReport{
+			Location:           "Bundle",
+			ResourceType:       "Bundle",
+			TargetResourceType: "Bundle",
+			Issues: []Issue{
+				{LostIssueKind, "Bundle.entry[1].resource", "resource type DataElement has no counterpart in FHIR R4"},
+			},
+		}, result.Reports[0])
+		assert.Equal(t, &ResourceReport{
+			Location:           "Bundle.entry[0].resource",
+			ResourceType:       "ProcedureRequest",
+			TargetResourceType: "ServiceRequest",
+		}, result.Reports[1])
+		assert.Equal(t, &ResourceReport{
+			Location:           "Bundle.entry[2].resource",
+			ResourceType:       "Patient",
+			TargetResourceType: "Patient",
+			Issues: []Issue{
+				{LostIssueKind, "Patient.animal", "element is undefined in FHIR R4"},
+			},
+		}, result.Reports[2])
+		assert.Same(t, result.Reports[2], result.Report("Bundle.entry[2].resource"))
+	}
+	assert.False(t, result.Lossless())
+}
+
+func TestConvertBundleSTU3ToR5(t *testing.T) {
+	result := convert(t, stu3.TypeDefContainer(), r5.TypeDefContainer(), "bundle_stu3.json.golden")
+	if assert.Len(t, result.Reports, 3) {
+		assert.Equal(t, "ProcedureRequest", result.Reports[1].ResourceType)
+		assert.Equal(t, "ServiceRequest", result.Reports[1].TargetResourceType)
+		assert.Len(t, result.Reports[0].Issues, 1)
+		assert.Len(t, result.Reports[2].Issues, 1)
+	}
+}
+
+func TestConvertRenamedReferenceSTU3ToR4(t *testing.T) {
+	c, err := NewConverter(stu3.TypeDefContainer(), r4.TypeDefContainer())
+	if !assert.NoError(t, err) {
+		return
+	}
+	result, err := c.Convert(map[string]interface{}{
+		"resourceType": "Encounter",
+		"status":       "finished",
+		"incomingReferral": []interface{}{
+			map[string]interface{}{"reference": "ReferralRequest/1"},
+			map[string]interface{}{"reference": "http://example.com/fhir/ReferralRequest/2/_history/3"},
+			map[string]interface{}{"reference": "#ref1"},
+		},
+		"subject": map[string]interface{}{"reference": "Patient/pat1"},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"reference": "ServiceRequest/1"},
+			map[string]interface{}{"reference": "http://example.com/fhir/ServiceRequest/2/_history/3"},
+			map[string]interface{}{"reference": "#ref1"},
+		}, result.Data["basedOn"])
+		assert.Equal(t, map[string]interface{}{"reference": "Patient/pat1"}, result.Data["subject"])
+		assert.True(t, result.Lossless())
+	}
+}
+
+func TestConvertRenamedReferenceNoCounterpart(t *testing.T) {
+	c, err := NewConverter(stu3.TypeDefContainer(), r4.TypeDefContainer())
+	if !assert.NoError(t, err) {
+		return
+	}
+	result, err := c.Convert(map[string]interface{}{
+		"resourceType": "Observation",
+		"status":       "final",
+		"code":         map[string]interface{}{"text": "test"},
+		"basedOn": []interface{}{
+			map[string]interface{}{"reference": "DataElement/1", "display": "Test"},
+		},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []interface{}{map[string]interface{}{"display": "Test"}}, result.Data["basedOn"])
+		assert.Equal(t, []Issue{
+			{UnmappableIssueKind, "Observation.basedOn[0].reference", "referenced resource type DataElement has no counterpart in FHIR R4"},
+		}, result.Reports[0].Issues)
+	}
+}
+
+func TestConvertRenamedReferenceR4ToSTU3Ambiguous(t *testing.T) {
+	c, err := NewConverter(r4.TypeDefContainer(), stu3.TypeDefContainer())
+	if !assert.NoError(t, err) {
+		return
+	}
+	result, err := c.Convert(map[string]interface{}{
+		"resourceType": "Encounter",
+		"status":       "finished",
+		"basedOn": []interface{}{
+			map[string]interface{}{"reference": "ServiceRequest/1", "type": "ServiceRequest"},
+		},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"reference": "ProcedureRequest/1"},
+		}, result.Data["incomingReferral"])
+		assert.Equal(t, []Issue{
+			{LostIssueKind, "Encounter.basedOn[0].type", "element is undefined in FHIR STU3"},
+			{AmbiguousIssueKind, "Encounter.basedOn[0].reference", "referenced resource type ServiceRequest corresponds to ProcedureRequest or ReferralRequest in FHIR STU3, ProcedureRequest has been used"},
+		}, result.Reports[0].Issues)
+	}
+}
+
+func TestConvertRenamedReferenceR4ToR5Type(t *testing.T) {
+	c, err := NewConverter(r4.TypeDefContainer(), r5.TypeDefContainer())
+	if !assert.NoError(t, err) {
+		return
+	}
+	result, err := c.Convert(map[string]interface{}{
+		"resourceType": "Observation",
+		"status":       "final",
+		"code":         map[string]interface{}{"text": "test"},
+		"partOf": []interface{}{
+			map[string]interface{}{"reference": "MedicationStatement/1", "type": "MedicationStatement"},
+		},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"reference": "MedicationUsage/1", "type": "MedicationUsage"},
+		}, result.Data["partOf"])
+		assert.True(t, result.Lossless())
+	}
+}
+
+func TestConvertRenamedResourceR4ToSTU3Ambiguous(t *testing.T) {
+	c, err := NewConverter(r4.TypeDefContainer(), stu3.TypeDefContainer())
+	if !assert.NoError(t, err) {
+		return
+	}
+	result, err := c.Convert(map[string]interface{}{
+		"resourceType": "ServiceRequest",
+		"status":       "active",
+		"intent":       "order",
+		"subject":      map[string]interface{}{"reference": "Patient/pat1"},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "ProcedureRequest", result.Data["resourceType"])
+		if assert.Len(t, result.Reports, 1) {
+			assert.Equal(t, "ProcedureRequest", result.Reports[0].TargetResourceType)
+			assert.Equal(t, []Issue{
+				{AmbiguousIssueKind, "ServiceRequest", "resource type ServiceRequest corresponds to ProcedureRequest or ReferralRequest in FHIR STU3, ProcedureRequest has been used"},
+			}, result.Reports[0].Issues)
+		}
+	}
+}
+
+func TestConvertRenamedBundleURLs(t *testing.T) {
+	c, err := NewConverter(stu3.TypeDefContainer(), r4.TypeDefContainer())
+	if !assert.NoError(t, err) {
+		return
+	}
+	result, err := c.Convert(map[string]interface{}{
+		"resourceType": "Bundle",
+		"type":         "transaction",
+		"entry": []interface{}{
+			map[string]interface{}{
+				"fullUrl": "http://example.com/fhir/ProcedureRequest/1",
+				"resource": map[string]interface{}{
+					"resourceType": "ProcedureRequest",
+					"id":           "1",
+					"status":       "active",
+					"intent":       "order",
+					"subject":      map[string]interface{}{"reference": "Patient/pat1"},
+				},
+				"request": map[string]interface{}{"method": "PUT", "url": "ProcedureRequest/1"},
+			},
+			map[string]interface{}{
+				"request": map[string]interface{}{"method": "GET", "url": "ReferralRequest?status=active"},
+			},
+		},
+	})
+	if assert.NoError(t, err) {
+		entries := result.Data["entry"].([]interface{})
+		if assert.Len(t, entries, 2) {
+			entry := entries[0].(map[string]interface{})
+			assert.Equal(t, "http://example.com/fhir/ServiceRequest/1", entry["fullUrl"])
+			assert.Equal(t, map[string]interface{}{"method": "PUT", "url": "ServiceRequest/1"}, entry["request"])
+			assert.Equal(t, map[string]interface{}{"method": "GET", "url": "ServiceRequest?status=active"},
+				entries[1].(map[string]interface{})["request"])
+		}
+		assert.True(t, result.Lossless())
+	}
+}
+
+func TestConvertPrimitiveExtension(t *testing.T) {
+	c, err := NewConverter(r4.TypeDefContainer(), r5.TypeDefContainer())
+	if !assert.NoError(t, err) {
+		return
+	}
+	extension := map[string]interface{}{
+		"extension": []interface{}{
+			map[string]interface{}{"url": "http://example.com/test", "valueString": "test"},
+		},
+	}
+	data := map[string]interface{}{
+		"resourceType": "Patient",
+		"name": []interface{}{
+			map[string]interface{}{
+				"given":  []interface{}{"Peter", "Oscar"},
+				"_given": []interface{}{nil, extension},
+			},
+		},
+		"_gender": extension,
+	}
+	result, err := c.Convert(data)
+	if assert.NoError(t, err) {
+		assert.Equal(t, data, result.Data)
+		assert.True(t, result.Lossless())
+	}
+}
+
+func TestConvertUnknownRootResource(t *testing.T) {
+	c, err := NewConverter(stu3.TypeDefContainer(), r4.TypeDefContainer())
+	if !assert.NoError(t, err) {
+		return
+	}
+	result, err := c.Convert(map[string]interface{}{"resourceType": "DataElement"})
+	assert.Nil(t, result)
+	if assert.Error(t, err) {
+		assert.Equal(t, "resource type DataElement has no counterpart in FHIR R4", err.Error())
+	}
+}
+
+func TestConvertNoResourceType(t *testing.T) {
+	c, err := NewConverter(stu3.TypeDefContainer(), r4.TypeDefContainer())
+	if !assert.NoError(t, err) {
+		return
+	}
+	result, err := c.Convert(map[string]interface{}{"id": "test"})
+	assert.Nil(t, result)
+	if assert.Error(t, err) {
+		assert.Equal(t, "data contains no resource type", err.Error())
+	}
+}
+
+func TestConvertSameVersion(t *testing.T) {
+	result := convert(t, r4.TypeDefContainer(), r4.TypeDefContainer(), "observation_r4.json.golden")
+	assert.Equal(t, readTestData(t, "observation_r4.json.golden"), result.Data)
+	assert.True(t, result.Lossless())
+}
+
+func TestConvertResource(t *testing.T) {
+	c, err := NewConverter(stu3.TypeDefContainer(), r4.TypeDefContainer())
+	if !assert.NoError(t, err) {
+		return
+	}
+	resource, err := dynamic.NewDynResource(stu3.TypeDefContainer(),
+		readTestData(t, "medication_statement_stu3.json.golden"), nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	converted, reports, err := c.ConvertResource(resource)
+	if assert.NoError(t, err) && assert.NotNil(t, converted) {
+		assert.Equal(t, "4.0.1", converted.VersionString())
+		assert.Equal(t, "MedicationStatement", converted.TypeName())
+		status, _ := converted.StringPropValue("status")
+		assert.Equal(t, "not-taken", status)
+		assert.Len(t, reports, 1)
+	}
+}
+
+func TestConvertResourceVersionDiffers(t *testing.T) {
+	c, err := NewConverter(stu3.TypeDefContainer(), r4.TypeDefContainer())
+	if !assert.NoError(t, err) {
+		return
+	}
+	resource, err := dynamic.NewDynResource(r4.TypeDefContainer(),
+		readTestData(t, "medication_statement_r4.json.golden"), nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	converted, reports, err := c.ConvertResource(resource)
+	assert.Nil(t, converted)
+	assert.Nil(t, reports)
+	if assert.Error(t, err) {
+		assert.Equal(t, "resource has FHIR version 4.0.1 instead of 3.0.2", err.Error())
+	}
+}
+
+func convert(t *testing.T, source, target *common.TypeDefContainer, fileName string) *Result {
+	c, err := NewConverter(source, target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := c.Convert(readTestData(t, fileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+func readTestData(t *testing.T, fileName string) map[string]interface{} {
+	var data map[string]interface{}
+	if err := json.Unmarshal(readTestFile(t, fileName), &data); err != nil {
+		t.Fatal(err)
+		return nil
+	}
+	return data
+}
+
+func readTestFile(t *testing.T, fileName string) []byte {
+	golden := filepath.Join("testdata", fileName)
+	if content, err := ioutil.ReadFile(golden); err != nil {
+		t.Fatal(err)
+		return nil
+	} else {
+		return content
+	}
+}
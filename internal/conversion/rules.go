@@ -0,0 +1,116 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package conversion
+
+import (
+	"fmt"
+	"strings"
+)
+
+// stepRules contains the rules of the conversion steps by the symbolic source
+// and target version, and by the name of the source structure type.
+var stepRules = map[string]map[string]structRule{
+	"STU3>R4": {
+		"Encounter":           stu3ToR4Encounter,
+		"Encounter_Diagnosis": stu3ToR4EncounterDiagnosis,
+		"MedicationStatement": stu3ToR4MedicationStatement,
+		"Observation":         stu3ToR4Observation,
+	},
+	"R4>STU3": {
+		"Encounter":           r4ToSTU3Encounter,
+		"Encounter_Diagnosis": r4ToSTU3EncounterDiagnosis,
+		"MedicationStatement": r4ToSTU3MedicationStatement,
+		"Observation":         r4ToSTU3Observation,
+	},
+	// MedicationStatement has been named MedicationUsage in the R5 preview
+	// release 4.2.0.
+	"R4>R5-preview": {
+		"Encounter":               r4ToR5Encounter,
+		"Encounter_StatusHistory": r4ToR5EncounterStatusHistory,
+		"MedicationStatement":     r4ToR5MedicationStatement,
+	},
+	"R5-preview>R4": {
+		"Encounter":               r5ToR4Encounter,
+		"Encounter_StatusHistory": r5ToR4EncounterStatusHistory,
+		"MedicationUsage":         r5ToR4MedicationUsage,
+	},
+}
+
+// moveEncounterReference moves a reference to an encounter. References to
+// other resources (e.g. to an episode of care) cannot be represented.
+func moveEncounterReference(c *structContext, sourceName string, targetName string) {
+	reference, _ := c.value(sourceName).(map[string]interface{})
+	if reference == nil {
+		c.move(sourceName, targetName)
+		return
+	}
+	if ref, _ := reference["reference"].(string); ref != "" && !strings.HasPrefix(ref, "Encounter/") &&
+		!strings.Contains(ref, "/Encounter/") && !strings.HasPrefix(ref, "#") && !strings.HasPrefix(ref, "urn:") {
+		c.consume(sourceName)
+		c.unmappable(sourceName, fmt.Sprintf("reference %s is no encounter", ref))
+		return
+	}
+	c.move(sourceName, targetName)
+}
+
+// moveToCodeableReferences moves the concepts and references of the specified
+// source elements to the target element of type CodeableReference.
+func moveToCodeableReferences(c *structContext, conceptName string, referenceName string, targetName string) {
+	c.consume(conceptName, referenceName)
+	for _, t := range []struct {
+		sourceName string
+		key        string
+	}{{conceptName, "concept"}, {referenceName, "reference"}} {
+		items, _ := c.value(t.sourceName).([]interface{})
+		for i, item := range items {
+			c.set(fmt.Sprintf("%s[%d]", t.sourceName, i), targetName, map[string]interface{}{t.key: item})
+		}
+	}
+}
+
+// moveFromCodeableReferences moves the concepts and references of the source
+// element of type CodeableReference to the specified target elements.
+func moveFromCodeableReferences(c *structContext, sourceName string, conceptName string, referenceName string) {
+	c.consume(sourceName)
+	items, _ := c.value(sourceName).([]interface{})
+	for i, item := range items {
+		name := fmt.Sprintf("%s[%d]", sourceName, i)
+		codeableReference, _ := item.(map[string]interface{})
+		for _, key := range sortedKeys(codeableReference) {
+			switch key {
+			case "concept":
+				c.set(name+".concept", conceptName, codeableReference[key])
+			case "reference":
+				c.set(name+".reference", referenceName, codeableReference[key])
+			default:
+				c.lost(name+"."+key, "element of codeable reference cannot be represented")
+			}
+		}
+	}
+}
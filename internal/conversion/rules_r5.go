@@ -0,0 +1,73 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package conversion
+
+// r4ToR5EncounterStatusCodes contains the encounter status codes of R4 that
+// have been replaced in the R5 preview release.
+var r4ToR5EncounterStatusCodes = map[string]string{
+	"arrived":  "in-progress",
+	"triaged":  "in-progress",
+	"onleave":  "onhold",
+	"finished": "completed",
+}
+
+// r5ToR4EncounterStatusCodes contains the encounter status codes of the R5
+// preview release that have been replaced in R4.
+var r5ToR4EncounterStatusCodes = map[string]string{
+	"onhold":    "onleave",
+	"completed": "finished",
+}
+
+func r4ToR5Encounter(c *structContext) {
+	c.moveMapped("status", "status", r4ToR5EncounterStatusCodes)
+	moveToCodeableReferences(c, "reasonCode", "reasonReference", "reason")
+}
+
+func r5ToR4Encounter(c *structContext) {
+	c.moveMapped("status", "status", r5ToR4EncounterStatusCodes)
+	moveFromCodeableReferences(c, "reason", "reasonCode", "reasonReference")
+}
+
+func r4ToR5EncounterStatusHistory(c *structContext) {
+	c.moveMapped("status", "status", r4ToR5EncounterStatusCodes)
+}
+
+func r5ToR4EncounterStatusHistory(c *structContext) {
+	c.moveMapped("status", "status", r5ToR4EncounterStatusCodes)
+}
+
+func r4ToR5MedicationStatement(c *structContext) {
+	moveEncounterReference(c, "context", "encounter")
+	moveToCodeableReferences(c, "reasonCode", "reasonReference", "reason")
+}
+
+func r5ToR4MedicationUsage(c *structContext) {
+	c.move("encounter", "context")
+	moveFromCodeableReferences(c, "reason", "reasonCode", "reasonReference")
+}
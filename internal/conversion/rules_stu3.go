@@ -0,0 +1,172 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package conversion
+
+import "fmt"
+
+// stu3ObservationRelatedTypes contains the types of related observations of
+// STU3 that have a corresponding R4 element.
+var stu3ObservationRelatedTypes = []struct {
+	code string
+	name string
+}{
+	{"has-member", "hasMember"},
+	{"derived-from", "derivedFrom"},
+}
+
+func stu3ToR4Encounter(c *structContext) {
+	c.move("reason", "reasonCode")
+	c.move("incomingReferral", "basedOn")
+}
+
+func r4ToSTU3Encounter(c *structContext) {
+	c.move("reasonCode", "reason")
+	c.move("basedOn", "incomingReferral")
+}
+
+func stu3ToR4EncounterDiagnosis(c *structContext) {
+	c.move("role", "use")
+}
+
+func r4ToSTU3EncounterDiagnosis(c *structContext) {
+	c.move("use", "role")
+}
+
+func stu3ToR4MedicationStatement(c *structContext) {
+	status := c.stringValue("status")
+	if status != "entered-in-error" {
+		switch c.stringValue("taken") {
+		case "n":
+			status = "not-taken"
+		case "unk":
+			status = "unknown"
+		}
+	}
+	c.moveMapped("status", "status", map[string]string{c.stringValue("status"): status})
+	c.consume("taken")
+	if _, found := c.source["_taken"]; found {
+		c.lost("_taken", "extensions of element taken cannot be represented")
+	}
+
+	c.move("reasonNotTaken", "statusReason")
+}
+
+func r4ToSTU3MedicationStatement(c *structContext) {
+	status := c.stringValue("status")
+	mappedStatus := status
+	var taken string
+	switch status {
+	case "":
+	case "not-taken":
+		mappedStatus, taken = "completed", "n"
+	case "unknown":
+		taken = "unk"
+	case "intended":
+		taken = "na"
+	case "entered-in-error":
+		taken = "unk"
+	default:
+		taken = "y"
+	}
+	if status == "unknown" {
+		c.consume("status")
+		c.unmappable("status", "status unknown cannot be represented")
+	} else {
+		c.moveMapped("status", "status", map[string]string{status: mappedStatus})
+	}
+	if taken != "" {
+		c.set("status", "taken", taken)
+	}
+
+	if status == "not-taken" {
+		c.move("statusReason", "reasonNotTaken")
+	} else if _, found := c.source["statusReason"]; found {
+		c.consume("statusReason")
+		c.unmappable("statusReason", "status reason can only be represented for medications that have not been taken")
+	}
+}
+
+func stu3ToR4Observation(c *structContext) {
+	c.consume("related")
+	related, _ := c.value("related").([]interface{})
+	for i, r := range related {
+		item, _ := r.(map[string]interface{})
+		name := fmt.Sprintf("related[%d]", i)
+		relatedType, _ := item["type"].(string)
+		found := false
+		for _, t := range stu3ObservationRelatedTypes {
+			if t.code == relatedType {
+				c.set(name+".target", t.name, item["target"])
+				found = true
+			}
+		}
+		if !found {
+			c.lost(name, fmt.Sprintf("related observation of type %s cannot be represented", relatedType))
+		}
+	}
+
+	moveEncounterReference(c, "context", "encounter")
+
+	c.consume("comment")
+	if comment, found := c.source["comment"]; found {
+		c.set("comment", "note", map[string]interface{}{"text": comment})
+	}
+}
+
+func r4ToSTU3Observation(c *structContext) {
+	for _, t := range stu3ObservationRelatedTypes {
+		c.consume(t.name)
+		items, _ := c.value(t.name).([]interface{})
+		for i, item := range items {
+			c.set(fmt.Sprintf("%s[%d]", t.name, i), "related", map[string]interface{}{
+				"type":   t.code,
+				"target": item,
+			})
+		}
+	}
+
+	c.move("encounter", "context")
+
+	c.consume("note")
+	notes, _ := c.value("note").([]interface{})
+	for i, n := range notes {
+		note, _ := n.(map[string]interface{})
+		name := fmt.Sprintf("note[%d]", i)
+		if i > 0 || note["text"] == nil {
+			c.lost(name, "only the text of a single note can be represented as comment")
+			continue
+		}
+		c.set(name+".text", "comment", note["text"])
+		for key := range note {
+			if key != "text" {
+				c.lost(name+"."+key, "only the text of a note can be represented as comment")
+			}
+		}
+	}
+}
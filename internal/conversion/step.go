@@ -0,0 +1,443 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package conversion
+
+import (
+	"fmt"
+	"github.com/healthiop/hi/internal/common"
+	"sort"
+	"strings"
+)
+
+const resourceTypePropName = "resourceType"
+
+// resourceTypeReferenceElements contains the names of the elements that refer
+// to resources by their resource type by the internal name of the structure
+// type that contains them.
+var resourceTypeReferenceElements = map[string][]string{
+	"Reference":      {"reference", "type"},
+	"Bundle_Entry":   {"fullUrl"},
+	"Bundle_Request": {"url"},
+}
+
+// structRule converts the elements of a structure of the source version that
+// cannot be converted by name. The rule must consume the converted elements.
+type structRule func(c *structContext)
+
+type step struct {
+	source *common.TypeDefContainer
+	target *common.TypeDefContainer
+	rules  map[string]structRule
+}
+
+type stepConversion struct {
+	*step
+	reports []*ResourceReport
+	current *ResourceReport
+}
+
+type structContext struct {
+	conversion *stepConversion
+	path       string
+	source     map[string]interface{}
+	target     map[string]interface{}
+	sourceType common.StructTypeDefAccessor
+	targetType common.StructTypeDefAccessor
+	consumed   map[string]bool
+}
+
+func newStep(source *common.TypeDefContainer, target *common.TypeDefContainer) *step {
+	return &step{source, target, stepRules[stepKey(source, target)]}
+}
+
+func stepKey(source *common.TypeDefContainer, target *common.TypeDefContainer) string {
+	return source.SymbolicVersion() + ">" + target.SymbolicVersion()
+}
+
+func (s *step) convert(rootName string, data map[string]interface{}) (map[string]interface{}, []*ResourceReport, error) {
+	resourceType := data[resourceTypePropName].(string)
+	if len(s.targetTypeNames(resourceType)) == 0 {
+		return nil, nil, s.noCounterpartError(resourceType)
+	}
+
+	sc := &stepConversion{step: s}
+	converted, _ := sc.convertResource(rootName, data)
+	return converted, sc.reports, nil
+}
+
+// targetTypeNames returns the names of the resource types of the target
+// version that correspond to the specified resource type of the source
+// version. If the resource type has been split, more than one name is
+// returned. If there is no counterpart, nil is returned.
+func (s *step) targetTypeNames(resourceType string) []string {
+	if s.target.TypeByName(resourceType) != nil {
+		return []string{resourceType}
+	}
+	return s.target.RenamedTypeNames(resourceType)
+}
+
+func (s *step) noCounterpartError(resourceType string) error {
+	return fmt.Errorf("resource type %s has no counterpart in FHIR %s",
+		resourceType, s.target.SymbolicVersion())
+}
+
+func (s *step) ambiguousMessage(resourceType string, targetTypeNames []string) string {
+	return fmt.Sprintf("resource type %s corresponds to %s in FHIR %s, %s has been used", resourceType,
+		strings.Join(targetTypeNames, " or "), s.target.SymbolicVersion(), targetTypeNames[0])
+}
+
+func (s *stepConversion) issue(kind IssueKind, path string, message string) {
+	s.current.Issues = append(s.current.Issues, Issue{kind, path, message})
+}
+
+func (s *stepConversion) convertResource(location string, data map[string]interface{}) (map[string]interface{}, bool) {
+	resourceType, ok := data[resourceTypePropName].(string)
+	if !ok {
+		s.issue(UnmappableIssueKind, location, "resource contains no resource type")
+		return nil, false
+	}
+	sourceType, ok := s.source.TypeByName(resourceType).(common.StructTypeDefAccessor)
+	if !ok || sourceType.TypeKind() != common.ResourceTypeKind {
+		s.issue(UnmappableIssueKind, location, fmt.Sprintf("resource type %s is undefined in FHIR %s",
+			resourceType, s.source.SymbolicVersion()))
+		return nil, false
+	}
+	targetTypeNames := s.targetTypeNames(resourceType)
+	if len(targetTypeNames) == 0 {
+		s.issue(LostIssueKind, location, s.noCounterpartError(resourceType).Error())
+		return nil, false
+	}
+	targetTypeName := targetTypeNames[0]
+	targetType := s.target.MandatoryStructTypeByName(targetTypeName)
+
+	parent := s.current
+	s.current = &ResourceReport{
+		Location:           location,
+		ResourceType:       resourceType,
+		TargetResourceType: targetTypeName,
+	}
+	s.reports = append(s.reports, s.current)
+	if len(targetTypeNames) > 1 {
+		s.issue(AmbiguousIssueKind, resourceType, s.ambiguousMessage(resourceType, targetTypeNames))
+	}
+	converted := s.convertStruct(resourceType, sourceType, targetType, data)
+	converted[resourceTypePropName] = targetTypeName
+	s.current = parent
+	return converted, true
+}
+
+func (s *stepConversion) convertStruct(path string, sourceType common.StructTypeDefAccessor, targetType common.StructTypeDefAccessor, data map[string]interface{}) map[string]interface{} {
+	c := &structContext{
+		conversion: s,
+		path:       path,
+		source:     data,
+		target:     make(map[string]interface{}, len(data)),
+		sourceType: sourceType,
+		targetType: targetType,
+		consumed:   make(map[string]bool),
+	}
+	if rule := s.rules[sourceType.InternalName()]; rule != nil {
+		rule(c)
+	}
+
+	for _, name := range sortedKeys(data) {
+		if name != resourceTypePropName && !c.consumed[name] {
+			c.move(strings.TrimPrefix(name, "_"), strings.TrimPrefix(name, "_"))
+		}
+	}
+	c.mapResourceTypeReferences()
+	return c.target
+}
+
+func (s *stepConversion) convertValue(path string, prop *common.PropDef, value interface{}) (interface{}, bool) {
+	items, ok := value.([]interface{})
+	if !ok {
+		converted, ok := s.convertItem(path, prop, value)
+		if ok && prop.Array() {
+			return []interface{}{converted}, true
+		}
+		return converted, ok
+	}
+
+	if !prop.Array() {
+		if len(items) == 0 {
+			return nil, false
+		}
+		for i := 1; i < len(items); i++ {
+			s.issue(LostIssueKind, indexedPath(path, i), "element allows only a single value")
+		}
+		return s.convertItem(indexedPath(path, 0), prop, items[0])
+	}
+
+	// positions of primitive values and their extensions must be kept since
+	// they are aligned with each other
+	aligned := prop.Type().TypeKind() == common.PrimitiveTypeKind || strings.HasPrefix(prop.Name(), "_")
+	converted := make([]interface{}, 0, len(items))
+	found := false
+	for i, item := range items {
+		c, ok := s.convertItem(indexedPath(path, i), prop, item)
+		if ok && c != nil {
+			converted = append(converted, c)
+			found = true
+		} else if aligned {
+			converted = append(converted, nil)
+		}
+	}
+	return converted, found
+}
+
+func (s *stepConversion) convertItem(path string, prop *common.PropDef, value interface{}) (interface{}, bool) {
+	targetType := prop.Type()
+	switch v := value.(type) {
+	case nil:
+		return nil, true
+	case map[string]interface{}:
+		if targetType.TypeKind() == common.ResourceTypeKind {
+			return s.convertResource(path, v)
+		}
+		targetStructType, ok := targetType.(common.StructTypeDefAccessor)
+		if !ok || targetType.TypeKind() == common.PrimitiveTypeKind {
+			s.issue(UnmappableIssueKind, path, fmt.Sprintf("complex value cannot be represented as %s", targetType.InternalName()))
+			return nil, false
+		}
+		sourceStructType, ok := s.source.TypeByName(targetType.InternalName()).(common.StructTypeDefAccessor)
+		if !ok {
+			sourceStructType = targetStructType
+		}
+		return s.convertStruct(path, sourceStructType, targetStructType, v), true
+	case string:
+		if enum := prop.Enum(); len(enum) > 0 && !containsCode(enum, v) {
+			s.issue(UnmappableIssueKind, path, fmt.Sprintf("code %s is undefined in FHIR %s", v, s.target.SymbolicVersion()))
+			return nil, false
+		}
+	}
+	if targetType.TypeKind() != common.PrimitiveTypeKind {
+		s.issue(UnmappableIssueKind, path, fmt.Sprintf("primitive value cannot be represented as %s", targetType.InternalName()))
+		return nil, false
+	}
+	return value, true
+}
+
+// value returns the value of the source element with the specified name.
+func (c *structContext) value(name string) interface{} {
+	return c.source[name]
+}
+
+// stringValue returns the string value of the source element with the
+// specified name. If the value is not a string, an empty string is returned.
+func (c *structContext) stringValue(name string) string {
+	s, _ := c.source[name].(string)
+	return s
+}
+
+// consume marks the source elements with the specified names (and their
+// primitive extensions) as converted.
+func (c *structContext) consume(names ...string) {
+	for _, name := range names {
+		c.consumed[name] = true
+		c.consumed["_"+name] = true
+	}
+}
+
+// move converts the source element with the specified name to the target
+// element with the specified name.
+func (c *structContext) move(sourceName string, targetName string) {
+	c.moveMapped(sourceName, targetName, nil)
+}
+
+// moveMapped converts the source element with the specified name to the target
+// element with the specified name. The values of the source element are mapped
+// with the specified map of codes before they are converted.
+func (c *structContext) moveMapped(sourceName string, targetName string, codes map[string]string) {
+	c.consume(sourceName)
+	value, found := c.source[sourceName]
+	if found && codes != nil {
+		value = mapCodes(value, codes)
+	}
+	extension, extensionFound := c.source["_"+sourceName]
+	if !found && !extensionFound {
+		return
+	}
+
+	prop := c.targetType.PropByName(targetName)
+	if prop == nil {
+		if found {
+			c.lost(sourceName, fmt.Sprintf("element is undefined in FHIR %s", c.conversion.target.SymbolicVersion()))
+		} else {
+			c.lost("_"+sourceName, fmt.Sprintf("element is undefined in FHIR %s", c.conversion.target.SymbolicVersion()))
+		}
+		return
+	}
+	if found {
+		c.put(prop, c.subPath(sourceName), value)
+	}
+	if extensionFound {
+		elementProp := common.NewPropDef("_"+targetName, c.conversion.target.ElementType(), "", 0, prop.Array(), nil)
+		if converted, ok := c.conversion.convertValue(c.subPath("_"+sourceName), elementProp, extension); ok {
+			c.target["_"+targetName] = converted
+		}
+	}
+}
+
+// set converts the specified value to the target element with the specified
+// name. If the target element is an array, the value is appended.
+func (c *structContext) set(sourceName string, targetName string, value interface{}) {
+	prop := c.targetType.PropByName(targetName)
+	if prop == nil {
+		panic(fmt.Sprintf("target element %s.%s is undefined", c.targetType.InternalName(), targetName))
+	}
+	c.put(prop, c.subPath(sourceName), value)
+}
+
+func (c *structContext) put(prop *common.PropDef, path string, value interface{}) {
+	converted, ok := c.conversion.convertValue(path, prop, value)
+	if !ok {
+		return
+	}
+	if existing, found := c.target[prop.Name()]; found && prop.Array() {
+		converted = append(existing.([]interface{}), converted.([]interface{})...)
+	}
+	c.target[prop.Name()] = converted
+}
+
+// lost adds an issue for the source element with the specified name (which
+// may contain an index) that has no counterpart in the target version.
+func (c *structContext) lost(sourceName string, message string) {
+	c.conversion.issue(LostIssueKind, c.subPath(sourceName), message)
+}
+
+// unmappable adds an issue for the source element with the specified name
+// (which may contain an index) whose value cannot be represented in the
+// target version.
+func (c *structContext) unmappable(sourceName string, message string) {
+	c.conversion.issue(UnmappableIssueKind, c.subPath(sourceName), message)
+}
+
+// mapResourceTypeReferences replaces the resource types of the converted
+// elements that refer to resources by their resource type (e.g. Patient/123)
+// with the corresponding resource types of the target version.
+func (c *structContext) mapResourceTypeReferences() {
+	for _, name := range resourceTypeReferenceElements[c.sourceType.InternalName()] {
+		ref, ok := c.target[name].(string)
+		if !ok {
+			continue
+		}
+		start, end := resourceTypeSegment(c.conversion.source, ref)
+		if start < 0 {
+			continue
+		}
+		resourceType := ref[start:end]
+		targetTypeNames := c.conversion.targetTypeNames(resourceType)
+		if len(targetTypeNames) == 0 {
+			delete(c.target, name)
+			c.unmappable(name, fmt.Sprintf("referenced %s", c.conversion.noCounterpartError(resourceType)))
+			continue
+		}
+		if len(targetTypeNames) > 1 {
+			c.conversion.issue(AmbiguousIssueKind, c.subPath(name),
+				fmt.Sprintf("referenced %s", c.conversion.ambiguousMessage(resourceType, targetTypeNames)))
+		}
+		c.target[name] = ref[:start] + targetTypeNames[0] + ref[end:]
+	}
+}
+
+func (c *structContext) subPath(name string) string {
+	return c.path + "." + name
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func indexedPath(path string, index int) string {
+	return fmt.Sprintf("%s[%d]", path, index)
+}
+
+// resourceTypeSegment returns the start and end index of the resource type in
+// the specified literal reference, resource URL or resource type. References
+// to contained resources and URNs contain no resource type. If the reference
+// contains no resource type of the specified version, -1 is returned.
+func resourceTypeSegment(c *common.TypeDefContainer, ref string) (int, int) {
+	if strings.HasPrefix(ref, "#") || strings.HasPrefix(ref, "urn:") {
+		return -1, -1
+	}
+	path := ref
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+	if i := strings.Index(path, "/_history/"); i >= 0 {
+		path = path[:i]
+	}
+	segments := strings.Split(path, "/")
+	for i := len(segments) - 2; i < len(segments); i++ {
+		if i >= 0 && isResourceType(c, segments[i]) {
+			start := len(strings.Join(segments[:i], "/"))
+			if i > 0 {
+				start++
+			}
+			return start, start + len(segments[i])
+		}
+	}
+	return -1, -1
+}
+
+func isResourceType(c *common.TypeDefContainer, name string) bool {
+	t := c.TypeByName(name)
+	return t != nil && t.TypeKind() == common.ResourceTypeKind
+}
+
+func containsCode(codes []string, code string) bool {
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+func mapCodes(value interface{}, codes map[string]string) interface{} {
+	switch v := value.(type) {
+	case string:
+		if mapped, found := codes[v]; found {
+			return mapped
+		}
+	case []interface{}:
+		mapped := make([]interface{}, len(v))
+		for i, item := range v {
+			mapped[i] = mapCodes(item, codes)
+		}
+		return mapped
+	}
+	return value
+}
@@ -0,0 +1,421 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package dynamic
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/healthiop/hi"
+	"github.com/healthiop/hi/internal/common"
+	gohipath "github.com/healthiop/hipath"
+	"github.com/healthiop/hipath/hipathsys"
+	"reflect"
+	"strconv"
+	"sync"
+)
+
+// BindingTagName is the name of the struct tag that contains the FHIRPath
+// expression of a bound field.
+const BindingTagName = "fhir"
+
+type bindingKind int
+
+const (
+	scalarBindingKind bindingKind = iota
+	structBindingKind
+	nodeBindingKind
+)
+
+var jsonNumberType = reflect.TypeOf(json.Number(""))
+
+// Binder binds the values of dynamic resources to the fields of Go structs.
+// The fields are selected by the struct tag fhir, which contains a FHIRPath
+// expression (e.g. `fhir:"name.where(use='official').family"`). The
+// expression is evaluated on the resource, and for fields of nested structs
+// on the node to which the nested struct is bound. The variable %resource
+// refers to the resource that contains the node and %rootResource to the
+// bound resource. Fields without tag and fields with tag "-" are ignored.
+//
+// Fields of type string, bool, json.Number, integer and float bind
+// primitive values whose simple type matches. Fields of struct types with
+// tagged fields bind elements, other struct types (e.g. time.Time) are not
+// supported. Fields of interface type bind the nodes themselves (e.g.
+// hi.DynElementAccessor). Pointer fields are set if the expression has a
+// result, slice fields contain all results. Other fields must not have more
+// than one result and are not modified if the result is empty.
+type Binder struct {
+	typeDefContainer *common.TypeDefContainer
+	model            *PathDynModel
+	lock             sync.RWMutex
+	bindings         map[reflect.Type]*structBinding
+}
+
+type structBinding struct {
+	fields []*fieldBinding
+	err    error
+}
+
+type fieldBinding struct {
+	index      int
+	expression string
+	path       *gohipath.Path
+	list       bool
+	ptr        bool
+	elemType   reflect.Type
+	kind       bindingKind
+	binding    *structBinding
+}
+
+func NewBinder(typeDefContainer *common.TypeDefContainer) *Binder {
+	return &Binder{
+		typeDefContainer: typeDefContainer,
+		model:            NewPathDynModel(typeDefContainer),
+		bindings:         make(map[reflect.Type]*structBinding),
+	}
+}
+
+func (b *Binder) TypeDefContainer() *common.TypeDefContainer {
+	return b.typeDefContainer
+}
+
+// Bind binds the values of the resource to the struct to which target
+// points. The resource must have been created for the FHIR version of the
+// binder. Errors name the FHIRPath expressions of the failing field.
+func (b *Binder) Bind(resource hi.DynResourceAccessor, target interface{}) error {
+	if resource.VersionString() != b.typeDefContainer.VersionString() {
+		return fmt.Errorf("resource has FHIR version %s instead of %s",
+			resource.VersionString(), b.typeDefContainer.VersionString())
+	}
+
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("target must be a non-nil pointer to a struct: %T", target)
+	}
+	sb, err := b.structBinding(v.Elem().Type())
+	if err != nil {
+		return err
+	}
+	return b.bindStruct(sb, v.Elem(), resource, resource, resource, "")
+}
+
+// BindData binds the values of the resource data to the struct to which
+// target points.
+func (b *Binder) BindData(data map[string]interface{}, target interface{}) error {
+	resource, err := NewDynResource(b.typeDefContainer, data, nil)
+	if err != nil {
+		return err
+	}
+	return b.Bind(resource, target)
+}
+
+// structBinding returns the compiled binding of the struct type.
+func (b *Binder) structBinding(t reflect.Type) (*structBinding, error) {
+	b.lock.RLock()
+	sb := b.bindings[t]
+	b.lock.RUnlock()
+	if sb == nil {
+		b.lock.Lock()
+		defer b.lock.Unlock()
+		sb = b.compileStruct(t)
+	}
+	return sb, sb.err
+}
+
+// compileStruct compiles the binding of the struct type. The binding is
+// registered before its fields are compiled, so that recursive struct types
+// can be compiled. The lock must be held.
+func (b *Binder) compileStruct(t reflect.Type) *structBinding {
+	if sb := b.bindings[t]; sb != nil {
+		return sb
+	}
+	sb := &structBinding{}
+	b.bindings[t] = sb
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		expression, found := f.Tag.Lookup(BindingTagName)
+		if !found || expression == "-" {
+			continue
+		}
+		fb, err := b.compileField(i, f, expression)
+		if err != nil {
+			sb.err = fmt.Errorf("field %s of %s: %w", f.Name, t, err)
+			break
+		}
+		sb.fields = append(sb.fields, fb)
+	}
+	return sb
+}
+
+func (b *Binder) compileField(index int, f reflect.StructField, expression string) (*fieldBinding, error) {
+	if f.PkgPath != "" {
+		return nil, fmt.Errorf("field is not exported")
+	}
+	path, err := gohipath.Compile(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid FHIRPath expression %s: %s", expression, err)
+	}
+
+	fb := &fieldBinding{index: index, expression: expression, path: path, elemType: f.Type}
+	if fb.elemType.Kind() == reflect.Slice {
+		fb.list = true
+		fb.elemType = fb.elemType.Elem()
+	}
+	if fb.elemType.Kind() == reflect.Ptr {
+		fb.ptr = true
+		fb.elemType = fb.elemType.Elem()
+	}
+
+	switch fb.elemType.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		fb.kind = scalarBindingKind
+	case reflect.Struct:
+		if !hasBindingTags(fb.elemType) {
+			return nil, fmt.Errorf("unsupported type %s", f.Type)
+		}
+		fb.kind = structBindingKind
+		fb.binding = b.compileStruct(fb.elemType)
+		if fb.binding.err != nil {
+			return nil, fb.binding.err
+		}
+	case reflect.Interface:
+		if fb.ptr {
+			return nil, fmt.Errorf("unsupported type %s", f.Type)
+		}
+		fb.kind = nodeBindingKind
+	default:
+		return nil, fmt.Errorf("unsupported type %s", f.Type)
+	}
+	return fb, nil
+}
+
+// hasBindingTags returns if the struct type contains at least one field that
+// is bound by a FHIRPath expression.
+func hasBindingTags(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if expression, found := t.Field(i).Tag.Lookup(BindingTagName); found && expression != "-" {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *Binder) bindStruct(sb *structBinding, v reflect.Value, node interface{}, resource hi.DynResourceAccessor, rootResource hi.DynResourceAccessor, location string) error {
+	for _, fb := range sb.fields {
+		fieldLocation := fb.expression
+		if location != "" {
+			fieldLocation = location + "." + fb.expression
+		}
+
+		ctx := NewPathDynContext(b.model, node, map[string]interface{}{
+			"resource":     resource,
+			"rootResource": rootResource,
+		})
+		col, err := fb.path.Execute(ctx, node)
+		if err != nil {
+			return fmt.Errorf("%s: %s", fieldLocation, err)
+		}
+
+		var items []interface{}
+		for i := 0; i < col.Count(); i++ {
+			item := col.Get(i)
+			if p, ok := item.(hi.DynPrimitiveAccessor); ok && fb.kind == scalarBindingKind && p.NilValue() {
+				continue
+			}
+			if item != nil {
+				items = append(items, item)
+			}
+		}
+		if len(items) == 0 {
+			continue
+		}
+
+		fv := v.Field(fb.index)
+		if !fb.list {
+			if len(items) > 1 {
+				return fmt.Errorf("%s: expected at most one value but found %d", fieldLocation, len(items))
+			}
+			if err := b.bindValue(fb, fv, items[0], rootResource, fieldLocation); err != nil {
+				return err
+			}
+			continue
+		}
+
+		list := reflect.MakeSlice(fv.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := b.bindValue(fb, list.Index(i), item, rootResource,
+				fieldLocation+"["+strconv.Itoa(i)+"]"); err != nil {
+				return err
+			}
+		}
+		fv.Set(list)
+	}
+	return nil
+}
+
+func (b *Binder) bindValue(fb *fieldBinding, v reflect.Value, item interface{}, rootResource hi.DynResourceAccessor, location string) error {
+	if fb.ptr {
+		p := reflect.New(fb.elemType)
+		v.Set(p)
+		v = p.Elem()
+	}
+
+	switch fb.kind {
+	case structBindingKind:
+		node, ok := item.(hi.DynStructAccessor)
+		if !ok {
+			return fmt.Errorf("%s: value cannot be bound to a struct: %T", location, item)
+		}
+		return b.bindStruct(fb.binding, v, item, containingResource(node, rootResource), rootResource, location)
+	case nodeBindingKind:
+		iv := reflect.ValueOf(item)
+		if !iv.Type().AssignableTo(fb.elemType) {
+			return fmt.Errorf("%s: value of type %T cannot be assigned to %s", location, item, fb.elemType)
+		}
+		v.Set(iv)
+		return nil
+	}
+
+	simpleType, s, bv, err := scalarValue(item)
+	if err != nil {
+		return fmt.Errorf("%s: %w", location, err)
+	}
+	var expected hi.SimpleType
+	switch {
+	case fb.elemType == jsonNumberType:
+		expected = hi.NumberSimpleType
+		if simpleType == expected {
+			v.SetString(s)
+		}
+	case fb.elemType.Kind() == reflect.String:
+		expected = hi.StringSimpleType
+		if simpleType == expected {
+			v.SetString(s)
+		}
+	case fb.elemType.Kind() == reflect.Bool:
+		expected = hi.BoolSimpleType
+		if simpleType == expected {
+			v.SetBool(bv)
+		}
+	default:
+		expected = hi.NumberSimpleType
+		if simpleType == expected {
+			if err := setNumber(v, s); err != nil {
+				return fmt.Errorf("%s: %w", location, err)
+			}
+		}
+	}
+	if simpleType != expected {
+		return fmt.Errorf("%s: value of simple type %s cannot be assigned to %s",
+			location, simpleTypeName(simpleType), fb.elemType)
+	}
+	return nil
+}
+
+// containingResource returns the resource that contains the node, which is
+// the node itself if it is a resource. If the node has no parent resource,
+// the root resource is returned.
+func containingResource(node hi.DynAccessor, rootResource hi.DynResourceAccessor) hi.DynResourceAccessor {
+	for n := node; n != nil; n = n.Parent() {
+		if r, ok := ResourceOf(n); ok {
+			return r
+		}
+	}
+	return rootResource
+}
+
+// scalarValue returns the simple type of the value and either its string
+// representation or its boolean value.
+func scalarValue(item interface{}) (hi.SimpleType, string, bool, error) {
+	switch v := item.(type) {
+	case hi.DynPrimitiveAccessor:
+		switch v.SimpleType() {
+		case hi.BoolSimpleType:
+			b, err := v.BoolValue()
+			return hi.BoolSimpleType, "", b, err
+		case hi.NumberSimpleType:
+			if p, ok := v.(*dynPrimitive); ok {
+				if n, ok := p.value.(json.Number); ok {
+					return hi.NumberSimpleType, n.String(), false, nil
+				}
+			}
+			n, err := v.NumberValue()
+			return hi.NumberSimpleType, strconv.FormatFloat(n, 'f', -1, 64), false, err
+		default:
+			s, err := v.StringValue()
+			return hi.StringSimpleType, s, false, err
+		}
+	case hipathsys.BooleanAccessor:
+		return hi.BoolSimpleType, "", v.Bool(), nil
+	case hipathsys.NumberAccessor:
+		return hi.NumberSimpleType, v.String(), false, nil
+	case hipathsys.Stringifier:
+		return hi.StringSimpleType, v.String(), false, nil
+	}
+	return 0, "", false, fmt.Errorf("value is not primitive: %T", item)
+}
+
+func setNumber(v reflect.Value, s string) error {
+	var err error
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		if i, err = strconv.ParseInt(s, 10, v.Type().Bits()); err == nil {
+			v.SetInt(i)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var u uint64
+		if u, err = strconv.ParseUint(s, 10, v.Type().Bits()); err == nil {
+			v.SetUint(u)
+		}
+	default:
+		var f float64
+		if f, err = strconv.ParseFloat(s, v.Type().Bits()); err == nil {
+			v.SetFloat(f)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("value %s cannot be converted to %s", s, v.Type())
+	}
+	return nil
+}
+
+func simpleTypeName(simpleType hi.SimpleType) string {
+	switch simpleType {
+	case hi.StringSimpleType:
+		return "string"
+	case hi.NumberSimpleType:
+		return "number"
+	default:
+		return "boolean"
+	}
+}
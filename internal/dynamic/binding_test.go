@@ -0,0 +1,287 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package dynamic
+
+import (
+	"encoding/json"
+	"github.com/healthiop/hi"
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/healthiop/hi/internal/stu3"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type testBoundPatient struct {
+	ID           string          `fhir:"id"`
+	Active       *bool           `fhir:"active"`
+	Family       string          `fhir:"name.where(use='official').family"`
+	Given        []string        `fhir:"name.given"`
+	Names        []testBoundName `fhir:"name"`
+	BirthDate    string          `fhir:"birthDate"`
+	Births       int             `fhir:"multipleBirth"`
+	NameCount    int64           `fhir:"name.count()"`
+	Missing      *string         `fhir:"gender"`
+	Organization hi.DynAccessor  `fhir:"managingOrganization"`
+	Contained    []*testBoundOrg `fhir:"contained.ofType('Organization')"`
+	Ignored      string          `fhir:"-"`
+	Untagged     string
+}
+
+type testBoundName struct {
+	Use    string   `fhir:"use"`
+	Family string   `fhir:"family"`
+	Given  []string `fhir:"given"`
+}
+
+type testBoundOrg struct {
+	Name string `fhir:"name"`
+}
+
+type testBoundObservation struct {
+	Value    json.Number `fhir:"value.value"`
+	Float    float64     `fhir:"value.value"`
+	Float32  *float32    `fhir:"value.value"`
+	Unit     string      `fhir:"value.unit"`
+	Next     *testBoundObservation
+	Self     []*testBoundObservation `fhir:"contained"`
+	Negative uint                    `fhir:"component.value"`
+}
+
+func newTestBoundPatient(t *testing.T) hi.DynResourceAccessor {
+	r, err := NewDynResource(r4.TypeDefContainer(), map[string]interface{}{
+		"resourceType": "Patient",
+		"id":           "example",
+		"active":       true,
+		"name": []interface{}{
+			map[string]interface{}{"use": "usual", "given": []interface{}{"Jane"}},
+			map[string]interface{}{"use": "official", "family": "Doe", "given": []interface{}{"Jane", nil, "Mary"},
+				"_given": []interface{}{nil, map[string]interface{}{"id": "1"}}},
+		},
+		"_birthDate":           map[string]interface{}{"id": "1"},
+		"multipleBirthInteger": json.Number("2"),
+		"managingOrganization": map[string]interface{}{"reference": "#org1"},
+		"contained": []interface{}{
+			map[string]interface{}{"resourceType": "Organization", "id": "org1", "name": "Example"},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestBind(t *testing.T) {
+	b := NewBinder(r4.TypeDefContainer())
+	assert.Same(t, r4.TypeDefContainer(), b.TypeDefContainer())
+
+	p := testBoundPatient{Ignored: "ignored", Untagged: "untagged"}
+	r := newTestBoundPatient(t)
+	if !assert.NoError(t, b.Bind(r, &p)) {
+		return
+	}
+	assert.Equal(t, "example", p.ID)
+	if assert.NotNil(t, p.Active) {
+		assert.True(t, *p.Active)
+	}
+	assert.Equal(t, "Doe", p.Family)
+	assert.Equal(t, []string{"Jane", "Jane", "Mary"}, p.Given)
+	assert.Equal(t, []testBoundName{
+		{Use: "usual", Given: []string{"Jane"}},
+		{Use: "official", Family: "Doe", Given: []string{"Jane", "Mary"}},
+	}, p.Names)
+	assert.Equal(t, "", p.BirthDate)
+	assert.Equal(t, 2, p.Births)
+	assert.Equal(t, int64(2), p.NameCount)
+	assert.Nil(t, p.Missing)
+	if assert.Implements(t, (*hi.DynElementAccessor)(nil), p.Organization) {
+		v, _ := p.Organization.(hi.DynElementAccessor).StringPropValue("reference")
+		assert.Equal(t, "#org1", v)
+	}
+	assert.Equal(t, []*testBoundOrg{{Name: "Example"}}, p.Contained)
+	assert.Equal(t, "ignored", p.Ignored)
+	assert.Equal(t, "untagged", p.Untagged)
+}
+
+func TestBindData(t *testing.T) {
+	var o testBoundObservation
+	err := NewBinder(r4.TypeDefContainer()).BindData(map[string]interface{}{
+		"resourceType":  "Observation",
+		"status":        "final",
+		"code":          map[string]interface{}{"text": "Test"},
+		"valueQuantity": map[string]interface{}{"value": json.Number("1.50"), "unit": "kg"},
+		"contained": []interface{}{
+			map[string]interface{}{"resourceType": "Observation", "status": "final",
+				"code": map[string]interface{}{"text": "Test"}, "valueQuantity": map[string]interface{}{"value": json.Number("2")}},
+		},
+	}, &o)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, json.Number("1.50"), o.Value)
+	assert.Equal(t, 1.5, o.Float)
+	if assert.NotNil(t, o.Float32) {
+		assert.Equal(t, float32(1.5), *o.Float32)
+	}
+	assert.Equal(t, "kg", o.Unit)
+	if assert.Len(t, o.Self, 1) {
+		assert.Equal(t, json.Number("2"), o.Self[0].Value)
+	}
+}
+
+func TestBindErrors(t *testing.T) {
+	type multiple struct {
+		Given string `fhir:"name.given"`
+	}
+	type simpleType struct {
+		Active string `fhir:"active"`
+	}
+	type conversion struct {
+		Active struct {
+			Given []int `fhir:"given"`
+		} `fhir:"name[1]"`
+	}
+	type system struct {
+		Count bool `fhir:"name.count()"`
+	}
+	type element struct {
+		Name struct {
+			Given []string `fhir:"given"`
+		} `fhir:"name.count()"`
+	}
+	type untaggedStruct struct {
+		Created time.Time `fhir:"meta.lastUpdated"`
+	}
+	type node struct {
+		Name hi.DynPrimitiveAccessor `fhir:"name.first()"`
+	}
+	type evaluation struct {
+		Name string `fhir:"name.given.substring('a')"`
+	}
+	type invalid struct {
+		Name string `fhir:"name."`
+	}
+	type unexported struct {
+		name string `fhir:"name"`
+	}
+	type unsupported struct {
+		Name map[string]string `fhir:"name"`
+	}
+	type nested struct {
+		Invalid []invalid `fhir:"name"`
+	}
+
+	tests := []struct {
+		name   string
+		target interface{}
+		err    string
+	}{
+		{"multiple", &multiple{}, "name.given: expected at most one value but found 3"},
+		{"simple type", &simpleType{}, "active: value of simple type boolean cannot be assigned to string"},
+		{"conversion", &conversion{}, "name[1].given[0]: value of simple type string cannot be assigned to int"},
+		{"system", &system{}, "name.count(): value of simple type number cannot be assigned to bool"},
+		{"element", &element{}, "name.count(): value cannot be bound to a struct: *hipathsys.integerType"},
+		{"node", &node{}, "name.first(): value of type *dynamic.dynStruct cannot be assigned to hi.DynPrimitiveAccessor"},
+		{"invalid", &invalid{}, "field Name of dynamic.invalid: invalid FHIRPath expression name.: "},
+		{"unexported", &unexported{}, "field name of dynamic.unexported: field is not exported"},
+		{"unsupported", &unsupported{}, "field Name of dynamic.unsupported: unsupported type map[string]string"},
+		{"untagged struct", &untaggedStruct{}, "field Created of dynamic.untaggedStruct: unsupported type time.Time"},
+		{"nested", &nested{}, "field Invalid of dynamic.nested: field Name of dynamic.invalid: invalid FHIRPath expression name.: "},
+		{"no pointer", multiple{}, "target must be a non-nil pointer to a struct: dynamic.multiple"},
+		{"no struct", new(string), "target must be a non-nil pointer to a struct: *string"},
+	}
+
+	b := NewBinder(r4.TypeDefContainer())
+	r := newTestBoundPatient(t)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := b.Bind(r, test.target)
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), test.err)
+			}
+		})
+	}
+}
+
+func TestBindContainedResource(t *testing.T) {
+	type org struct {
+		ID     string `fhir:"%resource.id"`
+		RootID string `fhir:"%rootResource.id"`
+	}
+	type contact struct {
+		ResourceID string `fhir:"%resource.id"`
+	}
+	var target struct {
+		ResourceID string    `fhir:"%resource.id"`
+		Orgs       []org     `fhir:"contained"`
+		Contacts   []contact `fhir:"contained.contact"`
+	}
+	err := NewBinder(r4.TypeDefContainer()).BindData(map[string]interface{}{
+		"resourceType": "Patient",
+		"id":           "patient1",
+		"contained": []interface{}{
+			map[string]interface{}{"resourceType": "Organization", "id": "org1",
+				"contact": []interface{}{map[string]interface{}{"name": map[string]interface{}{"text": "Test"}}}},
+		},
+	}, &target)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "patient1", target.ResourceID)
+		if assert.Len(t, target.Orgs, 1) {
+			assert.Equal(t, "org1", target.Orgs[0].ID)
+			assert.Equal(t, "patient1", target.Orgs[0].RootID)
+		}
+		assert.Equal(t, []contact{{ResourceID: "org1"}}, target.Contacts)
+	}
+}
+
+func TestBindNumberRange(t *testing.T) {
+	var target struct {
+		Births int8  `fhir:"multipleBirth"`
+		Count  uint8 `fhir:"name.count()"`
+	}
+	r, err := NewDynResource(r4.TypeDefContainer(), map[string]interface{}{
+		"resourceType":         "Patient",
+		"multipleBirthInteger": json.Number("1000"),
+	}, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.EqualError(t, NewBinder(r4.TypeDefContainer()).Bind(r, &target),
+		"multipleBirth: value 1000 cannot be converted to int8")
+}
+
+func TestBindVersion(t *testing.T) {
+	r, err := NewDynResource(stu3.TypeDefContainer(), map[string]interface{}{"resourceType": "Patient"}, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.EqualError(t, NewBinder(r4.TypeDefContainer()).Bind(r, &testBoundPatient{}),
+		"resource has FHIR version "+stu3.TypeDefContainer().VersionString()+
+			" instead of "+r4.TypeDefContainer().VersionString())
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package dynamic
+
+import (
+	"fmt"
+	"github.com/healthiop/hi"
+	"github.com/healthiop/hi/internal/common"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var builderPathSegmentRegexp = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9_]*)(?:\[(\d+)])?$`)
+
+type resourceBuilder struct {
+	resource *dynStruct
+	err      error
+}
+
+type builderPathSegment struct {
+	name  string
+	index int
+}
+
+// NewResourceBuilder returns a builder for a resource of the specified
+// type. An undefined resource type is returned as error by Build.
+func NewResourceBuilder(typeDefContainer *common.TypeDefContainer, resourceType string) hi.ResourceBuilder {
+	r, err := NewDynResource(typeDefContainer, map[string]interface{}{
+		ResourceTypePropName: resourceType,
+	}, nil)
+	if err != nil {
+		return &resourceBuilder{err: err}
+	}
+	return &resourceBuilder{resource: r.(*dynStruct)}
+}
+
+func (b *resourceBuilder) Set(path string, value interface{}) hi.ResourceBuilder {
+	b.apply(path, func(d *dynStruct, segment builderPathSegment) error {
+		propDef, err := d.propDef(segment.name)
+		if err != nil {
+			return err
+		}
+		if segment.index >= 0 {
+			if items, _ := d.listData(segment.name); segment.index == len(items) {
+				return d.AppendItem(segment.name, value)
+			}
+			return d.SetItem(segment.name, segment.index, value)
+		}
+		if _, ok := propDef.Type().(common.PrimitiveTypeDefAccessor); ok {
+			return d.SetPrimitive(segment.name, value)
+		}
+		if value == nil {
+			return d.SetElement(segment.name, nil)
+		}
+		s, ok := value.(hi.DynStructAccessor)
+		if !ok {
+			return fmt.Errorf("value of property %s of type %s must be a struct accessor: %T",
+				segment.name, d.typeDef.InternalName(), value)
+		}
+		return d.SetElement(segment.name, s)
+	})
+	return b
+}
+
+func (b *resourceBuilder) Add(path string, value interface{}) hi.ResourceBuilder {
+	b.apply(path, func(d *dynStruct, segment builderPathSegment) error {
+		if segment.index >= 0 {
+			return fmt.Errorf("last path segment must not contain an index")
+		}
+		return d.AppendItem(segment.name, value)
+	})
+	return b
+}
+
+func (b *resourceBuilder) Build() (hi.DynResourceAccessor, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b.resource, nil
+}
+
+// apply resolves the path up to its last segment and invokes the function
+// with the struct that contains the last segment.
+func (b *resourceBuilder) apply(path string, f func(d *dynStruct, segment builderPathSegment) error) {
+	if b.err != nil {
+		return
+	}
+	if path == "" {
+		b.err = fmt.Errorf("path must not be empty")
+		return
+	}
+
+	segments, err := parseBuilderPath(path)
+	if err == nil {
+		d := b.resource
+		for _, segment := range segments[:len(segments)-1] {
+			if d, err = d.builderChild(segment); err != nil {
+				break
+			}
+		}
+		if err == nil {
+			err = f(d, segments[len(segments)-1])
+		}
+	}
+	if err != nil {
+		b.err = fmt.Errorf("%s: %w", path, err)
+	}
+}
+
+// builderChild returns the element of the path segment. The element is
+// created if it does not exist.
+func (d *dynStruct) builderChild(segment builderPathSegment) (*dynStruct, error) {
+	propDef, err := d.propDef(segment.name)
+	if err != nil {
+		return nil, err
+	}
+	typeDef, ok := propDef.Type().(common.StructTypeDefAccessor)
+	if !ok {
+		return nil, fmt.Errorf("property %s of type %s has primitive type %s",
+			segment.name, d.typeDef.InternalName(), propDef.Type().InternalName())
+	}
+	if propDef.Array() != (segment.index >= 0) {
+		if propDef.Array() {
+			return nil, fmt.Errorf("list property %s of type %s requires an index", segment.name, d.typeDef.InternalName())
+		}
+		return nil, fmt.Errorf("property %s of type %s is not a list", segment.name, d.typeDef.InternalName())
+	}
+
+	var data interface{}
+	if segment.index >= 0 {
+		items, err := d.listData(segment.name)
+		if err != nil {
+			return nil, err
+		}
+		if segment.index < len(items) {
+			data = items[segment.index]
+		} else if segment.index > len(items) {
+			return nil, fmt.Errorf("index %d of list property %s of type %s is out of range",
+				segment.index, segment.name, d.typeDef.InternalName())
+		}
+	} else {
+		data = d.data[segment.name]
+	}
+
+	if data == nil {
+		if typeDef.TypeKind() == common.ResourceTypeKind {
+			return nil, fmt.Errorf("resource of property %s of type %s must be set before its properties",
+				segment.name, d.typeDef.InternalName())
+		}
+		child := &dynStruct{dynBase{dyn{d.typeDefContainer, d}}, typeDef, make(map[string]interface{}), nil}
+		if segment.index >= 0 {
+			err = d.AppendItem(segment.name, child)
+		} else {
+			err = d.SetElement(segment.name, child)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return child, nil
+	}
+
+	dataMap, ok := data.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("struct property %s of type %s contains invalid data with type %T",
+			segment.name, d.typeDef.InternalName(), data)
+	}
+	if typeDef.TypeKind() == common.ResourceTypeKind {
+		r, err := NewDynResource(d.typeDefContainer, dataMap, d)
+		if err != nil {
+			return nil, err
+		}
+		return r.(*dynStruct), nil
+	}
+	return &dynStruct{dynBase{dyn{d.typeDefContainer, d}}, typeDef, dataMap, nil}, nil
+}
+
+func parseBuilderPath(path string) ([]builderPathSegment, error) {
+	parts := strings.Split(path, ".")
+	segments := make([]builderPathSegment, len(parts))
+	for i, part := range parts {
+		m := builderPathSegmentRegexp.FindStringSubmatch(part)
+		if m == nil {
+			return nil, fmt.Errorf("invalid path segment: %s", part)
+		}
+		segments[i] = builderPathSegment{m[1], -1}
+		if m[2] != "" {
+			index, err := strconv.Atoi(m[2])
+			if err != nil {
+				return nil, fmt.Errorf("invalid index of path segment: %s", part)
+			}
+			segments[i].index = index
+		}
+	}
+	return segments, nil
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package dynamic
+
+import (
+	"encoding/json"
+	"github.com/healthiop/hi"
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestResourceBuilder(t *testing.T) {
+	tdc := r4.TypeDefContainer()
+	organization, err := NewResourceBuilder(tdc, "Organization").
+		Set("id", "org1").
+		Set("name", "Example Organization").
+		Build()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	r, err := NewResourceBuilder(tdc, "Patient").
+		Set("id", "example").
+		Set("active", true).
+		Set("name[0].family", "Doe").
+		Add("name[0].given", "Jane").
+		Set("name[0].given[1]", "Mary").
+		Set("name[1].text", "Jane Mary Doe").
+		Set("gender", "female").
+		Set("birthDate", "1970-03-30").
+		Set("multipleBirthInteger", 2).
+		Set("maritalStatus.coding[0].system", "http://terminology.hl7.org/CodeSystem/v3-MaritalStatus").
+		Set("maritalStatus.coding[0].code", "M").
+		Add("contained", organization).
+		Set("managingOrganization.reference", "#org1").
+		Build()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "Patient", r.TypeName())
+	assert.Equal(t, map[string]interface{}{
+		"resourceType": "Patient",
+		"id":           "example",
+		"active":       true,
+		"name": []interface{}{
+			map[string]interface{}{"family": "Doe", "given": []interface{}{"Jane", "Mary"}},
+			map[string]interface{}{"text": "Jane Mary Doe"},
+		},
+		"gender":               "female",
+		"birthDate":            "1970-03-30",
+		"multipleBirthInteger": json.Number("2"),
+		"maritalStatus": map[string]interface{}{
+			"coding": []interface{}{
+				map[string]interface{}{
+					"system": "http://terminology.hl7.org/CodeSystem/v3-MaritalStatus",
+					"code":   "M",
+				},
+			},
+		},
+		"contained": []interface{}{
+			map[string]interface{}{"resourceType": "Organization", "id": "org1", "name": "Example Organization"},
+		},
+		"managingOrganization": map[string]interface{}{"reference": "#org1"},
+	}, r.(DynDataRetriever).Data())
+}
+
+func TestResourceBuilderNestedResource(t *testing.T) {
+	tdc := r4.TypeDefContainer()
+	patient, _ := NewResourceBuilder(tdc, "Patient").Set("id", "p1").Build()
+	r, err := NewResourceBuilder(tdc, "Bundle").
+		Set("type", "collection").
+		Set("entry[0].fullUrl", "http://example.com/fhir/Patient/p1").
+		Set("entry[0].resource", patient).
+		Set("entry[0].resource.active", true).
+		Build()
+	if assert.NoError(t, err) {
+		entries, _ := r.ListProp("entry")
+		assert.NotNil(t, entries)
+		assert.Equal(t, map[string]interface{}{"resourceType": "Patient", "id": "p1", "active": true},
+			patient.(DynDataRetriever).Data())
+	}
+}
+
+func TestResourceBuilderInvalid(t *testing.T) {
+	tdc := r4.TypeDefContainer()
+	tests := []struct {
+		name    string
+		builder hi.ResourceBuilder
+		err     string
+	}{
+		{"resource type", NewResourceBuilder(tdc, "Test"), "resource type undefined: Test"},
+		{"unknown", NewResourceBuilder(tdc, "Patient").Set("name[0].test", "x"),
+			"name[0].test: type HumanName has no property named test"},
+		{"wrong type", NewResourceBuilder(tdc, "Patient").Set("active", "yes"),
+			"active: property active of type Patient: value of type boolean must be a boolean: string"},
+		{"primitive path", NewResourceBuilder(tdc, "Patient").Set("gender.text", "x"),
+			"gender.text: property gender of type Patient has primitive type string"},
+		{"missing index", NewResourceBuilder(tdc, "Patient").Set("name.family", "Doe"),
+			"name.family: list property name of type Patient requires an index"},
+		{"index", NewResourceBuilder(tdc, "Patient").Set("maritalStatus[0].text", "x"),
+			"maritalStatus[0].text: property maritalStatus of type Patient is not a list"},
+		{"index out of range", NewResourceBuilder(tdc, "Patient").Set("name[1].family", "Doe"),
+			"name[1].family: index 1 of list property name of type Patient is out of range"},
+		{"set list", NewResourceBuilder(tdc, "Patient").Set("name[0].given", "Jane"),
+			"name[0].given: property given of type HumanName is a list"},
+		{"add index", NewResourceBuilder(tdc, "Patient").Add("name[0].given[0]", "Jane"),
+			"name[0].given[0]: last path segment must not contain an index"},
+		{"struct value", NewResourceBuilder(tdc, "Patient").Set("maritalStatus", "M"),
+			"maritalStatus: value of property maritalStatus of type Patient must be a struct accessor: string"},
+		{"resource", NewResourceBuilder(tdc, "Bundle").Set("entry[0].resource.id", "p1"),
+			"entry[0].resource.id: resource of property resource of type Bundle_Entry must be set before its properties"},
+		{"path", NewResourceBuilder(tdc, "Patient").Set("name[x]", "Doe"),
+			"name[x]: invalid path segment: name[x]"},
+		{"empty path", NewResourceBuilder(tdc, "Patient").Set("", "Doe"),
+			"path must not be empty"},
+		{"first error", NewResourceBuilder(tdc, "Patient").Set("test", "x").Set("active", "yes"),
+			"test: type Patient has no property named test"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := tt.builder.Build()
+			assert.Nil(t, r)
+			if assert.Error(t, err) {
+				assert.Equal(t, tt.err, err.Error())
+			}
+		})
+	}
+}
@@ -29,10 +29,12 @@
 package dynamic
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/healthiop/hi"
 	"github.com/healthiop/hi/internal/common"
 	"strconv"
+	"strings"
 )
 
 const ResourceTypePropName = "resourceType"
@@ -41,6 +43,25 @@ type DynTypeDefRetriever interface {
 	Type() common.TypeDefAccessor
 }
 
+type DynDataRetriever interface {
+	Data() map[string]interface{}
+}
+
+// ResourceOf returns the node as resource if its type is a resource type.
+// Since every struct implements hi.DynResourceAccessor, the type of the node
+// must be checked.
+func ResourceOf(node interface{}) (hi.DynResourceAccessor, bool) {
+	r, ok := node.(hi.DynResourceAccessor)
+	if !ok {
+		return nil, false
+	}
+	typeDefRetriever, ok := node.(DynTypeDefRetriever)
+	if !ok || typeDefRetriever.Type().TypeKind() != common.ResourceTypeKind {
+		return nil, false
+	}
+	return r, true
+}
+
 type dyn struct {
 	typeDefContainer *common.TypeDefContainer
 	parent           hi.DynAccessor
@@ -58,8 +79,16 @@ type dynBase struct {
 
 type dynStruct struct {
 	dynBase
-	typeDef common.StructTypeDefAccessor
-	data    map[string]interface{}
+	typeDef  common.StructTypeDefAccessor
+	data     map[string]interface{}
+	location *pathLocation
+}
+
+// pathLocation is the location of a node within its parent. It is set for
+// nodes that have been returned by PathDynModel.
+type pathLocation struct {
+	name  string
+	index int
 }
 
 type dynPrimitive struct {
@@ -84,6 +113,7 @@ func NewDynPrimitive(typeDefContainer *common.TypeDefContainer, typeDef common.P
 			},
 			typeDefContainer.ElementType(),
 			element,
+			nil,
 		},
 		typeDef,
 		value,
@@ -101,6 +131,7 @@ func NewDynElement(typeDefContainer *common.TypeDefContainer, typeDef common.Str
 		},
 		typeDef,
 		data,
+		nil,
 	}, nil
 }
 
@@ -112,6 +143,10 @@ func NewDynResource(typeDefContainer *common.TypeDefContainer, data map[string]i
 
 	typeDef := typeDefContainer.TypeByName(resourceType)
 	if typeDef == nil {
+		if renamed := typeDefContainer.RenamedTypeNames(resourceType); len(renamed) > 0 {
+			return nil, fmt.Errorf("resource type undefined in FHIR %s: %s (use %s)",
+				typeDefContainer.SymbolicVersion(), resourceType, strings.Join(renamed, " or "))
+		}
 		return nil, fmt.Errorf("resource type undefined: %s", resourceType)
 	}
 	structTypeDef, ok := typeDef.(common.StructTypeDefAccessor)
@@ -128,6 +163,7 @@ func NewDynResource(typeDefContainer *common.TypeDefContainer, data map[string]i
 		},
 		structTypeDef,
 		data,
+		nil,
 	}, nil
 }
 
@@ -192,10 +228,13 @@ func (d *dynPrimitive) NumberValue() (float64, error) {
 	if d.value == nil {
 		return 0.0, nil
 	}
-	if v, ok := d.value.(float64); !ok {
-		return 0.0, fmt.Errorf("expected number value: %T", d.value)
-	} else {
+	switch v := d.value.(type) {
+	case float64:
 		return v, nil
+	case json.Number:
+		return v.Float64()
+	default:
+		return 0.0, fmt.Errorf("expected number value: %T", d.value)
 	}
 }
 
@@ -229,6 +268,10 @@ func (d *dynStruct) Type() common.TypeDefAccessor {
 	return d.typeDef
 }
 
+func (d *dynStruct) Data() map[string]interface{} {
+	return d.data
+}
+
 func (d *dynStruct) StringPropValue(name string) (string, error) {
 	p, err := d.dynPrimitivePropByName(name, common.StringSimpleType)
 	if p == nil || err != nil {
@@ -366,10 +409,20 @@ func (d *dynStruct) dynNonArrayProp(propDef *common.PropDef, kind common.TypeKin
 			name, d.typeDef.InternalName())
 	}
 
-	var ok bool
 	data := d.data[name]
 	if propDefKind == common.PrimitiveTypeKind {
-		element := d.data["_"+name]
+		return d.dynItem(propDef, data, d.data["_"+name])
+	}
+	return d.dynItem(propDef, data, nil)
+}
+
+// dynItem returns the accessor of a single value of the property. The
+// element contains the id and extensions of a primitive value.
+func (d *dynStruct) dynItem(propDef *common.PropDef, data interface{}, element interface{}) (hi.DynAccessor, error) {
+	var ok bool
+	name := propDef.Name()
+	propDefKind := propDef.Type().TypeKind()
+	if propDefKind == common.PrimitiveTypeKind {
 		if data == nil && element == nil {
 			return nil, nil
 		}
@@ -0,0 +1,113 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package dynamic
+
+import (
+	"github.com/healthiop/hi/internal/common"
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/healthiop/hi/internal/stu3"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewDynResourceSTU3(t *testing.T) {
+	r, err := NewDynResource(stu3.TypeDefContainer(), map[string]interface{}{
+		"resourceType": "BodySite",
+		"id":           "test",
+	}, nil)
+	if assert.NoError(t, err) && assert.NotNil(t, r) {
+		assert.Equal(t, "BodySite", r.TypeName())
+	}
+}
+
+func TestNewDynResourceRenamed(t *testing.T) {
+	r, err := NewDynResource(stu3.TypeDefContainer(), map[string]interface{}{
+		"resourceType": "ServiceRequest",
+	}, nil)
+	assert.Nil(t, r)
+	if assert.Error(t, err) {
+		assert.Equal(t, "resource type undefined in FHIR STU3: ServiceRequest (use ProcedureRequest or ReferralRequest)", err.Error())
+	}
+}
+
+func TestResourceOf(t *testing.T) {
+	r, err := NewDynResource(r4.TypeDefContainer(), map[string]interface{}{
+		"resourceType":  "Patient",
+		"maritalStatus": map[string]interface{}{"text": "married"},
+	}, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	resource, ok := ResourceOf(r)
+	assert.True(t, ok)
+	assert.Same(t, r, resource)
+
+	maritalStatus, err := r.ElementProp("maritalStatus")
+	if assert.NoError(t, err) && assert.NotNil(t, maritalStatus) {
+		_, ok = ResourceOf(maritalStatus)
+		assert.False(t, ok)
+	}
+	_, ok = ResourceOf("Patient")
+	assert.False(t, ok)
+}
+
+func TestNewDynResourceUndefined(t *testing.T) {
+	r, err := NewDynResource(stu3.TypeDefContainer(), map[string]interface{}{
+		"resourceType": "Test",
+	}, nil)
+	assert.Nil(t, r)
+	if assert.Error(t, err) {
+		assert.Equal(t, "resource type undefined: Test", err.Error())
+	}
+}
+
+func TestNewDynResourceCustomType(t *testing.T) {
+	tdc := r4.NewTypeDefContainer()
+	td := common.NewStructTypeDef("CustomTask", tdc.MandatoryTypeByName(common.DomainResourceTypeName), false)
+	err := tdc.RegisterStructType(td, []*common.PropDef{
+		common.NewPropDef("description", tdc.MandatoryTypeByName(common.StringTypeName), "", 0, false, nil),
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	r, err := NewDynResource(tdc, map[string]interface{}{
+		"resourceType": "CustomTask",
+		"id":           "test",
+		"description":  "Test description",
+	}, nil)
+	if assert.NoError(t, err) && assert.NotNil(t, r) {
+		assert.Equal(t, "CustomTask", r.TypeName())
+		assert.Equal(t, "DomainResource", r.BaseTypeName())
+		description, err := r.StringPropValue("description")
+		assert.NoError(t, err)
+		assert.Equal(t, "Test description", description)
+	}
+	assert.Nil(t, r4.TypeDefContainer().TypeByName("CustomTask"))
+}
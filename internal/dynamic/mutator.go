@@ -0,0 +1,568 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package dynamic
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/healthiop/hi"
+	"github.com/healthiop/hi/internal/common"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	elementPrefix     = "_"
+	extensionPropName = "extension"
+)
+
+// SetPrimitive sets the value of the primitive property. If the value is
+// nil, the value is removed and the id and the extensions of the primitive
+// are kept.
+func (d *dynStruct) SetPrimitive(name string, value interface{}) error {
+	propDef, err := d.mutablePropDef(name)
+	if err != nil {
+		return err
+	}
+	typeDef, ok := propDef.Type().(common.PrimitiveTypeDefAccessor)
+	if !ok {
+		return fmt.Errorf("property %s of type %s has non-primitive type %s",
+			name, d.typeDef.InternalName(), propDef.Type().InternalName())
+	}
+	if propDef.Array() {
+		return fmt.Errorf("property %s of type %s is a list", name, d.typeDef.InternalName())
+	}
+
+	if value == nil {
+		delete(d.data, name)
+		return nil
+	}
+	v, err := primitiveData(typeDef, propDef.Enum(), value)
+	if err != nil {
+		return fmt.Errorf("property %s of type %s: %w", name, d.typeDef.InternalName(), err)
+	}
+	if err := d.checkChoice(propDef); err != nil {
+		return err
+	}
+	d.data[name] = v
+	return nil
+}
+
+// SetElement sets the element or resource of the property. If the value is
+// nil, the property is removed.
+func (d *dynStruct) SetElement(name string, value hi.DynStructAccessor) error {
+	propDef, err := d.mutablePropDef(name)
+	if err != nil {
+		return err
+	}
+	if _, ok := propDef.Type().(common.StructTypeDefAccessor); !ok {
+		return fmt.Errorf("property %s of type %s has primitive type %s",
+			name, d.typeDef.InternalName(), propDef.Type().InternalName())
+	}
+	if propDef.Array() {
+		return fmt.Errorf("property %s of type %s is a list", name, d.typeDef.InternalName())
+	}
+
+	if value == nil {
+		delete(d.data, name)
+		return nil
+	}
+	data, err := d.structData(d.typeDef, propDef, value)
+	if err != nil {
+		return err
+	}
+	if err := d.checkChoice(propDef); err != nil {
+		return err
+	}
+	d.data[name] = data
+	return nil
+}
+
+// ClearProp removes the value of the property. The id and the extensions of
+// primitive values are removed as well.
+func (d *dynStruct) ClearProp(name string) error {
+	if _, err := d.mutablePropDef(name); err != nil {
+		return err
+	}
+	delete(d.data, name)
+	delete(d.data, elementPrefix+name)
+	return nil
+}
+
+// AppendItem appends the value to the list property.
+func (d *dynStruct) AppendItem(name string, value interface{}) error {
+	return d.editList(name, func(propDef *common.PropDef, values, elements []interface{}) ([]interface{}, []interface{}, error) {
+		v, err := d.itemData(propDef, value)
+		if err != nil {
+			return nil, nil, err
+		}
+		return append(values, v), append(elements, nil), nil
+	})
+}
+
+// InsertItem inserts the value into the list property at the specified
+// index. The index may be equal to the number of items.
+func (d *dynStruct) InsertItem(name string, index int, value interface{}) error {
+	return d.editList(name, func(propDef *common.PropDef, values, elements []interface{}) ([]interface{}, []interface{}, error) {
+		if index < 0 || index > len(values) {
+			return nil, nil, fmt.Errorf("index %d of list property %s of type %s is out of range",
+				index, name, d.typeDef.InternalName())
+		}
+		v, err := d.itemData(propDef, value)
+		if err != nil {
+			return nil, nil, err
+		}
+		values = append(values[:index], append([]interface{}{v}, values[index:]...)...)
+		elements = append(elements[:index], append([]interface{}{nil}, elements[index:]...)...)
+		return values, elements, nil
+	})
+}
+
+// SetItem replaces the value of the list property at the specified index.
+// The id and the extensions of a primitive value are kept. The value of a
+// primitive may be nil if the primitive has an id or extensions.
+func (d *dynStruct) SetItem(name string, index int, value interface{}) error {
+	return d.editList(name, func(propDef *common.PropDef, values, elements []interface{}) ([]interface{}, []interface{}, error) {
+		if index < 0 || index >= len(values) {
+			return nil, nil, fmt.Errorf("index %d of list property %s of type %s is out of range",
+				index, name, d.typeDef.InternalName())
+		}
+		if value == nil && elements[index] != nil {
+			values[index] = nil
+			return values, elements, nil
+		}
+		v, err := d.itemData(propDef, value)
+		if err != nil {
+			return nil, nil, err
+		}
+		values[index] = v
+		return values, elements, nil
+	})
+}
+
+// RemoveItem removes the value of the list property at the specified index.
+func (d *dynStruct) RemoveItem(name string, index int) error {
+	return d.editList(name, func(propDef *common.PropDef, values, elements []interface{}) ([]interface{}, []interface{}, error) {
+		if index < 0 || index >= len(values) {
+			return nil, nil, fmt.Errorf("index %d of list property %s of type %s is out of range",
+				index, name, d.typeDef.InternalName())
+		}
+		return append(values[:index], values[index+1:]...), append(elements[:index], elements[index+1:]...), nil
+	})
+}
+
+// MoveItem moves the value of the list property at the source index to the
+// destination index. The destination index refers to the list without the
+// moved value. The id and the extensions of a primitive value are moved as
+// well.
+func (d *dynStruct) MoveItem(name string, source int, destination int) error {
+	return d.editList(name, func(propDef *common.PropDef, values, elements []interface{}) ([]interface{}, []interface{}, error) {
+		if source < 0 || source >= len(values) {
+			return nil, nil, fmt.Errorf("index %d of list property %s of type %s is out of range",
+				source, name, d.typeDef.InternalName())
+		}
+		if destination < 0 || destination >= len(values) {
+			return nil, nil, fmt.Errorf("index %d of list property %s of type %s is out of range",
+				destination, name, d.typeDef.InternalName())
+		}
+		v, e := values[source], elements[source]
+		values = append(values[:source], values[source+1:]...)
+		elements = append(elements[:source], elements[source+1:]...)
+		values = append(values[:destination], append([]interface{}{v}, values[destination:]...)...)
+		elements = append(elements[:destination], append([]interface{}{e}, elements[destination:]...)...)
+		return values, elements, nil
+	})
+}
+
+// SetPrimitiveExtensions sets the extensions of the primitive property. The
+// index must be -1 if the property is no list. If no extensions are
+// specified, the extensions are removed.
+func (d *dynStruct) SetPrimitiveExtensions(name string, index int, extensions []hi.DynElementAccessor) error {
+	propDef, err := d.mutablePropDef(name)
+	if err != nil {
+		return err
+	}
+	if _, ok := propDef.Type().(common.PrimitiveTypeDefAccessor); !ok {
+		return fmt.Errorf("property %s of type %s has non-primitive type %s",
+			name, d.typeDef.InternalName(), propDef.Type().InternalName())
+	}
+
+	var extensionData []interface{}
+	elementTypeDef := d.typeDefContainer.ElementType()
+	extensionPropDef := elementTypeDef.PropByName(extensionPropName)
+	for _, e := range extensions {
+		data, err := d.structData(elementTypeDef, extensionPropDef, e)
+		if err != nil {
+			return err
+		}
+		extensionData = append(extensionData, data)
+	}
+	setExtensions := func(element interface{}) interface{} {
+		e := make(map[string]interface{})
+		if m, ok := element.(map[string]interface{}); ok {
+			for k, v := range m {
+				e[k] = v
+			}
+		}
+		if len(extensionData) > 0 {
+			e[extensionPropName] = extensionData
+		} else {
+			delete(e, extensionPropName)
+		}
+		if len(e) == 0 {
+			return nil
+		}
+		return e
+	}
+
+	if propDef.Array() {
+		return d.editList(name, func(propDef *common.PropDef, values, elements []interface{}) ([]interface{}, []interface{}, error) {
+			if index < 0 || index >= len(values) {
+				return nil, nil, fmt.Errorf("index %d of list property %s of type %s is out of range",
+					index, name, d.typeDef.InternalName())
+			}
+			elements[index] = setExtensions(elements[index])
+			return values, elements, nil
+		})
+	}
+
+	if index != -1 {
+		return fmt.Errorf("property %s of type %s is not a list", name, d.typeDef.InternalName())
+	}
+	if len(extensionData) > 0 {
+		if err := d.checkChoice(propDef); err != nil {
+			return err
+		}
+	}
+	if e := setExtensions(d.data[elementPrefix+name]); e != nil {
+		d.data[elementPrefix+name] = e
+	} else {
+		delete(d.data, elementPrefix+name)
+	}
+	return nil
+}
+
+func (d *dynStruct) mutablePropDef(name string) (*common.PropDef, error) {
+	if d.data == nil {
+		return nil, fmt.Errorf("type %s has no data that can be modified", d.typeDef.InternalName())
+	}
+	return d.propDef(name)
+}
+
+// checkChoice checks that no other type of the choice of the property has
+// been set.
+func (d *dynStruct) checkChoice(propDef *common.PropDef) error {
+	if propDef.Choice() == "" {
+		return nil
+	}
+	for _, p := range d.typeDef.Props() {
+		if p != propDef && p.Choice() == propDef.Choice() &&
+			(d.data[p.Name()] != nil || d.data[elementPrefix+p.Name()] != nil) {
+			return fmt.Errorf("property %s of type %s cannot be set since property %s of the same choice has been set",
+				propDef.Name(), d.typeDef.InternalName(), p.Name())
+		}
+	}
+	return nil
+}
+
+// editList invokes the function with the values and the elements of the
+// list property. Both have the same length. The results are stored without
+// trailing empty lists.
+func (d *dynStruct) editList(name string, f func(propDef *common.PropDef, values, elements []interface{}) ([]interface{}, []interface{}, error)) error {
+	propDef, err := d.mutablePropDef(name)
+	if err != nil {
+		return err
+	}
+	if !propDef.Array() {
+		return fmt.Errorf("property %s of type %s is not a list", name, d.typeDef.InternalName())
+	}
+	_, primitive := propDef.Type().(common.PrimitiveTypeDefAccessor)
+
+	values, err := d.listData(name)
+	if err != nil {
+		return err
+	}
+	var elements []interface{}
+	if primitive {
+		if elements, err = d.listData(elementPrefix + name); err != nil {
+			return err
+		}
+	}
+	for len(values) < len(elements) {
+		values = append(values, nil)
+	}
+	for len(elements) < len(values) {
+		elements = append(elements, nil)
+	}
+
+	values, elements, err = f(propDef, values, elements)
+	if err != nil {
+		return err
+	}
+	d.setListData(name, values)
+	if primitive {
+		d.setListData(elementPrefix+name, elements)
+	}
+	return nil
+}
+
+// listData returns a copy of the list data of the property.
+func (d *dynStruct) listData(name string) ([]interface{}, error) {
+	data := d.data[name]
+	if data == nil {
+		return nil, nil
+	}
+	items, ok := data.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("list property %s of type %s contains invalid list data with type %T",
+			name, d.typeDef.InternalName(), data)
+	}
+	return append([]interface{}(nil), items...), nil
+}
+
+func (d *dynStruct) setListData(name string, items []interface{}) {
+	for _, item := range items {
+		if item != nil {
+			d.data[name] = items
+			return
+		}
+	}
+	delete(d.data, name)
+}
+
+// itemData returns the data of a value of the property. Primitive values
+// are checked and struct values must be accessors.
+func (d *dynStruct) itemData(propDef *common.PropDef, value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, fmt.Errorf("value of property %s of type %s must not be nil",
+			propDef.Name(), d.typeDef.InternalName())
+	}
+	if typeDef, ok := propDef.Type().(common.PrimitiveTypeDefAccessor); ok {
+		v, err := primitiveData(typeDef, propDef.Enum(), value)
+		if err != nil {
+			return nil, fmt.Errorf("property %s of type %s: %w", propDef.Name(), d.typeDef.InternalName(), err)
+		}
+		return v, nil
+	}
+	s, ok := value.(hi.DynStructAccessor)
+	if !ok {
+		return nil, fmt.Errorf("value of property %s of type %s must be a struct accessor: %T",
+			propDef.Name(), d.typeDef.InternalName(), value)
+	}
+	return d.structData(d.typeDef, propDef, s)
+}
+
+// structData returns the data of the element or resource after checking
+// that it can be used as value of the property of the owner type.
+func (d *dynStruct) structData(ownerTypeDef common.TypeDefAccessor, propDef *common.PropDef, value hi.DynStructAccessor) (map[string]interface{}, error) {
+	if value.VersionString() != d.VersionString() {
+		return nil, fmt.Errorf("value of property %s of type %s has FHIR version %s instead of %s",
+			propDef.Name(), ownerTypeDef.InternalName(), value.VersionString(), d.VersionString())
+	}
+	typeDefRetriever, ok := value.(DynTypeDefRetriever)
+	if !ok {
+		return nil, fmt.Errorf("type of value of property %s of type %s cannot be accessed: %T",
+			propDef.Name(), ownerTypeDef.InternalName(), value)
+	}
+	valueTypeDef, ok := typeDefRetriever.Type().(common.StructTypeDefAccessor)
+	if !ok || !valueTypeDef.ExtendsTypeName(propDef.Type().InternalName()) {
+		return nil, fmt.Errorf("value of property %s of type %s has type %s instead of %s",
+			propDef.Name(), ownerTypeDef.InternalName(), typeDefRetriever.Type().InternalName(),
+			propDef.Type().InternalName())
+	}
+	if _, ok := value.(hi.DynPrimitiveAccessor); ok {
+		return nil, fmt.Errorf("value of property %s of type %s must not be a primitive",
+			propDef.Name(), ownerTypeDef.InternalName())
+	}
+	dataRetriever, ok := value.(DynDataRetriever)
+	if !ok || dataRetriever.Data() == nil {
+		return nil, fmt.Errorf("data of value of property %s of type %s cannot be accessed",
+			propDef.Name(), ownerTypeDef.InternalName())
+	}
+
+	data := dataRetriever.Data()
+	if err := checkStructData(d.typeDefContainer, valueTypeDef, data, valueTypeDef.InternalName()); err != nil {
+		return nil, fmt.Errorf("value of property %s of type %s is invalid: %w",
+			propDef.Name(), ownerTypeDef.InternalName(), err)
+	}
+	return data, nil
+}
+
+// checkStructData checks that the data matches the type definition of the
+// struct. The path is used in error messages.
+func checkStructData(typeDefContainer *common.TypeDefContainer, typeDef common.StructTypeDefAccessor, data map[string]interface{}, path string) error {
+	names := make([]string, 0, len(data))
+	for name := range data {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	choices := make(map[string]string)
+	for _, name := range names {
+		value := data[name]
+		if name == ResourceTypePropName && typeDef.TypeKind() == common.ResourceTypeKind {
+			if value != typeDef.Name() {
+				return fmt.Errorf("%s: resource type %v does not match", path, value)
+			}
+			continue
+		}
+
+		propName := strings.TrimPrefix(name, elementPrefix)
+		propDef := typeDef.PropByName(propName)
+		if propDef == nil {
+			return fmt.Errorf("%s: property %s is not defined by type %s", path, propName, typeDef.InternalName())
+		}
+		if propDef.Choice() != "" {
+			if other, ok := choices[propDef.Choice()]; ok && other != propName {
+				return fmt.Errorf("%s: properties %s and %s of the same choice have been set", path, other, propName)
+			}
+			choices[propDef.Choice()] = propName
+		}
+
+		propPath := path + "." + name
+		if name != propName {
+			if _, ok := propDef.Type().(common.PrimitiveTypeDefAccessor); !ok {
+				return fmt.Errorf("%s: property has non-primitive type %s", propPath, propDef.Type().InternalName())
+			}
+		}
+		if propDef.Array() {
+			items, ok := value.([]interface{})
+			if !ok {
+				return fmt.Errorf("%s: list property contains data with type %T", propPath, value)
+			}
+			for i, item := range items {
+				itemPath := propPath + "[" + strconv.Itoa(i) + "]"
+				if err := checkPropData(typeDefContainer, propDef, name != propName, item, itemPath); err != nil {
+					return err
+				}
+			}
+		} else if err := checkPropData(typeDefContainer, propDef, name != propName, value, propPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkPropData checks a single value of the property. Values of primitive
+// lists and elements of primitives may be nil.
+func checkPropData(typeDefContainer *common.TypeDefContainer, propDef *common.PropDef, element bool, value interface{}, path string) error {
+	typeDef := propDef.Type()
+	primitiveTypeDef, primitive := typeDef.(common.PrimitiveTypeDefAccessor)
+	if value == nil {
+		if primitive && propDef.Array() {
+			return nil
+		}
+		return fmt.Errorf("%s: value must not be nil", path)
+	}
+
+	if element {
+		typeDef = typeDefContainer.ElementType()
+	} else if primitive {
+		if _, err := primitiveData(primitiveTypeDef, propDef.Enum(), value); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		return nil
+	}
+
+	data, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("%s: struct property contains data with type %T", path, value)
+	}
+	structTypeDef := typeDef.(common.StructTypeDefAccessor)
+	if structTypeDef.TypeKind() == common.ResourceTypeKind {
+		resourceType, _ := data[ResourceTypePropName].(string)
+		resourceTypeDef, ok := typeDefContainer.TypeByName(resourceType).(common.StructTypeDefAccessor)
+		if !ok || resourceTypeDef.TypeKind() != common.ResourceTypeKind {
+			return fmt.Errorf("%s: resource type undefined: %s", path, resourceType)
+		}
+		if !resourceTypeDef.ExtendsTypeName(structTypeDef.InternalName()) {
+			return fmt.Errorf("%s: resource type %s is not a %s", path, resourceType, structTypeDef.InternalName())
+		}
+		structTypeDef = resourceTypeDef
+	}
+	return checkStructData(typeDefContainer, structTypeDef, data, path)
+}
+
+// primitiveData checks the value of a primitive and returns the data that is
+// stored. Integers are stored as json.Number.
+func primitiveData(typeDef common.PrimitiveTypeDefAccessor, enum []string, value interface{}) (interface{}, error) {
+	var s string
+	switch typeDef.SimpleType() {
+	case common.StringSimpleType:
+		v, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("value of type %s must be a string: %T", typeDef.Name(), value)
+		}
+		if v == "" {
+			return nil, fmt.Errorf("value of type %s must not be empty", typeDef.Name())
+		}
+		s = v
+	case common.NumberSimpleType:
+		switch v := value.(type) {
+		case float64:
+			s = strconv.FormatFloat(v, 'f', -1, 64)
+		case json.Number:
+			if _, err := v.Float64(); err != nil {
+				return nil, fmt.Errorf("value of type %s is not a valid number: %s", typeDef.Name(), v)
+			}
+			s = v.String()
+		case int:
+			s = strconv.Itoa(v)
+			value = json.Number(s)
+		case int64:
+			s = strconv.FormatInt(v, 10)
+			value = json.Number(s)
+		default:
+			return nil, fmt.Errorf("value of type %s must be a number: %T", typeDef.Name(), value)
+		}
+	case common.BoolSimpleType:
+		if _, ok := value.(bool); !ok {
+			return nil, fmt.Errorf("value of type %s must be a boolean: %T", typeDef.Name(), value)
+		}
+		return value, nil
+	}
+
+	if pattern := typeDef.Pattern(); pattern != nil && !pattern.MatchString(s) {
+		return nil, fmt.Errorf("value is not a valid %s: %s", typeDef.Name(), s)
+	}
+	if len(enum) > 0 && !containsString(enum, s) {
+		return nil, fmt.Errorf("code is not allowed: %s (allowed: %s)", s, strings.Join(enum, ", "))
+	}
+	return value, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,386 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package dynamic
+
+import (
+	"encoding/json"
+	"github.com/healthiop/hi"
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/healthiop/hi/internal/stu3"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func newTestMutator(t *testing.T, data map[string]interface{}) hi.DynStructMutator {
+	r, err := NewDynResource(r4.TypeDefContainer(), data, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r.(hi.DynStructMutator)
+}
+
+func newTestElement(t *testing.T, typeName string, data map[string]interface{}) hi.DynStructMutator {
+	tdc := r4.TypeDefContainer()
+	e, err := NewDynElement(tdc, tdc.MandatoryStructTypeByName(typeName), data, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e.(hi.DynStructMutator)
+}
+
+func TestSetPrimitive(t *testing.T) {
+	data := map[string]interface{}{"resourceType": "Patient"}
+	m := newTestMutator(t, data)
+
+	assert.NoError(t, m.SetPrimitive("gender", "female"))
+	assert.NoError(t, m.SetPrimitive("active", true))
+	assert.NoError(t, m.SetPrimitive("birthDate", "1970-03-30"))
+	assert.NoError(t, m.SetPrimitive("multipleBirthInteger", 2))
+	assert.Equal(t, map[string]interface{}{
+		"resourceType":         "Patient",
+		"gender":               "female",
+		"active":               true,
+		"birthDate":            "1970-03-30",
+		"multipleBirthInteger": json.Number("2"),
+	}, data)
+
+	n, err := m.NumberPropValue("multipleBirthInteger")
+	assert.NoError(t, err)
+	assert.Equal(t, 2.0, n)
+
+	assert.NoError(t, m.SetPrimitive("active", nil))
+	_, found := data["active"]
+	assert.False(t, found)
+}
+
+func TestSetPrimitiveInvalid(t *testing.T) {
+	m := newTestMutator(t, map[string]interface{}{"resourceType": "Patient"})
+	tests := []struct {
+		name  string
+		prop  string
+		value interface{}
+		err   string
+	}{
+		{"undefined", "test", "x", "type Patient has no property named test"},
+		{"non-primitive", "maritalStatus", "x", "property maritalStatus of type Patient has non-primitive type CodeableConcept"},
+		{"list", "photo", "x", "property photo of type Patient has non-primitive type Attachment"},
+		{"type", "active", "true", "property active of type Patient: value of type boolean must be a boolean: string"},
+		{"number", "multipleBirthInteger", "2", "property multipleBirthInteger of type Patient: value of type integer must be a number: string"},
+		{"pattern", "birthDate", "1970-3-30", "property birthDate of type Patient: value is not a valid date: 1970-3-30"},
+		{"integer", "multipleBirthInteger", 1.5, "property multipleBirthInteger of type Patient: value is not a valid integer: 1.5"},
+		{"enum", "gender", "test", "property gender of type Patient: code is not allowed: test (allowed: male, female, other, unknown)"},
+		{"empty", "gender", "", "property gender of type Patient: value of type string must not be empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := m.SetPrimitive(tt.prop, tt.value)
+			if assert.Error(t, err) {
+				assert.Equal(t, tt.err, err.Error())
+			}
+		})
+	}
+}
+
+func TestSetPrimitiveChoice(t *testing.T) {
+	data := map[string]interface{}{"resourceType": "Observation"}
+	m := newTestMutator(t, data)
+	assert.NoError(t, m.SetPrimitive("valueBoolean", false))
+	err := m.SetPrimitive("valueString", "Test")
+	if assert.Error(t, err) {
+		assert.Equal(t, "property valueString of type Observation cannot be set since property valueBoolean of the same choice has been set", err.Error())
+	}
+	assert.NoError(t, m.SetPrimitive("valueBoolean", true))
+	assert.NoError(t, m.ClearProp("valueBoolean"))
+	assert.NoError(t, m.SetPrimitive("valueString", "Test"))
+	assert.Equal(t, map[string]interface{}{"resourceType": "Observation", "valueString": "Test"}, data)
+
+	err = m.SetElement("valueQuantity", newTestElement(t, "Quantity", map[string]interface{}{"value": 1.5}))
+	if assert.Error(t, err) {
+		assert.Equal(t, "property valueQuantity of type Observation cannot be set since property valueString of the same choice has been set", err.Error())
+	}
+}
+
+func TestSetElement(t *testing.T) {
+	data := map[string]interface{}{"resourceType": "Patient"}
+	m := newTestMutator(t, data)
+
+	cc := newTestElement(t, "CodeableConcept", map[string]interface{}{})
+	assert.NoError(t, cc.SetPrimitive("text", "Married"))
+	assert.NoError(t, m.SetElement("maritalStatus", cc))
+	assert.Equal(t, map[string]interface{}{"text": "Married"}, data["maritalStatus"])
+
+	e, err := m.ElementProp("maritalStatus")
+	if assert.NoError(t, err) {
+		assert.NoError(t, e.(hi.DynStructMutator).SetPrimitive("text", "Divorced"))
+		assert.Equal(t, map[string]interface{}{"text": "Divorced"}, data["maritalStatus"])
+	}
+
+	assert.NoError(t, m.SetElement("maritalStatus", nil))
+	assert.Equal(t, map[string]interface{}{"resourceType": "Patient"}, data)
+}
+
+func TestSetElementResource(t *testing.T) {
+	data := map[string]interface{}{"resourceType": "Bundle", "type": "collection"}
+	m := newTestMutator(t, data)
+	entry := newTestElement(t, "Bundle_Entry", map[string]interface{}{})
+	assert.NoError(t, entry.SetElement("resource", newTestMutator(t, map[string]interface{}{
+		"resourceType": "Patient",
+		"id":           "p1",
+	})))
+	err := entry.SetElement("resource", newTestElement(t, "CodeableConcept", map[string]interface{}{}))
+	if assert.Error(t, err) {
+		assert.Equal(t, "value of property resource of type Bundle_Entry has type CodeableConcept instead of Resource", err.Error())
+	}
+	assert.NoError(t, m.AppendItem("entry", entry))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"resource": map[string]interface{}{"resourceType": "Patient", "id": "p1"}},
+	}, data["entry"])
+}
+
+func TestSetElementInvalid(t *testing.T) {
+	m := newTestMutator(t, map[string]interface{}{"resourceType": "Patient"})
+	tests := []struct {
+		name  string
+		prop  string
+		value hi.DynStructAccessor
+		err   string
+	}{
+		{"primitive prop", "gender", newTestElement(t, "CodeableConcept", map[string]interface{}{}),
+			"property gender of type Patient has primitive type string"},
+		{"list", "name", newTestElement(t, "HumanName", map[string]interface{}{}),
+			"property name of type Patient is a list"},
+		{"type", "maritalStatus", newTestElement(t, "Coding", map[string]interface{}{}),
+			"value of property maritalStatus of type Patient has type Coding instead of CodeableConcept"},
+		{"invalid data", "maritalStatus", newTestElement(t, "CodeableConcept", map[string]interface{}{
+			"coding": []interface{}{map[string]interface{}{"system": "http://example.com", "code": 1.0}},
+		}), "value of property maritalStatus of type Patient is invalid: CodeableConcept.coding[0].code: value of type code must be a string: float64"},
+		{"undefined data", "maritalStatus", newTestElement(t, "CodeableConcept", map[string]interface{}{"test": "1"}),
+			"value of property maritalStatus of type Patient is invalid: CodeableConcept: property test is not defined by type CodeableConcept"},
+		{"list data", "maritalStatus", newTestElement(t, "CodeableConcept", map[string]interface{}{"coding": map[string]interface{}{}}),
+			"value of property maritalStatus of type Patient is invalid: CodeableConcept.coding: list property contains data with type map[string]interface {}"},
+		{"no data", "maritalStatus", newTestElement(t, "CodeableConcept", nil),
+			"data of value of property maritalStatus of type Patient cannot be accessed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := m.SetElement(tt.prop, tt.value)
+			if assert.Error(t, err) {
+				assert.Equal(t, tt.err, err.Error())
+			}
+		})
+	}
+}
+
+func TestSetElementVersion(t *testing.T) {
+	m := newTestMutator(t, map[string]interface{}{"resourceType": "Patient"})
+	tdc := stu3.TypeDefContainer()
+	cc, _ := NewDynElement(tdc, tdc.MandatoryStructTypeByName("CodeableConcept"), map[string]interface{}{}, nil)
+	err := m.SetElement("maritalStatus", cc)
+	if assert.Error(t, err) {
+		assert.Equal(t, "value of property maritalStatus of type Patient has FHIR version 3.0.2 instead of 4.0.1", err.Error())
+	}
+}
+
+func TestListItems(t *testing.T) {
+	data := map[string]interface{}{}
+	m := newTestElement(t, "HumanName", data)
+
+	assert.NoError(t, m.AppendItem("given", "Jane"))
+	assert.NoError(t, m.AppendItem("given", "Mary"))
+	assert.NoError(t, m.InsertItem("given", 1, "Ann"))
+	assert.NoError(t, m.InsertItem("given", 0, "Dr"))
+	assert.Equal(t, []interface{}{"Dr", "Jane", "Ann", "Mary"}, data["given"])
+
+	assert.NoError(t, m.RemoveItem("given", 0))
+	assert.NoError(t, m.SetItem("given", 2, "Maria"))
+	assert.Equal(t, []interface{}{"Jane", "Ann", "Maria"}, data["given"])
+
+	for _, err := range []error{
+		m.InsertItem("given", 4, "X"),
+		m.RemoveItem("given", 3),
+		m.SetItem("given", -1, "X"),
+	} {
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "of list property given of type HumanName is out of range")
+		}
+	}
+	err := m.SetItem("given", 0, nil)
+	if assert.Error(t, err) {
+		assert.Equal(t, "value of property given of type HumanName must not be nil", err.Error())
+	}
+	err = m.AppendItem("family", "Doe")
+	if assert.Error(t, err) {
+		assert.Equal(t, "property family of type HumanName is not a list", err.Error())
+	}
+	err = m.AppendItem("period", "Doe")
+	if assert.Error(t, err) {
+		assert.Equal(t, "property period of type HumanName is not a list", err.Error())
+	}
+
+	assert.NoError(t, m.MoveItem("given", 0, 2))
+	assert.Equal(t, []interface{}{"Ann", "Maria", "Jane"}, data["given"])
+	assert.NoError(t, m.MoveItem("given", 2, 0))
+	assert.Equal(t, []interface{}{"Jane", "Ann", "Maria"}, data["given"])
+	for _, err := range []error{
+		m.MoveItem("given", 3, 0),
+		m.MoveItem("given", 0, 3),
+	} {
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "of list property given of type HumanName is out of range")
+		}
+	}
+
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, m.RemoveItem("given", 0))
+	}
+	assert.Equal(t, map[string]interface{}{}, data)
+}
+
+func TestListItemsStruct(t *testing.T) {
+	data := map[string]interface{}{"resourceType": "Patient"}
+	m := newTestMutator(t, data)
+	name := newTestElement(t, "HumanName", map[string]interface{}{"family": "Doe"})
+	assert.NoError(t, m.AppendItem("name", name))
+	err := m.AppendItem("name", "Doe")
+	if assert.Error(t, err) {
+		assert.Equal(t, "value of property name of type Patient must be a struct accessor: string", err.Error())
+	}
+	err = m.AppendItem("name", newTestElement(t, "Address", map[string]interface{}{}))
+	if assert.Error(t, err) {
+		assert.Equal(t, "value of property name of type Patient has type Address instead of HumanName", err.Error())
+	}
+	assert.Equal(t, []interface{}{map[string]interface{}{"family": "Doe"}}, data["name"])
+}
+
+func TestPrimitiveExtensions(t *testing.T) {
+	data := map[string]interface{}{
+		"resourceType": "Patient",
+		"name": []interface{}{
+			map[string]interface{}{"given": []interface{}{"Jane", "Mary"}},
+		},
+	}
+	m := newTestMutator(t, data)
+	ext := newTestElement(t, "Extension", map[string]interface{}{
+		"url":       "http://example.com/source",
+		"valueCode": "self",
+	})
+
+	assert.NoError(t, m.SetPrimitiveExtensions("gender", -1, []hi.DynElementAccessor{ext}))
+	assert.Equal(t, map[string]interface{}{"extension": []interface{}{ext.(DynDataRetriever).Data()}}, data["_gender"])
+	p, err := m.PrimitiveProp("gender")
+	if assert.NoError(t, err) && assert.NotNil(t, p) {
+		assert.True(t, p.NilValue())
+	}
+	assert.NoError(t, m.SetPrimitiveExtensions("gender", -1, nil))
+	_, found := data["_gender"]
+	assert.False(t, found)
+
+	nameElement := newTestElement(t, "HumanName", data["name"].([]interface{})[0].(map[string]interface{}))
+	assert.NoError(t, nameElement.SetPrimitiveExtensions("given", 1, []hi.DynElementAccessor{ext}))
+	assert.NoError(t, nameElement.SetItem("given", 1, nil))
+	assert.NoError(t, nameElement.InsertItem("given", 0, "Dr"))
+	name := data["name"].([]interface{})[0]
+	assert.Equal(t, map[string]interface{}{
+		"given":  []interface{}{"Dr", "Jane", nil},
+		"_given": []interface{}{nil, nil, map[string]interface{}{"extension": []interface{}{ext.(DynDataRetriever).Data()}}},
+	}, name)
+
+	assert.NoError(t, nameElement.MoveItem("given", 2, 0))
+	assert.Equal(t, map[string]interface{}{
+		"given":  []interface{}{nil, "Dr", "Jane"},
+		"_given": []interface{}{map[string]interface{}{"extension": []interface{}{ext.(DynDataRetriever).Data()}}, nil, nil},
+	}, name)
+	assert.NoError(t, nameElement.MoveItem("given", 0, 2))
+	assert.NoError(t, nameElement.RemoveItem("given", 2))
+	assert.Equal(t, map[string]interface{}{"given": []interface{}{"Dr", "Jane"}}, name)
+
+	err = m.SetPrimitiveExtensions("gender", 0, nil)
+	if assert.Error(t, err) {
+		assert.Equal(t, "property gender of type Patient is not a list", err.Error())
+	}
+	err = m.SetPrimitiveExtensions("gender", -1, []hi.DynElementAccessor{newTestElement(t, "Coding", map[string]interface{}{})})
+	if assert.Error(t, err) {
+		assert.Equal(t, "value of property extension of type Element has type Coding instead of Extension", err.Error())
+	}
+	err = m.SetPrimitiveExtensions("maritalStatus", -1, nil)
+	if assert.Error(t, err) {
+		assert.Equal(t, "property maritalStatus of type Patient has non-primitive type CodeableConcept", err.Error())
+	}
+}
+
+func TestPrimitiveExtensionsChoice(t *testing.T) {
+	m := newTestMutator(t, map[string]interface{}{"resourceType": "Observation", "valueBoolean": true})
+	ext := newTestElement(t, "Extension", map[string]interface{}{"url": "http://example.com/test"})
+	err := m.SetPrimitiveExtensions("valueString", -1, []hi.DynElementAccessor{ext})
+	if assert.Error(t, err) {
+		assert.Equal(t, "property valueString of type Observation cannot be set since property valueBoolean of the same choice has been set", err.Error())
+	}
+}
+
+func TestMutatorNoData(t *testing.T) {
+	m := newTestElement(t, "HumanName", nil)
+	err := m.SetPrimitive("family", "Doe")
+	if assert.Error(t, err) {
+		assert.Equal(t, "type HumanName has no data that can be modified", err.Error())
+	}
+}
+
+func TestCheckStructDataResource(t *testing.T) {
+	tdc := r4.TypeDefContainer()
+	patient := tdc.MandatoryStructTypeByName("Patient")
+	assert.NoError(t, checkStructData(tdc, patient, map[string]interface{}{
+		"resourceType": "Patient",
+		"contained":    []interface{}{map[string]interface{}{"resourceType": "Organization", "name": "Test"}},
+	}, "Patient"))
+
+	err := checkStructData(tdc, patient, map[string]interface{}{
+		"resourceType": "Patient",
+		"contained":    []interface{}{map[string]interface{}{"resourceType": "Test"}},
+	}, "Patient")
+	if assert.Error(t, err) {
+		assert.Equal(t, "Patient.contained[0]: resource type undefined: Test", err.Error())
+	}
+
+	err = checkStructData(tdc, tdc.MandatoryStructTypeByName("Observation"), map[string]interface{}{
+		"resourceType": "Observation",
+		"valueBoolean": true,
+		"_valueString": map[string]interface{}{"id": "v1"},
+	}, "Observation")
+	if assert.Error(t, err) {
+		assert.Equal(t, "Observation: properties valueString and valueBoolean of the same choice have been set", err.Error())
+	}
+
+	err = checkStructData(tdc, patient, map[string]interface{}{
+		"resourceType": "Patient",
+		"_name":        map[string]interface{}{},
+	}, "Patient")
+	if assert.Error(t, err) {
+		assert.Equal(t, "Patient._name: property has non-primitive type HumanName", err.Error())
+	}
+}
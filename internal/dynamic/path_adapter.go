@@ -29,10 +29,12 @@
 package dynamic
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/healthiop/hi"
 	"github.com/healthiop/hi/internal/common"
 	"github.com/healthiop/hipath/hipathsys"
+	"strconv"
 )
 
 const UCUMSystemURI = "http://unitsofmeasure.org"
@@ -41,61 +43,95 @@ type PathDynModel struct {
 	typeDefContainer *common.TypeDefContainer
 }
 
-func (p *PathDynModel) ConvertToSystem(node interface{}) (interface{}, error) {
+var _ hipathsys.ModelAdapter = (*PathDynModel)(nil)
+
+// PathChild is a child node that is returned by PathDynModel.NamedChildren.
+// The index is -1 if the property is not a list.
+type PathChild struct {
+	Name  string
+	Index int
+	Node  interface{}
+}
+
+func NewPathDynModel(typeDefContainer *common.TypeDefContainer) *PathDynModel {
+	return &PathDynModel{typeDefContainer}
+}
+
+// CastToSystem converts primitive values and UCUM quantities to the
+// corresponding FHIRPath system types. The source of the system value is the
+// converted node. Other nodes and primitives without value result in nil.
+func (p *PathDynModel) CastToSystem(node interface{}) (hipathsys.AnyAccessor, error) {
+	if a, ok := node.(hipathsys.AnyAccessor); ok {
+		return a, nil
+	}
 	if p, ok := node.(hi.DynPrimitiveAccessor); ok {
-		if p.NilValue() {
-			return nil, nil
-		}
-		switch p.TypeName() {
-		case common.BooleanTypeName:
-			v, err := p.BoolValue()
-			if err != nil {
-				return nil, err
-			}
-			return hipathsys.NewBooleanWithSource(v, p), nil
-		case common.StringTypeName, common.URITypeName, common.CodeTypeName, common.OIDTypeName, common.IDTypeName, common.UUIDTypeName, common.SIDTypeName, common.MarkdownTypeName, common.Base64BinaryTypeName:
-			v, err := p.StringValue()
-			if err != nil {
-				return nil, err
-			}
-			return hipathsys.NewStringWithSource(v, p), nil
-		case common.IntegerTypeName, common.PositiveIntTypeName, common.UnsignedIntTypeName:
-			v, err := p.NumberValue()
-			if err != nil {
-				return nil, err
-			}
-			return hipathsys.NewIntegerWithSource(int32(v), p), nil
-		case common.DecimalTypeName:
-			v, err := p.StringValue()
-			if err != nil {
-				return nil, err
-			}
-			return hipathsys.ParseDecimalWithSource(v, p)
-		case common.DateTypeName, common.DateTimeTypeName, common.InstantTypeName:
-			v, err := p.StringValue()
-			if err != nil {
-				return nil, err
-			}
-			return hipathsys.ParseDateTimeWithSource(v, p)
-		case common.TimeTypeName:
-			v, err := p.StringValue()
-			if err != nil {
-				return nil, err
-			}
-			return hipathsys.ParseTimeWithSource(v, p)
-		default:
-			return nil, nil
-		}
+		return convertPrimitiveToSystem(p)
 	}
 	if e, ok := node.(hi.DynElementAccessor); ok {
 		return p.convertElementToSystem(e)
 	}
-	return p, nil
+	return nil, nil
 }
 
-func (p *PathDynModel) convertElementToSystem(element hi.DynElementAccessor) (interface{}, error) {
+func convertPrimitiveToSystem(p hi.DynPrimitiveAccessor) (hipathsys.AnyAccessor, error) {
+	if p.NilValue() {
+		return nil, nil
+	}
+
+	switch p.TypeName() {
+	case common.DateTypeName:
+		v, err := p.StringValue()
+		if err != nil {
+			return nil, err
+		}
+		return hipathsys.ParseDateWithSource(v, p)
+	case common.DateTimeTypeName, common.InstantTypeName:
+		v, err := p.StringValue()
+		if err != nil {
+			return nil, err
+		}
+		return hipathsys.ParseDateTimeWithSource(v, p)
+	case common.TimeTypeName:
+		v, err := p.StringValue()
+		if err != nil {
+			return nil, err
+		}
+		return hipathsys.ParseTimeWithSource(v, p)
+	}
+
+	switch p.SimpleType() {
+	case hi.BoolSimpleType:
+		v, err := p.BoolValue()
+		if err != nil {
+			return nil, err
+		}
+		return hipathsys.NewBooleanWithSource(v, p), nil
+	case hi.NumberSimpleType:
+		v, err := p.NumberValue()
+		if err != nil {
+			return nil, err
+		}
+		if p.TypeName() == common.DecimalTypeName {
+			if d, ok := p.(*dynPrimitive); ok {
+				if n, ok := d.value.(json.Number); ok {
+					return hipathsys.ParseDecimalWithSource(n.String(), p)
+				}
+			}
+			return hipathsys.ParseDecimalWithSource(strconv.FormatFloat(v, 'f', -1, 64), p)
+		}
+		return hipathsys.NewIntegerWithSource(int32(v), p), nil
+	default:
+		v, err := p.StringValue()
+		if err != nil {
+			return nil, err
+		}
+		return hipathsys.NewStringWithSource(v, p), nil
+	}
+}
+
+func (p *PathDynModel) convertElementToSystem(element hi.DynElementAccessor) (hipathsys.AnyAccessor, error) {
 	if element.TypeName() != common.QuantityTypeName {
-		return element, nil
+		return nil, nil
 	}
 
 	system, err := element.StringPropValue("system")
@@ -103,7 +139,7 @@ func (p *PathDynModel) convertElementToSystem(element hi.DynElementAccessor) (in
 		return nil, fmt.Errorf("error when accessing system property of Quantity: %w", err)
 	}
 	if system != UCUMSystemURI {
-		return element, nil
+		return nil, nil
 	}
 
 	code, err := element.StringPropValue("code")
@@ -111,14 +147,14 @@ func (p *PathDynModel) convertElementToSystem(element hi.DynElementAccessor) (in
 		return nil, fmt.Errorf("error when accessing code property of Quantity: %w", err)
 	}
 	if len(code) == 0 {
-		return element, nil
+		return nil, nil
 	}
 
 	valueProp, err := element.PrimitiveProp("value")
 	if valueProp == nil || err != nil {
 		return nil, err
 	}
-	value, err := p.ConvertToSystem(valueProp)
+	value, err := p.CastToSystem(valueProp)
 	if value == nil || err != nil {
 		return nil, err
 	}
@@ -149,6 +185,9 @@ func (p *PathDynModel) convertElementToSystem(element hi.DynElementAccessor) (in
 }
 
 func (p *PathDynModel) TypeSpec(node interface{}) hipathsys.TypeSpecAccessor {
+	if a, ok := node.(hipathsys.AnyAccessor); ok && a.Source() != nil {
+		node = a.Source()
+	}
 	typeDefRetriever, ok := node.(DynTypeDefRetriever)
 	if !ok {
 		return nil
@@ -156,22 +195,257 @@ func (p *PathDynModel) TypeSpec(node interface{}) hipathsys.TypeSpecAccessor {
 	return &PathTypeSpec{typeDefRetriever.Type()}
 }
 
-func (p *PathDynModel) Cast(node interface{}, name hipathsys.FQTypeNameAccessor) (interface{}, error) {
-	panic("implement me")
+// TypeSpecByName returns the type specification of the type with the
+// specified name. If the type is undefined, nil is returned.
+func (p *PathDynModel) TypeSpecByName(name hipathsys.FQTypeNameAccessor) hipathsys.TypeSpecAccessor {
+	if typeSpec := NewPathTypeSpec(p.typeDefContainer, name); typeSpec != nil {
+		return typeSpec
+	}
+	return nil
+}
+
+// AsType returns the node if it has the specified type or a type that is
+// derived from the specified type. Otherwise nil is returned.
+func (p *PathDynModel) AsType(node interface{}, name hipathsys.FQTypeNameAccessor) (interface{}, error) {
+	typeSpec := p.TypeSpec(node)
+	if typeSpec == nil || !typeSpec.ExtendsName(name) {
+		return nil, nil
+	}
+	return node, nil
 }
 
 func (p *PathDynModel) Equal(node1 interface{}, node2 interface{}) bool {
-	panic("implement me")
+	return pathNodesEqual(node1, node2, false)
 }
 
 func (p *PathDynModel) Equivalent(node1 interface{}, node2 interface{}) bool {
-	panic("implement me")
+	return pathNodesEqual(node1, node2, true)
 }
 
+// Navigate returns the values of the property with the specified name. If
+// the node is a collection, the values of all its items are returned. A
+// choice property can be accessed by its name without type suffix. Primitive
+// values and UCUM quantities are returned as system types. If there is no value, an empty
+// collection is returned in order to allow further navigation.
 func (p *PathDynModel) Navigate(node interface{}, name string) (interface{}, error) {
-	panic("implement me")
+	result := hipathsys.NewCol(p)
+	if col, ok := node.(hipathsys.ColAccessor); ok {
+		for i := 0; i < col.Count(); i++ {
+			if err := p.navigateStruct(pathStruct(col.Get(i)), name, result); err != nil {
+				return nil, err
+			}
+		}
+	} else if err := p.navigateStruct(pathStruct(node), name, result); err != nil {
+		return nil, err
+	}
+
+	if result.Count() == 1 {
+		return result.Get(0), nil
+	}
+	return result, nil
 }
 
+// Children returns the values of all properties of the node.
 func (p *PathDynModel) Children(node interface{}) (hipathsys.ColAccessor, error) {
-	panic("implement me")
+	children, err := p.NamedChildren(node)
+	if err != nil {
+		return nil, err
+	}
+	result := hipathsys.NewCol(p)
+	for _, c := range children {
+		result.Add(c.Node)
+	}
+	return result, nil
+}
+
+// NamedChildren returns the values of all properties of the node together
+// with the names of the properties in the order of the property definitions.
+// The values are returned like the ones of Navigate.
+func (p *PathDynModel) NamedChildren(node interface{}) ([]PathChild, error) {
+	s := pathStruct(node)
+	if s == nil || s.data == nil {
+		return nil, nil
+	}
+
+	var children []PathChild
+	for _, propDef := range s.typeDef.Props() {
+		nodes, err := p.propNodes(s, propDef)
+		if err != nil {
+			return nil, err
+		}
+		for i, n := range nodes {
+			index := i
+			if !propDef.Array() {
+				index = -1
+			}
+			children = append(children, PathChild{propDef.Name(), index, n})
+		}
+	}
+	return children, nil
+}
+
+func (p *PathDynModel) navigateStruct(s *dynStruct, name string, result hipathsys.ColModifier) error {
+	if s == nil || s.data == nil {
+		return nil
+	}
+
+	var propDefs []*common.PropDef
+	if propDef := s.typeDef.PropByName(name); propDef != nil {
+		propDefs = []*common.PropDef{propDef}
+	} else {
+		propDefs = choicePropDefs(s.typeDef, name)
+	}
+
+	for _, propDef := range propDefs {
+		nodes, err := p.propNodes(s, propDef)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			result.Add(n)
+		}
+	}
+	return nil
+}
+
+// propNodes returns the values of the property of the struct as they are
+// returned by Navigate.
+func (p *PathDynModel) propNodes(s *dynStruct, propDef *common.PropDef) ([]interface{}, error) {
+	if !propDef.Array() {
+		n, err := s.dynNonArrayProp(propDef, common.AnyTypeKind)
+		if n == nil || err != nil {
+			return nil, err
+		}
+		pathStruct(n).location = &pathLocation{propDef.Name(), -1}
+		node, err := p.pathNode(n)
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{node}, nil
+	}
+
+	name := propDef.Name()
+	values, ok := s.data[name].([]interface{})
+	if !ok && s.data[name] != nil {
+		return nil, fmt.Errorf("list property %s of type %s contains invalid list data with type %T",
+			name, s.typeDef.InternalName(), s.data[name])
+	}
+	var elements []interface{}
+	if propDef.Type().TypeKind() == common.PrimitiveTypeKind {
+		elements, ok = s.data["_"+name].([]interface{})
+		if !ok && s.data["_"+name] != nil {
+			return nil, fmt.Errorf("primitive list property %s of type %s contains invalid element data with type %T",
+				name, s.typeDef.InternalName(), s.data["_"+name])
+		}
+	}
+
+	count := len(values)
+	if len(elements) > count {
+		count = len(elements)
+	}
+	nodes := make([]interface{}, 0, count)
+	for i := 0; i < count; i++ {
+		var value, element interface{}
+		if i < len(values) {
+			value = values[i]
+		}
+		if i < len(elements) {
+			element = elements[i]
+		}
+		n, err := s.dynItem(propDef, value, element)
+		if err != nil {
+			return nil, err
+		}
+		if n != nil {
+			pathStruct(n).location = &pathLocation{name, i}
+			node, err := p.pathNode(n)
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes, nil
+}
+
+// Location returns the struct that contains the node together with the
+// name of the property and the index of the node within the list property.
+// The index is -1 if the property is not a list. False is returned if the
+// node has not been returned by this model (e.g. the resource itself).
+func (p *PathDynModel) Location(node interface{}) (hi.DynStructAccessor, string, int, bool) {
+	s := pathStruct(node)
+	if s == nil || s.location == nil {
+		return nil, "", 0, false
+	}
+	parent, ok := s.parent.(hi.DynStructAccessor)
+	if !ok {
+		return nil, "", 0, false
+	}
+	return parent, s.location.name, s.location.index, true
+}
+
+// pathNode returns the system value of a primitive with value or of a UCUM
+// quantity and the node itself otherwise.
+func (p *PathDynModel) pathNode(node hi.DynAccessor) (interface{}, error) {
+	switch n := node.(type) {
+	case hi.DynPrimitiveAccessor:
+		if !n.NilValue() {
+			return convertPrimitiveToSystem(n)
+		}
+	case hi.DynElementAccessor:
+		if q, err := p.convertElementToSystem(n); q != nil || err != nil {
+			return q, err
+		}
+	}
+	return node, nil
+}
+
+// pathStruct returns the struct that contains the properties of the node.
+// The properties of a primitive are the ones of its element.
+func pathStruct(node interface{}) *dynStruct {
+	if a, ok := node.(hipathsys.AnyAccessor); ok {
+		node = a.Source()
+	}
+	switch n := node.(type) {
+	case *dynStruct:
+		return n
+	case *dynPrimitive:
+		return &n.dynStruct
+	default:
+		return nil
+	}
+}
+
+// choicePropDefs returns the properties of a choice with the specified name
+// without type suffix, e.g. valueQuantity and valueString for value.
+func choicePropDefs(typeDef common.StructTypeDefAccessor, name string) []*common.PropDef {
+	var propDefs []*common.PropDef
+	for _, propDef := range typeDef.Props() {
+		if propDef.Choice() == name {
+			propDefs = append(propDefs, propDef)
+		}
+	}
+	return propDefs
+}
+
+func pathNodesEqual(node1 interface{}, node2 interface{}, equivalent bool) bool {
+	if a, ok := node1.(hipathsys.AnyAccessor); ok {
+		node1 = a.Source()
+	}
+	if a, ok := node2.(hipathsys.AnyAccessor); ok {
+		node2 = a.Source()
+	}
+
+	switch n1 := node1.(type) {
+	case *dynStruct:
+		n2, ok := node2.(*dynStruct)
+		return ok && n1.typeDef == n2.typeDef &&
+			dynamicStructDeepEqual(n1.data, n2.data, equivalent)
+	case *dynPrimitive:
+		n2, ok := node2.(*dynPrimitive)
+		return ok && dynamicDeepEqual(n1.value, n2.value, equivalent) &&
+			dynamicStructDeepEqual(n1.data, n2.data, equivalent)
+	default:
+		return false
+	}
 }
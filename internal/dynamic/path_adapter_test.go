@@ -0,0 +1,275 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package dynamic
+
+import (
+	"encoding/json"
+	"github.com/healthiop/hi"
+	"github.com/healthiop/hi/internal/r4"
+	gohipath "github.com/healthiop/hipath"
+	"github.com/healthiop/hipath/hipathsys"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func newTestPathResource(t *testing.T) hi.DynResourceAccessor {
+	r, err := NewDynResource(r4.TypeDefContainer(), map[string]interface{}{
+		"resourceType": "Observation",
+		"id":           "test",
+		"status":       "final",
+		"code": map[string]interface{}{
+			"coding": []interface{}{
+				map[string]interface{}{"system": "http://loinc.org", "code": "29463-7"},
+				map[string]interface{}{"system": "http://loinc.org", "code": "29463-7"},
+			},
+		},
+		"valueQuantity": map[string]interface{}{
+			"value":  67.5,
+			"system": "http://unitsofmeasure.org",
+			"code":   "kg",
+		},
+		"note": []interface{}{
+			map[string]interface{}{"text": "note 1"},
+			map[string]interface{}{"text": "note 2"},
+		},
+		"category": []interface{}{
+			map[string]interface{}{"text": "vital-signs"},
+		},
+		"issued":  "2020-01-01T10:00:00Z",
+		"_issued": map[string]interface{}{"id": "issued"},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func executeTestPath(t *testing.T, node interface{}, path string) hipathsys.ColAccessor {
+	model := NewPathDynModel(r4.TypeDefContainer())
+	col, err := gohipath.Execute(NewPathDynContext(model, node, nil), path, node)
+	if err != nil {
+		t.Fatalf("cannot execute %s: %v", path, err)
+	}
+	return col
+}
+
+func TestPathDynModelNavigate(t *testing.T) {
+	r := newTestPathResource(t)
+	col := executeTestPath(t, r, "note.text")
+	if assert.Equal(t, 2, col.Count()) {
+		assert.Equal(t, "note 1", col.Get(0).(hipathsys.StringAccessor).String())
+		assert.Equal(t, "note 2", col.Get(1).(hipathsys.StringAccessor).String())
+	}
+
+	col = executeTestPath(t, r, "status")
+	if assert.Equal(t, 1, col.Count()) {
+		s, ok := col.Get(0).(hipathsys.StringAccessor)
+		if assert.True(t, ok) {
+			assert.Equal(t, "final", s.String())
+			assert.Implements(t, (*hi.DynPrimitiveAccessor)(nil), s.Source())
+		}
+	}
+
+	assert.True(t, executeTestPath(t, r, "subject.reference").Empty())
+	assert.True(t, executeTestPath(t, r, "undefined.value").Empty())
+	assert.Equal(t, 1, executeTestPath(t, r, "category.text").Count())
+	assert.Equal(t, "issued", executeTestPath(t, r, "issued.id").Get(0).(hipathsys.StringAccessor).String())
+}
+
+func TestPathDynModelNavigateChoice(t *testing.T) {
+	col := executeTestPath(t, newTestPathResource(t), "value")
+	if assert.Equal(t, 1, col.Count()) {
+		q, ok := col.Get(0).(hipathsys.QuantityAccessor)
+		if assert.True(t, ok) {
+			assert.Equal(t, "67.5 'kg'", q.String())
+			assert.Implements(t, (*hi.DynElementAccessor)(nil), q.Source())
+		}
+	}
+	assert.Equal(t, 1, executeTestPath(t, newTestPathResource(t), "value.code").Count())
+	assert.True(t, executeTestPath(t, newTestPathResource(t), "effective").Empty())
+}
+
+func TestPathDynModelNavigateNoChoice(t *testing.T) {
+	r, err := NewDynResource(r4.TypeDefContainer(), map[string]interface{}{
+		"resourceType": "Patient",
+		"birthDate":    "1970-01-01",
+	}, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, 1, executeTestPath(t, r, "birthDate").Count())
+	assert.True(t, executeTestPath(t, r, "birth").Empty())
+}
+
+func TestPathDynModelFunctions(t *testing.T) {
+	r := newTestPathResource(t)
+	assert.Equal(t, hipathsys.True, executeTestPath(t, r, "value <= 70 'kg'").Get(0))
+	assert.Equal(t, hipathsys.True, executeTestPath(t, r, "issued > @2019-12-31T00:00:00Z").Get(0))
+	assert.Equal(t, hipathsys.True, executeTestPath(t, r, "code.coding.distinct().count() = 1").Get(0))
+	assert.Equal(t, hipathsys.True, executeTestPath(t, r, "value.is(FHIR.Quantity)").Get(0))
+	assert.Equal(t, hipathsys.True, executeTestPath(t, r, "children().count() = 8").Get(0))
+	assert.Equal(t, hipathsys.NewString("http://loinc.org"), executeTestPath(t, r, "%loinc").Get(0))
+}
+
+func TestPathDynModelNamedChildren(t *testing.T) {
+	model := NewPathDynModel(r4.TypeDefContainer())
+	children, err := model.NamedChildren(newTestPathResource(t))
+	if !assert.NoError(t, err) {
+		return
+	}
+	names := make([]string, 0, len(children))
+	for _, c := range children {
+		names = append(names, c.Name)
+	}
+	assert.Equal(t, []string{"id", "status", "category", "code", "issued", "valueQuantity", "note", "note"}, names)
+	assert.Equal(t, -1, children[3].Index)
+	assert.Equal(t, 0, children[6].Index)
+	assert.Equal(t, 1, children[7].Index)
+
+	col, err := model.Children(children[4].Node)
+	if assert.NoError(t, err) && assert.Equal(t, 1, col.Count()) {
+		assert.Equal(t, "issued", col.Get(0).(hipathsys.StringAccessor).String())
+	}
+	children, err = model.NamedChildren(hipathsys.NewString("test"))
+	assert.NoError(t, err)
+	assert.Nil(t, children)
+}
+
+func TestPathDynModelLocation(t *testing.T) {
+	model := NewPathDynModel(r4.TypeDefContainer())
+	r := newTestPathResource(t)
+	_, _, _, found := model.Location(r)
+	assert.False(t, found)
+
+	children, err := model.NamedChildren(r)
+	if !assert.NoError(t, err) {
+		return
+	}
+	for _, c := range children {
+		parent, name, index, found := model.Location(c.Node)
+		if assert.True(t, found, c.Name) {
+			assert.Same(t, r, parent)
+			assert.Equal(t, c.Name, name)
+			assert.Equal(t, c.Index, index)
+		}
+	}
+	_, _, _, found = model.Location(hipathsys.NewString("test"))
+	assert.False(t, found)
+}
+
+func TestPathDynModelCastToSystem(t *testing.T) {
+	model := NewPathDynModel(r4.TypeDefContainer())
+	r := newTestPathResource(t)
+	p, err := r.PrimitiveProp("issued")
+	if !assert.NoError(t, err) {
+		return
+	}
+	v, err := model.CastToSystem(p)
+	if assert.NoError(t, err) {
+		assert.Equal(t, hipathsys.DateTimeDataType, v.DataType())
+		assert.Same(t, p, v.Source())
+	}
+
+	q, err := r.ElementProp("valueQuantity")
+	if !assert.NoError(t, err) {
+		return
+	}
+	v, err = model.CastToSystem(q)
+	if assert.NoError(t, err) {
+		assert.Equal(t, hipathsys.QuantityDataType, v.DataType())
+	}
+
+	v, err = model.CastToSystem(r)
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+}
+
+func TestPathDynModelCastToSystemNumber(t *testing.T) {
+	model := NewPathDynModel(r4.TypeDefContainer())
+	e, err := NewDynElement(r4.TypeDefContainer(), r4.TypeDefContainer().MandatoryStructTypeByName("Quantity"),
+		map[string]interface{}{"value": json.Number("1.50")}, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	p, err := e.PrimitiveProp("value")
+	if !assert.NoError(t, err) {
+		return
+	}
+	n, err := p.NumberValue()
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1.5, n)
+	}
+	v, err := model.CastToSystem(p)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "1.50", v.(hipathsys.DecimalAccessor).String())
+	}
+}
+
+func TestPathDynModelEqual(t *testing.T) {
+	model := NewPathDynModel(r4.TypeDefContainer())
+	col := executeTestPath(t, newTestPathResource(t), "code.coding")
+	if assert.Equal(t, 2, col.Count()) {
+		assert.True(t, model.Equal(col.Get(0), col.Get(1)))
+		assert.True(t, model.Equivalent(col.Get(0), col.Get(1)))
+	}
+	notes := executeTestPath(t, newTestPathResource(t), "note")
+	if assert.Equal(t, 2, notes.Count()) {
+		assert.False(t, model.Equal(notes.Get(0), notes.Get(1)))
+		assert.False(t, model.Equal(notes.Get(0), col.Get(0)))
+	}
+}
+
+func TestPathDynModelAsType(t *testing.T) {
+	model := NewPathDynModel(r4.TypeDefContainer())
+	r := newTestPathResource(t)
+	n, err := model.AsType(r, hipathsys.NewFQTypeName("DomainResource", "FHIR"))
+	assert.NoError(t, err)
+	assert.Same(t, r, n)
+	n, err = model.AsType(r, hipathsys.NewFQTypeName("Patient", "FHIR"))
+	assert.NoError(t, err)
+	assert.Nil(t, n)
+}
+
+func TestPathDynContextEnvVar(t *testing.T) {
+	r := newTestPathResource(t)
+	ctx := NewPathDynContext(NewPathDynModel(r4.TypeDefContainer()), r, map[string]interface{}{"resource": r})
+	v, found := ctx.EnvVar("resource")
+	assert.True(t, found)
+	assert.Same(t, r, v)
+	v, found = ctx.EnvVar("context")
+	assert.True(t, found)
+	assert.Same(t, r, v)
+	v, found = ctx.EnvVar("ucum")
+	assert.True(t, found)
+	assert.Equal(t, hipathsys.UCUMSystemURI, v)
+	_, found = ctx.EnvVar("undefined")
+	assert.False(t, found)
+	assert.Same(t, r, ctx.ContextNode())
+	assert.Nil(t, ctx.Tracer())
+}
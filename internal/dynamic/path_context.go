@@ -0,0 +1,88 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package dynamic
+
+import "github.com/healthiop/hipath/hipathsys"
+
+var pathEnvVars = map[string]interface{}{
+	"ucum":  hipathsys.UCUMSystemURI,
+	"sct":   hipathsys.NewString("http://snomed.info/sct"),
+	"loinc": hipathsys.NewString("http://loinc.org"),
+}
+
+// PathDynContext is the context for the evaluation of FHIRPath expressions
+// on dynamic resources. Environment variables that are not defined by the
+// context (e.g. resource and rootResource) are resolved with the specified
+// map.
+type PathDynContext struct {
+	model   *PathDynModel
+	node    interface{}
+	envVars map[string]interface{}
+	tracer  hipathsys.Tracer
+}
+
+func NewPathDynContext(model *PathDynModel, node interface{}, envVars map[string]interface{}) *PathDynContext {
+	return &PathDynContext{model: model, node: node, envVars: envVars}
+}
+
+// SetTracer sets the tracer that is used by the trace function.
+func (c *PathDynContext) SetTracer(tracer hipathsys.Tracer) {
+	c.tracer = tracer
+}
+
+func (c *PathDynContext) EnvVar(name string) (interface{}, bool) {
+	if value, found := c.envVars[name]; found {
+		return value, true
+	}
+	if name == "context" {
+		return c.node, true
+	}
+	value, found := pathEnvVars[name]
+	return value, found
+}
+
+func (c *PathDynContext) ContextNode() interface{} {
+	return c.node
+}
+
+func (c *PathDynContext) ModelAdapter() hipathsys.ModelAdapter {
+	return c.model
+}
+
+func (c *PathDynContext) NewCol() hipathsys.ColModifier {
+	return hipathsys.NewCol(c.model)
+}
+
+func (c *PathDynContext) NewColWithItem(item interface{}) hipathsys.ColModifier {
+	return hipathsys.NewColWithItem(c.model, item)
+}
+
+func (c *PathDynContext) Tracer() hipathsys.Tracer {
+	return c.tracer
+}
@@ -39,6 +39,21 @@ type PathTypeSpec struct {
 	typeDef common.TypeDefAccessor
 }
 
+// NewPathTypeSpec returns the type specification of the type with the
+// specified name. The type may also have been registered after the creation
+// of the type definition container. If the type is undefined or anonymous,
+// nil is returned.
+func NewPathTypeSpec(typeDefContainer *common.TypeDefContainer, name hipathsys.FQTypeNameAccessor) *PathTypeSpec {
+	if name.HasNamespace() && name.Namespace() != PathNamespaceName {
+		return nil
+	}
+	typeDef := typeDefContainer.TypeByName(name.Name())
+	if typeDef == nil || typeDef.Anonymous() {
+		return nil
+	}
+	return &PathTypeSpec{typeDef}
+}
+
 func (p *PathTypeSpec) Base() hipathsys.TypeSpecAccessor {
 	return basePathTypeSpec(p)
 }
@@ -83,7 +98,7 @@ func (p *PathTypeSpec) ExtendsName(name hipathsys.FQTypeNameAccessor) bool {
 }
 
 func (p *PathTypeSpec) Anonymous() bool {
-	return p.Anonymous()
+	return p.typeDef.Anonymous()
 }
 
 func (p *PathTypeSpec) HasNamespace() bool {
@@ -101,7 +116,7 @@ func (p *PathTypeSpec) Name() string {
 func (p *PathTypeSpec) Equal(name hipathsys.FQTypeNameAccessor) bool {
 	return name.Namespace() == PathNamespaceName &&
 		name.Name() == p.typeDef.InternalName() &&
-		p.typeDef.Anonymous()
+		!p.typeDef.Anonymous()
 }
 
 func basePathTypeSpec(p *PathTypeSpec) *PathTypeSpec {
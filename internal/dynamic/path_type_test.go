@@ -0,0 +1,92 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package dynamic
+
+import (
+	"github.com/healthiop/hi/internal/common"
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/healthiop/hipath/hipathsys"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewPathTypeSpec(t *testing.T) {
+	ts := NewPathTypeSpec(r4.TypeDefContainer(), hipathsys.NewFQTypeName("Patient", "FHIR"))
+	if assert.NotNil(t, ts) {
+		assert.Equal(t, "FHIR.Patient", ts.String())
+		assert.Equal(t, "FHIR.DomainResource", ts.Base().String())
+		assert.False(t, ts.Anonymous())
+	}
+}
+
+func TestNewPathTypeSpecWithoutNamespace(t *testing.T) {
+	ts := NewPathTypeSpec(r4.TypeDefContainer(), hipathsys.NewTypeName("Patient"))
+	if assert.NotNil(t, ts) {
+		assert.Equal(t, "FHIR.Patient", ts.String())
+	}
+}
+
+func TestNewPathTypeSpecOtherNamespace(t *testing.T) {
+	assert.Nil(t, NewPathTypeSpec(r4.TypeDefContainer(), hipathsys.NewFQTypeName("Patient", "System")))
+}
+
+func TestNewPathTypeSpecUndefined(t *testing.T) {
+	assert.Nil(t, NewPathTypeSpec(r4.TypeDefContainer(), hipathsys.NewTypeName("Test")))
+}
+
+func TestNewPathTypeSpecAnonymous(t *testing.T) {
+	assert.Nil(t, NewPathTypeSpec(r4.TypeDefContainer(), hipathsys.NewTypeName("Patient_Contact")))
+}
+
+func TestPathTypeSpecEqual(t *testing.T) {
+	ts := NewPathTypeSpec(r4.TypeDefContainer(), hipathsys.NewTypeName("Patient"))
+	if assert.NotNil(t, ts) {
+		assert.True(t, ts.Equal(hipathsys.NewFQTypeName("Patient", "FHIR")))
+		assert.False(t, ts.Equal(hipathsys.NewFQTypeName("Observation", "FHIR")))
+		assert.True(t, ts.ExtendsName(hipathsys.NewFQTypeName("DomainResource", "FHIR")))
+	}
+}
+
+func TestPathTypeSpecCustomType(t *testing.T) {
+	tdc := r4.NewTypeDefContainer()
+	td := common.NewStructTypeDef("CustomTask", tdc.MandatoryTypeByName(common.DomainResourceTypeName), false)
+	if !assert.NoError(t, tdc.RegisterStructType(td, nil)) {
+		return
+	}
+
+	ts := NewPathDynModel(tdc).TypeSpecByName(hipathsys.NewFQTypeName("CustomTask", "FHIR"))
+	if assert.NotNil(t, ts) {
+		assert.Equal(t, "FHIR.CustomTask", ts.String())
+		assert.True(t, ts.ExtendsName(hipathsys.NewTypeName("DomainResource")))
+	}
+}
+
+func TestPathDynModelTypeSpecByNameUndefined(t *testing.T) {
+	assert.Nil(t, NewPathDynModel(r4.TypeDefContainer()).TypeSpecByName(hipathsys.NewTypeName("Test")))
+}
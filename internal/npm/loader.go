@@ -0,0 +1,194 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package npm
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// corePackageNames contains the packages of the FHIR core specification.
+// Their definitions are provided by the type definitions of this module,
+// therefore they need not be available in a package cache.
+var corePackageNames = map[string]bool{
+	"hl7.fhir.core":     true,
+	"hl7.fhir.r2.core":  true,
+	"hl7.fhir.r3.core":  true,
+	"hl7.fhir.r4.core":  true,
+	"hl7.fhir.r4b.core": true,
+	"hl7.fhir.r5.core":  true,
+}
+
+// Loader loads packages together with their dependencies. Dependencies are
+// looked up in the package cache directories only, i.e. packages are never
+// downloaded.
+type Loader struct {
+	cacheDirs []string
+}
+
+type loading struct {
+	*Loader
+	set *PackageSet
+}
+
+// NewLoader returns a loader that looks up dependencies in the specified
+// package cache directories. The package folders in a cache directory are
+// named by package name and version separated by a number sign (e.g.
+// hl7.fhir.us.core#3.1.1). Package files are named by package name and
+// version separated by a hyphen with extension .tgz.
+func NewLoader(cacheDirs ...string) *Loader {
+	return &Loader{cacheDirs}
+}
+
+// DefaultCacheDir returns the default package cache directory of the FHIR
+// tooling in the home directory of the user.
+func DefaultCacheDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".fhir", "packages"), nil
+}
+
+// LoadDirectory loads the package in the specified directory and its
+// dependencies.
+func (l *Loader) LoadDirectory(dir string) (*PackageSet, error) {
+	p, err := ReadDirectory(dir)
+	if err != nil {
+		return nil, err
+	}
+	return l.load(p)
+}
+
+// LoadFile loads the package in the specified .tgz file and its
+// dependencies.
+func (l *Loader) LoadFile(fileName string) (*PackageSet, error) {
+	p, err := ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	return l.load(p)
+}
+
+// LoadPackage loads the package with the specified name and version from a
+// package cache directory together with its dependencies. The version may
+// contain wildcards (e.g. 3.1.x) or may be latest or empty in order to load
+// the highest available version.
+func (l *Loader) LoadPackage(name string, version string) (*PackageSet, error) {
+	p, err := l.readCachedPackage(name, version)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, fmt.Errorf("package %s#%s not found in package cache", name, version)
+	}
+	return l.load(p)
+}
+
+func (l *Loader) load(root *Package) (*PackageSet, error) {
+	ld := &loading{l, newPackageSet(root)}
+	if err := ld.addWithDependencies(root); err != nil {
+		return nil, err
+	}
+	return ld.set, nil
+}
+
+func (ld *loading) addWithDependencies(p *Package) error {
+	if ld.set.containsPackage(p.Manifest.Name, p.Manifest.Version) {
+		return nil
+	}
+	ld.set.add(p)
+
+	names := make([]string, 0, len(p.Manifest.Dependencies))
+	for name := range p.Manifest.Dependencies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		version := p.Manifest.Dependencies[name]
+		if ld.set.containsMatchingPackage(name, version) {
+			continue
+		}
+		dependency, err := ld.readCachedPackage(name, version)
+		if err != nil {
+			return err
+		}
+		if dependency == nil {
+			if corePackageNames[name] {
+				continue
+			}
+			return fmt.Errorf("dependency %s#%s of package %s not found in package cache", name, version, p.ID())
+		}
+		if err := ld.addWithDependencies(dependency); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readCachedPackage reads the package with the highest version that matches
+// the specified version from the cache directories. If there is no such
+// package, nil is returned.
+func (l *Loader) readCachedPackage(name string, version string) (*Package, error) {
+	var bestVersion, bestPath string
+	bestDir := false
+	for _, cacheDir := range l.cacheDirs {
+		entries, err := os.ReadDir(cacheDir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("cannot read package cache %s: %v", cacheDir, err)
+		}
+		for _, entry := range entries {
+			var v string
+			if entry.IsDir() && strings.HasPrefix(entry.Name(), name+"#") {
+				v = entry.Name()[len(name)+1:]
+			} else if !entry.IsDir() && strings.HasPrefix(entry.Name(), name+"-") && strings.HasSuffix(entry.Name(), ".tgz") {
+				v = strings.TrimSuffix(entry.Name()[len(name)+1:], ".tgz")
+			} else {
+				continue
+			}
+			if matchesVersion(v, version) && (bestVersion == "" || compareVersions(v, bestVersion) > 0) {
+				bestVersion, bestPath, bestDir = v, filepath.Join(cacheDir, entry.Name()), entry.IsDir()
+			}
+		}
+	}
+
+	if bestPath == "" {
+		return nil, nil
+	}
+	if bestDir {
+		return ReadDirectory(bestPath)
+	}
+	return ReadFile(bestPath)
+}
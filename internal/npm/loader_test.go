@@ -0,0 +1,331 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package npm
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"github.com/healthiop/hi/internal/profile"
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const (
+	testPackageDir   = "testdata/example.fhir.test"
+	testCacheDir     = "testdata/cache"
+	testPatientURL   = "http://example.org/fhir/StructureDefinition/test-patient"
+	testBirthSexURL  = "http://example.org/fhir/base/StructureDefinition/birthsex"
+	testValueSetURL  = "http://example.org/fhir/ValueSet/test-codes"
+	basePatientURL   = "http://example.org/fhir/base/StructureDefinition/base-patient"
+	testCodeSystemID = "http://example.org/fhir/CodeSystem/test-codes"
+)
+
+func TestLoadDirectory(t *testing.T) {
+	s, err := NewLoader(testCacheDir).LoadDirectory(testPackageDir)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assertTestPackageSet(t, s)
+	assert.Equal(t, filepath.Join(testPackageDir, "package"), s.Root().Location)
+}
+
+func TestLoadDirectoryPackageFolder(t *testing.T) {
+	s, err := NewLoader(testCacheDir).LoadDirectory(filepath.Join(testPackageDir, "package"))
+	if assert.NoError(t, err) {
+		assertTestPackageSet(t, s)
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "example.fhir.test-1.0.0.tgz")
+	writeTestArchive(t, fileName, filepath.Join(testPackageDir, "package"))
+
+	s, err := NewLoader(testCacheDir).LoadFile(fileName)
+	if assert.NoError(t, err) {
+		assertTestPackageSet(t, s)
+		assert.Equal(t, fileName, s.Root().Location)
+	}
+}
+
+func assertTestPackageSet(t *testing.T, s *PackageSet) {
+	packages := s.Packages()
+	if assert.Len(t, packages, 2) {
+		assert.Same(t, s.Root(), packages[0])
+		assert.Equal(t, "example.fhir.test#1.0.0", packages[0].ID())
+		assert.Equal(t, "example.fhir.base#2.1.0", packages[1].ID())
+	}
+
+	root := s.Root()
+	assert.Equal(t, "http://example.org/fhir", root.Manifest.Canonical)
+	assert.Equal(t, []string{"4.0.1"}, root.Manifest.FHIRVersions)
+	assert.Equal(t, map[string]string{"hl7.fhir.r4.core": "4.0.1", "example.fhir.base": "2.x"}, root.Manifest.Dependencies)
+	if assert.Len(t, root.Resources, 4, "examples and other resources must be ignored") {
+		types := make(map[string]bool)
+		for _, r := range root.Resources {
+			types[r.ResourceType] = true
+			assert.Same(t, root, r.Package)
+		}
+		assert.Equal(t, map[string]bool{"StructureDefinition": true, "ValueSet": true,
+			"CodeSystem": true, "SearchParameter": true}, types)
+	}
+
+	r := s.Resource(testValueSetURL)
+	if assert.NotNil(t, r) {
+		assert.Equal(t, "ValueSet", r.ResourceType)
+		assert.Equal(t, "TestCodes", r.Name)
+		assert.Equal(t, testValueSetURL+"|1.0.0", r.Canonical())
+	}
+	assert.NotNil(t, s.Resource(testCodeSystemID+"|1.0.0"))
+	if r := s.Resource(testBirthSexURL + "|2.1"); assert.NotNil(t, r) {
+		assert.Equal(t, "2.1.0", r.Version)
+		assert.Same(t, packages[1], r.Package)
+	}
+	assert.Nil(t, s.Resource(testBirthSexURL+"|2.0.0"))
+	assert.Nil(t, s.Resource("http://example.org/fhir/ValueSet/other"))
+	assert.Len(t, s.ResourcesByType("StructureDefinition"), 3)
+}
+
+func TestPackageSetTypeDefContainer(t *testing.T) {
+	s, err := NewLoader(testCacheDir).LoadDirectory(testPackageDir)
+	if !assert.NoError(t, err) {
+		return
+	}
+	c, err := s.TypeDefContainer()
+	if assert.NoError(t, err) {
+		assert.Same(t, r4.TypeDefContainer(), c)
+	}
+
+	s.Root().Manifest.FHIRVersions = []string{"4.0.0"}
+	c, err = s.TypeDefContainer()
+	if assert.NoError(t, err) {
+		assert.Same(t, r4.TypeDefContainer(), c)
+	}
+
+	s.Root().Manifest.FHIRVersions = []string{"1.0.2"}
+	_, err = s.TypeDefContainer()
+	if assert.Error(t, err) {
+		assert.Equal(t, "FHIR version of package example.fhir.test#1.0.0 is not supported: 1.0.2", err.Error())
+	}
+
+	s.Root().Manifest.FHIRVersions = nil
+	_, err = s.TypeDefContainer()
+	if assert.Error(t, err) {
+		assert.Equal(t, "package example.fhir.test#1.0.0 declares no FHIR version", err.Error())
+	}
+}
+
+func TestPackageSetProfileByURL(t *testing.T) {
+	s, err := NewLoader(testCacheDir).LoadDirectory(testPackageDir)
+	if !assert.NoError(t, err) {
+		return
+	}
+	p := s.ProfileByURL(testPatientURL + "|1.0.0")
+	if assert.NotNil(t, p) {
+		assert.Equal(t, basePatientURL, p.BaseDefinition)
+		assert.Same(t, p, s.ProfileByURL(testPatientURL))
+	}
+	assert.Nil(t, s.ProfileByURL(testValueSetURL))
+}
+
+func TestPackageSetNewValidator(t *testing.T) {
+	s, err := NewLoader(testCacheDir).LoadDirectory(testPackageDir)
+	if !assert.NoError(t, err) {
+		return
+	}
+	v, err := s.NewValidator()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NotNil(t, v.ProfileByURL(basePatientURL+"|2.1.0"))
+	assert.NotNil(t, v.ProfileByURL(testBirthSexURL))
+
+	result, err := v.ValidateData(map[string]interface{}{
+		"resourceType": "Patient",
+		"extension": []interface{}{
+			map[string]interface{}{"url": testBirthSexURL, "valueString": "F"},
+		},
+	}, testPatientURL)
+	if assert.NoError(t, err) {
+		messages := make([]string, 0, len(result.Issues))
+		for _, issue := range result.Issues {
+			if issue.Severity == profile.ErrorSeverity {
+				messages = append(messages, issue.Location+": "+issue.Message)
+			}
+		}
+		assert.ElementsMatch(t, []string{
+			"Patient.extension[0].valueString: type string is not allowed by element Extension.value[x]",
+			"Patient.gender: element Patient.gender requires at least 1 value(s) but has 0",
+			"Patient.name: element Patient.name requires at least 1 value(s) but has 0",
+		}, messages)
+	}
+}
+
+func TestLoadPackage(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected string
+	}{
+		{"", "3.0.0-ballot"},
+		{"latest", "3.0.0-ballot"},
+		{"2.0.0", "2.0.0"},
+		{"2.0", "2.0.0"},
+		{"2", "2.1.0"},
+		{"2.x", "2.1.0"},
+		{"2.1.x", "2.1.0"},
+		{"3.0.0-ballot", "3.0.0-ballot"},
+	}
+	l := NewLoader("testdata/undefined", testCacheDir)
+	for _, test := range tests {
+		s, err := l.LoadPackage("example.fhir.base", test.version)
+		if assert.NoError(t, err, test.version) {
+			assert.Equal(t, "example.fhir.base#"+test.expected, s.Root().ID(), test.version)
+			assert.Len(t, s.Packages(), 1)
+		}
+	}
+}
+
+func TestLoadPackageNotFound(t *testing.T) {
+	_, err := NewLoader(testCacheDir).LoadPackage("example.fhir.base", "3.0.0")
+	if assert.Error(t, err) {
+		assert.Equal(t, "package example.fhir.base#3.0.0 not found in package cache", err.Error())
+	}
+}
+
+func TestLoadPackageArchive(t *testing.T) {
+	cacheDir := t.TempDir()
+	writeTestArchive(t, filepath.Join(cacheDir, "example.fhir.test-1.0.0.tgz"), filepath.Join(testPackageDir, "package"))
+
+	s, err := NewLoader(cacheDir, testCacheDir).LoadPackage("example.fhir.test", "1.0.0")
+	if assert.NoError(t, err) {
+		assertTestPackageSet(t, s)
+	}
+}
+
+func TestLoadMissingDependency(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "package.json"),
+		`{"name":"example.fhir.other","version":"1.0.0","dependencies":{"example.fhir.base":"4.0.0"}}`)
+
+	_, err := NewLoader(testCacheDir).LoadDirectory(dir)
+	if assert.Error(t, err) {
+		assert.Equal(t, "dependency example.fhir.base#4.0.0 of package example.fhir.other#1.0.0 "+
+			"not found in package cache", err.Error())
+	}
+}
+
+func TestReadDirectoryNoManifest(t *testing.T) {
+	dir := t.TempDir()
+	_, err := ReadDirectory(dir)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "package "+dir+" contains no package.json")
+	}
+}
+
+func TestReadDirectoryInvalidManifest(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "package.json"), `{"name":"example.fhir.other"}`)
+	_, err := ReadDirectory(dir)
+	if assert.Error(t, err) {
+		assert.Equal(t, "package.json of package "+dir+" contains no name or version", err.Error())
+	}
+}
+
+func TestReadDirectoryInvalidResource(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "package.json"), `{"name":"example.fhir.other","version":"1.0.0"}`)
+	writeTestFile(t, filepath.Join(dir, "ValueSet-test.json"), `{"resourceType":"ValueSet"}`)
+	_, err := ReadDirectory(dir)
+	if assert.Error(t, err) {
+		assert.Equal(t, "ValueSet in file ValueSet-test.json of package example.fhir.other#1.0.0 has no URL", err.Error())
+	}
+
+	writeTestFile(t, filepath.Join(dir, "ValueSet-test.json"), `{"resourceType":`)
+	_, err = ReadDirectory(dir)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "file ValueSet-test.json of package example.fhir.other#1.0.0 contains invalid JSON")
+	}
+}
+
+func TestReadFileNoArchive(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "test.tgz")
+	writeTestFile(t, fileName, "test")
+	_, err := ReadFile(fileName)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "package file "+fileName+" is not gzip compressed")
+	}
+}
+
+func writeTestArchive(t *testing.T, fileName string, dir string) {
+	f, err := os.Create(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	gw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gw)
+
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		h := &tar.Header{Name: "package/" + filepath.ToSlash(rel), Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}
+		if err := tw.WriteHeader(h); err != nil {
+			return err
+		}
+		_, err = tw.Write(content)
+		return err
+	})
+	if err == nil {
+		err = tw.Close()
+	}
+	if err == nil {
+		err = gw.Close()
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func writeTestFile(t *testing.T, fileName string, content string) {
+	if err := ioutil.WriteFile(fileName, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
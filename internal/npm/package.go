@@ -0,0 +1,254 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package npm
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	manifestFileName  = "package.json"
+	packageFolderName = "package"
+)
+
+// definitionalResourceTypes contains the resource types that are loaded
+// from packages.
+var definitionalResourceTypes = map[string]bool{
+	"StructureDefinition": true,
+	"ValueSet":            true,
+	"CodeSystem":          true,
+	"SearchParameter":     true,
+}
+
+// Manifest contains the properties of the package.json file of a package
+// that are used by the loader.
+type Manifest struct {
+	Name         string            `json:"name"`
+	Version      string            `json:"version"`
+	Canonical    string            `json:"canonical"`
+	FHIRVersions []string          `json:"fhirVersions"`
+	Dependencies map[string]string `json:"dependencies"`
+}
+
+// Package is a loaded FHIR NPM package.
+type Package struct {
+	Manifest  Manifest
+	Location  string
+	Resources []*Resource
+}
+
+// Resource is a definitional resource of a package.
+type Resource struct {
+	ResourceType string
+	URL          string
+	Version      string
+	Name         string
+	Data         map[string]interface{}
+	Package      *Package
+}
+
+// ID returns the name and version of the package separated by a number sign
+// as it is used by package caches.
+func (p *Package) ID() string {
+	return p.Manifest.Name + "#" + p.Manifest.Version
+}
+
+// Canonical returns the URL and, if available, the version of the resource
+// separated by a vertical bar.
+func (r *Resource) Canonical() string {
+	if r.Version == "" {
+		return r.URL
+	}
+	return r.URL + "|" + r.Version
+}
+
+// ReadDirectory reads the package in the specified directory. The directory
+// may either be the package folder that contains the package.json file or
+// its parent directory. Dependencies are not loaded.
+func ReadDirectory(dir string) (*Package, error) {
+	if _, err := os.Stat(filepath.Join(dir, packageFolderName, manifestFileName)); err == nil {
+		dir = filepath.Join(dir, packageFolderName)
+	}
+
+	manifestData, err := ioutil.ReadFile(filepath.Join(dir, manifestFileName))
+	if err != nil {
+		return nil, fmt.Errorf("package %s contains no %s: %v", dir, manifestFileName, err)
+	}
+	p, err := newPackage(dir, manifestData)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read package %s: %v", dir, err)
+	}
+	for _, f := range files {
+		if f.IsDir() || !isResourceFileName(f.Name()) {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("cannot read file %s of package %s: %v", f.Name(), p.ID(), err)
+		}
+		if err := p.addResource(f.Name(), data); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// ReadFile reads the package from the specified gzip compressed tar file
+// (.tgz). Dependencies are not loaded.
+func ReadFile(fileName string) (*Package, error) {
+	f, err := os.Open(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open package file %s: %v", fileName, err)
+	}
+	defer f.Close()
+
+	p, err := ReadArchive(f, fileName)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// ReadArchive reads the package from the specified gzip compressed tar
+// stream. The location is used for error messages.
+func ReadArchive(r io.Reader, location string) (*Package, error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("package file %s is not gzip compressed: %v", location, err)
+	}
+	defer gr.Close()
+
+	var manifestData []byte
+	files := make(map[string][]byte)
+	var fileNames []string
+	tr := tar.NewReader(gr)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid package file %s: %v", location, err)
+		}
+		if h.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name := path.Clean(strings.TrimPrefix(h.Name, "./"))
+		dir, base := path.Split(name)
+		if dir != packageFolderName+"/" {
+			continue
+		}
+		if base != manifestFileName && !isResourceFileName(base) {
+			continue
+		}
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read file %s of package file %s: %v", name, location, err)
+		}
+		if base == manifestFileName {
+			manifestData = data
+		} else {
+			files[base] = data
+			fileNames = append(fileNames, base)
+		}
+	}
+
+	if manifestData == nil {
+		return nil, fmt.Errorf("package file %s contains no %s", location, manifestFileName)
+	}
+	p, err := newPackage(location, manifestData)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range fileNames {
+		if err := p.addResource(name, files[name]); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+func newPackage(location string, manifestData []byte) (*Package, error) {
+	p := &Package{Location: location}
+	if err := json.Unmarshal(manifestData, &p.Manifest); err != nil {
+		return nil, fmt.Errorf("package %s contains invalid %s: %v", location, manifestFileName, err)
+	}
+	if p.Manifest.Name == "" || p.Manifest.Version == "" {
+		return nil, fmt.Errorf("%s of package %s contains no name or version", manifestFileName, location)
+	}
+	return p, nil
+}
+
+// addResource adds the resource with the specified data if it is a
+// definitional resource. Other files are ignored.
+func (p *Package) addResource(fileName string, data []byte) error {
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return fmt.Errorf("file %s of package %s contains invalid JSON: %v", fileName, p.ID(), err)
+	}
+	resourceType, _ := m["resourceType"].(string)
+	if !definitionalResourceTypes[resourceType] {
+		return nil
+	}
+
+	url, _ := m["url"].(string)
+	if url == "" {
+		return fmt.Errorf("%s in file %s of package %s has no URL", resourceType, fileName, p.ID())
+	}
+	version, _ := m["version"].(string)
+	name, _ := m["name"].(string)
+	p.Resources = append(p.Resources, &Resource{
+		ResourceType: resourceType,
+		URL:          url,
+		Version:      version,
+		Name:         name,
+		Data:         m,
+		Package:      p,
+	})
+	return nil
+}
+
+func isResourceFileName(name string) bool {
+	return strings.HasSuffix(name, ".json") && name != manifestFileName && !strings.HasPrefix(name, ".")
+}
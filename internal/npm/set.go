@@ -0,0 +1,218 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package npm
+
+import (
+	"fmt"
+	"github.com/healthiop/hi/internal/common"
+	"github.com/healthiop/hi/internal/profile"
+	"github.com/healthiop/hi/internal/version"
+	"strings"
+	"sync"
+)
+
+const structureDefinitionTypeName = "StructureDefinition"
+
+// PackageSet contains a root package and its loaded dependencies. Resources
+// are resolved by their canonical URLs across all packages.
+type PackageSet struct {
+	root     *Package
+	packages []*Package
+	byURL    map[string][]*Resource
+	lock     sync.Mutex
+	profiles map[*Resource]*profile.Profile
+}
+
+func newPackageSet(root *Package) *PackageSet {
+	return &PackageSet{
+		root:     root,
+		byURL:    make(map[string][]*Resource),
+		profiles: make(map[*Resource]*profile.Profile),
+	}
+}
+
+func (s *PackageSet) add(p *Package) {
+	s.packages = append(s.packages, p)
+	for _, r := range p.Resources {
+		s.byURL[r.URL] = append(s.byURL[r.URL], r)
+	}
+}
+
+func (s *PackageSet) containsPackage(name string, version string) bool {
+	for _, p := range s.packages {
+		if p.Manifest.Name == name && p.Manifest.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *PackageSet) containsMatchingPackage(name string, version string) bool {
+	for _, p := range s.packages {
+		if p.Manifest.Name == name && matchesVersion(p.Manifest.Version, version) {
+			return true
+		}
+	}
+	return false
+}
+
+// Root returns the package that has been loaded with its dependencies.
+func (s *PackageSet) Root() *Package {
+	return s.root
+}
+
+// Packages returns the root package followed by its dependencies.
+func (s *PackageSet) Packages() []*Package {
+	packages := make([]*Package, len(s.packages))
+	copy(packages, s.packages)
+	return packages
+}
+
+// Resource returns the resource with the specified canonical URL. The URL
+// may contain a version that is separated by a vertical bar. The version may
+// consist of fewer segments (e.g. 3.1) than the version of the resource.
+// If there are several matching resources, the resource with the highest
+// version is returned. Resources of the root package take precedence over
+// resources of dependencies with the same version. If there is no such
+// resource, nil is returned.
+func (s *PackageSet) Resource(canonical string) *Resource {
+	url, v := canonical, ""
+	if i := strings.IndexByte(canonical, '|'); i >= 0 {
+		url, v = canonical[:i], canonical[i+1:]
+	}
+
+	var result *Resource
+	for _, r := range s.byURL[url] {
+		if v != "" && !matchesVersion(r.Version, v) {
+			continue
+		}
+		if result == nil || compareVersions(r.Version, result.Version) > 0 {
+			result = r
+		}
+	}
+	return result
+}
+
+// ResourcesByType returns all resources with the specified resource type in
+// the order of their packages.
+func (s *PackageSet) ResourcesByType(resourceType string) []*Resource {
+	var resources []*Resource
+	for _, p := range s.packages {
+		for _, r := range p.Resources {
+			if r.ResourceType == resourceType {
+				resources = append(resources, r)
+			}
+		}
+	}
+	return resources
+}
+
+// ProfileByURL returns the StructureDefinition with the specified canonical
+// URL as profile. If there is no such StructureDefinition or if it is
+// invalid, nil is returned.
+func (s *PackageSet) ProfileByURL(canonical string) *profile.Profile {
+	r := s.Resource(canonical)
+	if r == nil || r.ResourceType != structureDefinitionTypeName {
+		return nil
+	}
+	p, _ := s.profile(r)
+	return p
+}
+
+func (s *PackageSet) profile(r *Resource) (*profile.Profile, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if p, ok := s.profiles[r]; ok {
+		return p, nil
+	}
+	p, err := profile.NewProfile(r.Data)
+	if err != nil {
+		return nil, fmt.Errorf("package %s: %v", r.Package.ID(), err)
+	}
+	s.profiles[r] = p
+	return p, nil
+}
+
+// TypeDefContainer returns the type definition container of the FHIR
+// version of the root package. An error is returned if the package declares
+// no or an unsupported FHIR version.
+func (s *PackageSet) TypeDefContainer() (*common.TypeDefContainer, error) {
+	versions := s.root.Manifest.FHIRVersions
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("package %s declares no FHIR version", s.root.ID())
+	}
+	registry := version.DefaultRegistry()
+	for _, v := range versions {
+		if c := registry.ByVersion(v); c != nil {
+			return c, nil
+		}
+		if parts := strings.SplitN(v, ".", 3); len(parts) == 3 {
+			if c := registry.ByVersion(parts[0] + "." + parts[1]); c != nil {
+				return c, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("FHIR version of package %s is not supported: %s",
+		s.root.ID(), strings.Join(versions, ", "))
+}
+
+// NewValidator returns a validator for the FHIR version of the root package
+// that contains all profiles of the loaded packages. Snapshots of profiles
+// that contain only a differential are generated.
+func (s *PackageSet) NewValidator() (*profile.Validator, error) {
+	c, err := s.TypeDefContainer()
+	if err != nil {
+		return nil, err
+	}
+	v := profile.NewValidator(c)
+	generator := profile.NewSnapshotGenerator(c, s)
+	for _, r := range s.ResourcesByType(structureDefinitionTypeName) {
+		if derivation, _ := r.Data["derivation"].(string); derivation != "constraint" {
+			continue
+		}
+		p, err := s.profile(r)
+		if err != nil {
+			return nil, err
+		}
+		if v.ProfileByURL(p.Canonical()) != nil {
+			// the same profile may be contained in several packages
+			continue
+		}
+		if !p.HasSnapshot() {
+			if p, err = generator.Generate(p); err != nil {
+				return nil, fmt.Errorf("package %s: %v", r.Package.ID(), err)
+			}
+		}
+		if err := v.AddProfile(p); err != nil {
+			return nil, fmt.Errorf("package %s: %v", r.Package.ID(), err)
+		}
+	}
+	return v, nil
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package npm
+
+import (
+	"strconv"
+	"strings"
+)
+
+// matchesVersion returns if the version matches the specified version
+// pattern. Segments of the pattern may be wildcards (x or *). Patterns with
+// fewer segments match all versions with the same leading segments. An empty
+// pattern or latest matches all versions.
+func matchesVersion(version string, pattern string) bool {
+	if pattern == "" || pattern == "latest" || pattern == version {
+		return true
+	}
+	versionSegments := strings.Split(releaseVersion(version), ".")
+	patternSegments := strings.Split(pattern, ".")
+	if len(patternSegments) > len(versionSegments) {
+		return false
+	}
+	for i, s := range patternSegments {
+		if s != "x" && s != "*" && s != versionSegments[i] {
+			return false
+		}
+	}
+	// a complete version without wildcards does not match its pre-releases
+	preRelease := releaseVersion(version) != version
+	return !preRelease || len(patternSegments) < len(versionSegments) || strings.ContainsAny(pattern, "x*")
+}
+
+// compareVersions compares two semantic versions. Numeric segments are
+// compared numerically. A pre-release version is lower than its release.
+func compareVersions(v1 string, v2 string) int {
+	s1, s2 := strings.Split(releaseVersion(v1), "."), strings.Split(releaseVersion(v2), ".")
+	for i := 0; i < len(s1) || i < len(s2); i++ {
+		var p1, p2 string
+		if i < len(s1) {
+			p1 = s1[i]
+		}
+		if i < len(s2) {
+			p2 = s2[i]
+		}
+		if c := compareVersionSegments(p1, p2); c != 0 {
+			return c
+		}
+	}
+
+	pre1, pre2 := preReleaseVersion(v1), preReleaseVersion(v2)
+	switch {
+	case pre1 == pre2:
+		return 0
+	case pre1 == "":
+		return 1
+	case pre2 == "":
+		return -1
+	default:
+		return strings.Compare(pre1, pre2)
+	}
+}
+
+func compareVersionSegments(s1 string, s2 string) int {
+	n1, err1 := strconv.Atoi(s1)
+	n2, err2 := strconv.Atoi(s2)
+	if err1 == nil && err2 == nil {
+		switch {
+		case n1 < n2:
+			return -1
+		case n1 > n2:
+			return 1
+		default:
+			return 0
+		}
+	}
+	return strings.Compare(s1, s2)
+}
+
+func releaseVersion(version string) string {
+	if i := strings.IndexByte(version, '-'); i >= 0 {
+		return version[:i]
+	}
+	return version
+}
+
+func preReleaseVersion(version string) string {
+	if i := strings.IndexByte(version, '-'); i >= 0 {
+		return version[i+1:]
+	}
+	return ""
+}
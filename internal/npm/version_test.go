@@ -0,0 +1,62 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package npm
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMatchesVersion(t *testing.T) {
+	assert.True(t, matchesVersion("1.2.3", "1.2.3"))
+	assert.True(t, matchesVersion("1.2.3", ""))
+	assert.True(t, matchesVersion("1.2.3", "latest"))
+	assert.True(t, matchesVersion("1.2.3", "1.2"))
+	assert.True(t, matchesVersion("1.2.3", "1"))
+	assert.True(t, matchesVersion("1.2.3", "1.x"))
+	assert.True(t, matchesVersion("1.2.3", "1.2.*"))
+	assert.True(t, matchesVersion("1.2.3-ballot", "1.2.3-ballot"))
+	assert.True(t, matchesVersion("1.2.3-ballot", "1.2.x"))
+	assert.False(t, matchesVersion("1.2.3-ballot", "1.2.3"))
+	assert.False(t, matchesVersion("1.2.3", "1.2.4"))
+	assert.False(t, matchesVersion("1.2.3", "1.3"))
+	assert.False(t, matchesVersion("1.2.3", "1.2.3.4"))
+	assert.False(t, matchesVersion("", "1.2.3"))
+}
+
+func TestCompareVersions(t *testing.T) {
+	assert.Equal(t, 0, compareVersions("1.2.3", "1.2.3"))
+	assert.Equal(t, -1, compareVersions("1.2.3", "1.10.0"))
+	assert.Equal(t, 1, compareVersions("2.0.0", "1.10.0"))
+	assert.Equal(t, 1, compareVersions("1.2.3", "1.2"))
+	assert.Equal(t, -1, compareVersions("1.2.3-ballot", "1.2.3"))
+	assert.Equal(t, 1, compareVersions("1.2.3", "1.2.3-ballot"))
+	assert.Equal(t, 1, compareVersions("1.2.3-snapshot2", "1.2.3-snapshot1"))
+	assert.Equal(t, -1, compareVersions("", "1.0.0"))
+}
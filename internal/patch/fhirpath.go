@@ -0,0 +1,648 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package patch
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/healthiop/hi"
+	"github.com/healthiop/hi/internal/common"
+	"github.com/healthiop/hi/internal/dynamic"
+	"github.com/healthiop/hi/internal/validation"
+	gohipath "github.com/healthiop/hipath"
+	"github.com/healthiop/hipath/hipathsys"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+const (
+	parametersTypeName = "Parameters"
+	operationParamName = "operation"
+	valuePrefix        = "value"
+	extensionPropName  = "extension"
+
+	addOperationType     = "add"
+	insertOperationType  = "insert"
+	deleteOperationType  = "delete"
+	replaceOperationType = "replace"
+	moveOperationType    = "move"
+)
+
+// lastPropRegexp splits a path into the path of the parent and the name of
+// the property of the parent.
+var lastPropRegexp = regexp.MustCompile(`^(?:(.+)\.)?([A-Za-z][A-Za-z0-9]*)$`)
+
+// FHIRPathPatcher applies FHIRPath Patch documents to resources. A patch is
+// a Parameters resource with one parameter operation for each operation of
+// type add, insert, delete, replace or move. The paths of the operations are
+// evaluated with PathDynModel and may start with the resource type. Paths
+// that must identify a single element are rejected if they match several
+// elements.
+type FHIRPathPatcher struct {
+	typeDefContainer *common.TypeDefContainer
+	model            *dynamic.PathDynModel
+	validator        *validation.StructureValidator
+}
+
+type fhirPathOperation struct {
+	opType      string
+	path        string
+	name        string
+	value       map[string]interface{}
+	index       int
+	source      int
+	destination int
+}
+
+type fhirPathPatch struct {
+	*FHIRPathPatcher
+	resource hi.DynResourceAccessor
+}
+
+func NewFHIRPathPatcher(typeDefContainer *common.TypeDefContainer) *FHIRPathPatcher {
+	return &FHIRPathPatcher{
+		typeDefContainer: typeDefContainer,
+		model:            dynamic.NewPathDynModel(typeDefContainer),
+		validator:        validation.NewStructureValidator(typeDefContainer),
+	}
+}
+
+func (p *FHIRPathPatcher) TypeDefContainer() *common.TypeDefContainer {
+	return p.typeDefContainer
+}
+
+// Apply applies the patch to a copy of the resource and returns the
+// patched copy. The resource and the patch must have been created for the
+// FHIR version of the patcher.
+func (p *FHIRPathPatcher) Apply(resource hi.DynResourceAccessor, patch hi.DynResourceAccessor) (hi.DynResourceAccessor, error) {
+	data, err := resourceData(p.typeDefContainer, resource)
+	if err != nil {
+		return nil, err
+	}
+	patchData, err := resourceData(p.typeDefContainer, patch)
+	if err != nil {
+		return nil, err
+	}
+	result, err := p.ApplyData(data, patchData)
+	if err != nil {
+		return nil, err
+	}
+	return dynamic.NewDynResource(p.typeDefContainer, result, nil)
+}
+
+// ApplyData applies the patch data to a copy of the resource data and
+// returns the patched copy. The operations are applied in their order. An
+// InvalidResultError is returned if the patched resource is invalid.
+func (p *FHIRPathPatcher) ApplyData(data map[string]interface{}, patch map[string]interface{}) (map[string]interface{}, error) {
+	operations, err := parseFHIRPathOperations(patch)
+	if err != nil {
+		return nil, err
+	}
+	result := copyData(data)
+	resource, err := dynamic.NewDynResource(p.typeDefContainer, result, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	fp := &fhirPathPatch{p, resource}
+	for i, op := range operations {
+		if err := fp.apply(op); err != nil {
+			return nil, fmt.Errorf("Parameters.parameter[%d]: %w", i, err)
+		}
+	}
+	if err := validate(p.validator, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func parseFHIRPathOperations(patch map[string]interface{}) ([]*fhirPathOperation, error) {
+	if patch[dynamic.ResourceTypePropName] != parametersTypeName {
+		return nil, fmt.Errorf("patch is not a Parameters resource: %v", patch[dynamic.ResourceTypePropName])
+	}
+	params, ok := patch["parameter"].([]interface{})
+	if !ok && patch["parameter"] != nil {
+		return nil, fmt.Errorf("Parameters.parameter must be an array: %T", patch["parameter"])
+	}
+
+	operations := make([]*fhirPathOperation, 0, len(params))
+	for i, param := range params {
+		op, err := parseFHIRPathOperation(param)
+		if err != nil {
+			return nil, fmt.Errorf("Parameters.parameter[%d]: %w", i, err)
+		}
+		operations = append(operations, op)
+	}
+	return operations, nil
+}
+
+func parseFHIRPathOperation(param interface{}) (*fhirPathOperation, error) {
+	m, ok := param.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("parameter must be an object: %T", param)
+	}
+	if m["name"] != operationParamName {
+		return nil, fmt.Errorf("parameter must have name %s: %v", operationParamName, m["name"])
+	}
+	parts, _ := m["part"].([]interface{})
+	values := make(map[string]map[string]interface{}, len(parts))
+	for _, part := range parts {
+		pm, ok := part.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("part must be an object: %T", part)
+		}
+		name, _ := pm["name"].(string)
+		if _, found := values[name]; found {
+			return nil, fmt.Errorf("part %s is contained more than once", name)
+		}
+		values[name] = pm
+	}
+
+	op := &fhirPathOperation{value: values["value"]}
+	var err error
+	if op.opType, err = stringPart(values, "type", "valueCode"); err != nil {
+		return nil, err
+	}
+	if op.path, err = stringPart(values, "path", "valueString"); err != nil {
+		return nil, err
+	}
+
+	switch op.opType {
+	case addOperationType:
+		if op.name, err = stringPart(values, "name", "valueString"); err != nil {
+			return nil, err
+		}
+	case insertOperationType:
+		op.index, err = integerPart(values, "index")
+	case moveOperationType:
+		if op.source, err = integerPart(values, "source"); err != nil {
+			return nil, err
+		}
+		op.destination, err = integerPart(values, "destination")
+	case deleteOperationType, replaceOperationType:
+	default:
+		return nil, fmt.Errorf("unsupported operation type: %s", op.opType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.opType {
+	case addOperationType, insertOperationType, replaceOperationType:
+		if op.value == nil {
+			return nil, fmt.Errorf("part value is missing for operation type %s", op.opType)
+		}
+	}
+	return op, nil
+}
+
+func stringPart(parts map[string]map[string]interface{}, name string, valueName string) (string, error) {
+	part := parts[name]
+	if part == nil {
+		return "", fmt.Errorf("part %s is missing", name)
+	}
+	s, ok := part[valueName].(string)
+	if !ok || s == "" {
+		return "", fmt.Errorf("part %s must have a %s", name, valueName)
+	}
+	return s, nil
+}
+
+func integerPart(parts map[string]map[string]interface{}, name string) (int, error) {
+	part := parts[name]
+	if part == nil {
+		return 0, fmt.Errorf("part %s is missing", name)
+	}
+	switch v := part["valueInteger"].(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return int(i), nil
+		}
+	case float64:
+		if v == float64(int(v)) {
+			return int(v), nil
+		}
+	case int:
+		return v, nil
+	}
+	return 0, fmt.Errorf("part %s must have an integer valueInteger", name)
+}
+
+func (fp *fhirPathPatch) apply(op *fhirPathOperation) error {
+	switch op.opType {
+	case addOperationType:
+		return fp.add(op)
+	case insertOperationType:
+		return fp.insert(op)
+	case deleteOperationType:
+		return fp.delete(op)
+	case replaceOperationType:
+		return fp.replace(op)
+	default:
+		return fp.move(op)
+	}
+}
+
+// add adds the value as property with the specified name to the single
+// element that is identified by the path. The value is appended if the
+// property is a list. Extensions can also be added to primitive values.
+func (fp *fhirPathPatch) add(op *fhirPathOperation) error {
+	node, err := fp.single(op.path)
+	if err != nil {
+		return err
+	}
+	if _, ok := node.(hi.DynPrimitiveAccessor); ok && op.name == extensionPropName {
+		return fp.addPrimitiveExtension(op, node)
+	}
+	m, err := fp.mutator(op.path, node)
+	if err != nil {
+		return err
+	}
+	propDef, value, err := fp.value(m, op.name, op.value)
+	if err != nil {
+		return err
+	}
+
+	if propDef.Array() {
+		return m.AppendItem(propDef.Name(), value)
+	}
+	if existing, _ := m.Prop(propDef.Name()); existing != nil {
+		return fmt.Errorf("property %s of path %s has already a value", propDef.Name(), op.path)
+	}
+	return setValue(m, propDef, value)
+}
+
+// addPrimitiveExtension appends the value to the extensions of the primitive
+// value that is identified by the path.
+func (fp *fhirPathPatch) addPrimitiveExtension(op *fhirPathOperation, node interface{}) error {
+	m, name, index, err := fp.location(op.path, node)
+	if err != nil {
+		return err
+	}
+	elementTypeDef := fp.typeDefContainer.ElementType()
+	propDef, value, err := fp.typedValue(elementTypeDef, op.name, op.value)
+	if err != nil {
+		return err
+	}
+
+	var extensions []hi.DynElementAccessor
+	items, _ := node.(dynamic.DynDataRetriever).Data()[extensionPropName].([]interface{})
+	for _, item := range items {
+		data, ok := item.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("extension of path %s must be an object: %T", op.path, item)
+		}
+		e, err := dynamic.NewDynElement(fp.typeDefContainer, propDef.Type().(common.StructTypeDefAccessor), data, nil)
+		if err != nil {
+			return fmt.Errorf("extension of path %s is invalid: %w", op.path, err)
+		}
+		extensions = append(extensions, e)
+	}
+	extensions = append(extensions, value.(hi.DynElementAccessor))
+	return m.SetPrimitiveExtensions(name, index, extensions)
+}
+
+// insert inserts the value into the list that is identified by the path.
+func (fp *fhirPathPatch) insert(op *fhirPathOperation) error {
+	m, name, err := fp.list(op.path)
+	if err != nil {
+		return err
+	}
+	propDef, value, err := fp.value(m, name, op.value)
+	if err != nil {
+		return err
+	}
+	return m.InsertItem(propDef.Name(), op.index, value)
+}
+
+// delete removes the single element that is identified by the path. Paths
+// that do not match any element are ignored.
+func (fp *fhirPathPatch) delete(op *fhirPathOperation) error {
+	nodes, err := fp.evaluate(op.path)
+	if err != nil || len(nodes) == 0 {
+		return err
+	}
+	if len(nodes) > 1 {
+		return fmt.Errorf("path %s matches %d elements", op.path, len(nodes))
+	}
+	m, name, index, err := fp.location(op.path, nodes[0])
+	if err != nil {
+		return err
+	}
+	if index >= 0 {
+		return m.RemoveItem(name, index)
+	}
+	return m.ClearProp(name)
+}
+
+// replace replaces the single element that is identified by the path. The
+// value may have another type of the same choice.
+func (fp *fhirPathPatch) replace(op *fhirPathOperation) error {
+	node, err := fp.single(op.path)
+	if err != nil {
+		return err
+	}
+	m, name, index, err := fp.location(op.path, node)
+	if err != nil {
+		return err
+	}
+	propDef, value, err := fp.value(m, choiceName(m, name), op.value)
+	if err != nil {
+		return err
+	}
+
+	if index >= 0 {
+		if propDef.Name() != name {
+			return fmt.Errorf("list property %s cannot be replaced by %s", name, propDef.Name())
+		}
+		return m.SetItem(name, index, value)
+	}
+	if propDef.Name() != name {
+		if err := m.ClearProp(name); err != nil {
+			return err
+		}
+	}
+	return setValue(m, propDef, value)
+}
+
+// move moves an item of the list that is identified by the path.
+func (fp *fhirPathPatch) move(op *fhirPathOperation) error {
+	m, name, err := fp.list(op.path)
+	if err != nil {
+		return err
+	}
+	return m.MoveItem(name, op.source, op.destination)
+}
+
+// evaluate returns the nodes that are identified by the path. The path may
+// start with the resource type.
+func (fp *fhirPathPatch) evaluate(path string) ([]interface{}, error) {
+	expression := fp.relativePath(path)
+	if expression == "" {
+		return []interface{}{fp.resource}, nil
+	}
+	compiled, compileErr := gohipath.Compile(expression)
+	if compileErr != nil {
+		return nil, fmt.Errorf("invalid path %s: %s", path, compileErr)
+	}
+	ctx := dynamic.NewPathDynContext(fp.model, fp.resource, map[string]interface{}{
+		"resource":     fp.resource,
+		"rootResource": fp.resource,
+	})
+	col, pathErr := compiled.Execute(ctx, fp.resource)
+	if pathErr != nil {
+		return nil, fmt.Errorf("path %s cannot be evaluated: %s", path, pathErr)
+	}
+	nodes := make([]interface{}, 0, col.Count())
+	for i := 0; i < col.Count(); i++ {
+		node := col.Get(i)
+		if a, ok := node.(hipathsys.AnyAccessor); ok && a.Source() != nil {
+			node = a.Source()
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// relativePath removes the resource type from the start of the path.
+func (fp *fhirPathPatch) relativePath(path string) string {
+	resourceType := fp.resource.TypeName()
+	if path == resourceType {
+		return ""
+	}
+	return strings.TrimPrefix(path, resourceType+".")
+}
+
+// single returns the single node that is identified by the path.
+func (fp *fhirPathPatch) single(path string) (interface{}, error) {
+	nodes, err := fp.evaluate(path)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 0:
+		return nil, fmt.Errorf("path %s matches no element", path)
+	case 1:
+		return nodes[0], nil
+	default:
+		return nil, fmt.Errorf("path %s matches %d elements", path, len(nodes))
+	}
+}
+
+// list returns the element that contains the list property that is
+// identified by the path, together with the name of the property.
+func (fp *fhirPathPatch) list(path string) (hi.DynStructMutator, string, error) {
+	match := lastPropRegexp.FindStringSubmatch(fp.relativePath(path))
+	if match == nil {
+		return nil, "", fmt.Errorf("path %s does not end with a property name", path)
+	}
+	parentPath := match[1]
+	if parentPath == "" {
+		parentPath = fp.resource.TypeName()
+	}
+	node, err := fp.single(parentPath)
+	if err != nil {
+		return nil, "", err
+	}
+	m, err := fp.mutator(parentPath, node)
+	if err != nil {
+		return nil, "", err
+	}
+	return m, match[2], nil
+}
+
+// location returns the element that contains the node together with the
+// name of the property and the index of the node within the list.
+func (fp *fhirPathPatch) location(path string, node interface{}) (hi.DynStructMutator, string, int, error) {
+	parent, name, index, found := fp.model.Location(node)
+	if !found {
+		return nil, "", 0, fmt.Errorf("path %s does not match a property value", path)
+	}
+	m, err := fp.mutator(path, parent)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	return m, name, index, nil
+}
+
+func (fp *fhirPathPatch) mutator(path string, node interface{}) (hi.DynStructMutator, error) {
+	if _, ok := node.(hi.DynPrimitiveAccessor); ok {
+		return nil, fmt.Errorf("path %s matches a primitive value", path)
+	}
+	m, ok := node.(hi.DynStructMutator)
+	if !ok {
+		return nil, fmt.Errorf("path %s does not match an element: %T", path, node)
+	}
+	return m, nil
+}
+
+// value returns the property of the element and the value of the part
+// value. The value of a primitive is its JSON value, other values are
+// accessors.
+func (fp *fhirPathPatch) value(m hi.DynStructMutator, name string, part map[string]interface{}) (*common.PropDef, interface{}, error) {
+	typeDef := m.(dynamic.DynTypeDefRetriever).Type().(common.StructTypeDefAccessor)
+	return fp.typedValue(typeDef, name, part)
+}
+
+// typedValue returns the property of the type and the value of the part
+// value like value.
+func (fp *fhirPathPatch) typedValue(typeDef common.StructTypeDefAccessor, name string, part map[string]interface{}) (*common.PropDef, interface{}, error) {
+	propDef, data, err := fp.partData(typeDef, name, part)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch t := propDef.Type().(type) {
+	case common.PrimitiveTypeDefAccessor:
+		return propDef, data, nil
+	case common.StructTypeDefAccessor:
+		dataMap, ok := data.(map[string]interface{})
+		if !ok {
+			return nil, nil, fmt.Errorf("value of property %s must be an object: %T", propDef.Name(), data)
+		}
+		var value hi.DynStructAccessor
+		if t.TypeKind() == common.ResourceTypeKind {
+			value, err = dynamic.NewDynResource(fp.typeDefContainer, dataMap, nil)
+		} else {
+			value, err = dynamic.NewDynElement(fp.typeDefContainer, t, dataMap, nil)
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("value of property %s is invalid: %w", propDef.Name(), err)
+		}
+		return propDef, value, nil
+	default:
+		return nil, nil, fmt.Errorf("property %s has unsupported type %s", propDef.Name(), propDef.Type().Name())
+	}
+}
+
+// partData returns the property of the type and the JSON data of the part.
+// The part contains either a typed value, a resource or parts that contain
+// the properties of the value. A typed value selects the type of a choice
+// if the name has no type suffix.
+func (fp *fhirPathPatch) partData(typeDef common.StructTypeDefAccessor, name string, part map[string]interface{}) (*common.PropDef, interface{}, error) {
+	var valueName, typeSuffix string
+	for k := range part {
+		if len(k) > len(valuePrefix) && strings.HasPrefix(k, valuePrefix) && unicode.IsUpper(rune(k[len(valuePrefix)])) {
+			if valueName != "" {
+				return nil, nil, fmt.Errorf("part of property %s contains more than one value", name)
+			}
+			valueName, typeSuffix = k, k[len(valuePrefix):]
+		}
+	}
+
+	propDef := typeDef.PropByName(name)
+	if propDef == nil && typeSuffix != "" {
+		propDef = typeDef.PropByName(name + typeSuffix)
+	}
+	if propDef == nil {
+		return nil, nil, fmt.Errorf("type %s has no property named %s", typeDef.InternalName(), name)
+	}
+
+	if valueName != "" {
+		return propDef, part[valueName], nil
+	}
+	if resource := part["resource"]; resource != nil {
+		return propDef, resource, nil
+	}
+	subParts, ok := part["part"].([]interface{})
+	if !ok || len(subParts) == 0 {
+		return nil, nil, fmt.Errorf("part of property %s contains no value", name)
+	}
+	structTypeDef, ok := propDef.Type().(common.StructTypeDefAccessor)
+	if !ok || structTypeDef.TypeKind() == common.ResourceTypeKind {
+		return nil, nil, fmt.Errorf("property %s cannot be specified by parts", name)
+	}
+
+	data := make(map[string]interface{})
+	for _, subPart := range subParts {
+		sp, ok := subPart.(map[string]interface{})
+		if !ok {
+			return nil, nil, fmt.Errorf("part of property %s must be an object: %T", name, subPart)
+		}
+		subName, _ := sp["name"].(string)
+		subPropDef, subData, err := fp.partData(structTypeDef, subName, sp)
+		if err != nil {
+			return nil, nil, fmt.Errorf("part %s: %w", name, err)
+		}
+		if subPropDef.Array() {
+			items, _ := data[subPropDef.Name()].([]interface{})
+			data[subPropDef.Name()] = append(items, subData)
+		} else if _, found := data[subPropDef.Name()]; found {
+			return nil, nil, fmt.Errorf("part of property %s contains property %s more than once", name, subPropDef.Name())
+		} else {
+			data[subPropDef.Name()] = subData
+		}
+	}
+	return propDef, data, nil
+}
+
+// choiceName returns the name of the choice without type suffix if the
+// property belongs to a choice, so that the value can select another type
+// of the choice.
+func choiceName(m hi.DynStructMutator, name string) string {
+	typeDef := m.(dynamic.DynTypeDefRetriever).Type().(common.StructTypeDefAccessor)
+	propDef := typeDef.PropByName(name)
+	if propDef == nil {
+		return name
+	}
+	typeName := propDef.Type().Name()
+	if len(name) <= len(typeName) || !strings.EqualFold(name[len(name)-len(typeName):], typeName) {
+		return name
+	}
+	base := name[:len(name)-len(typeName)]
+	for _, p := range typeDef.Props() {
+		if p != propDef && strings.HasPrefix(p.Name(), base) &&
+			strings.EqualFold(p.Name()[len(base):], p.Type().Name()) {
+			return base
+		}
+	}
+	return name
+}
+
+func setValue(m hi.DynStructMutator, propDef *common.PropDef, value interface{}) error {
+	if s, ok := value.(hi.DynStructAccessor); ok {
+		return m.SetElement(propDef.Name(), s)
+	}
+	return m.SetPrimitive(propDef.Name(), value)
+}
+
+// resourceData returns the data of the resource after checking its FHIR
+// version.
+func resourceData(typeDefContainer *common.TypeDefContainer, resource hi.DynResourceAccessor) (map[string]interface{}, error) {
+	if resource.VersionString() != typeDefContainer.VersionString() {
+		return nil, fmt.Errorf("resource has FHIR version %s instead of %s",
+			resource.VersionString(), typeDefContainer.VersionString())
+	}
+	dataRetriever, ok := resource.(dynamic.DynDataRetriever)
+	if !ok {
+		return nil, fmt.Errorf("resource data cannot be accessed: %T", resource)
+	}
+	return dataRetriever.Data(), nil
+}
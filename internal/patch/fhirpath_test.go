@@ -0,0 +1,428 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package patch
+
+import (
+	"encoding/json"
+	"github.com/healthiop/hi/internal/dynamic"
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/healthiop/hi/internal/stu3"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func newTestPatient() map[string]interface{} {
+	return map[string]interface{}{
+		"resourceType": "Patient",
+		"id":           "example",
+		"identifier": []interface{}{
+			map[string]interface{}{"system": "http://example.com/mrn", "value": "1"},
+			map[string]interface{}{"system": "http://example.com/ssn", "value": "2"},
+		},
+		"name": []interface{}{
+			map[string]interface{}{"use": "official", "family": "Doe", "given": []interface{}{"Jane", "Mary"}},
+			map[string]interface{}{"use": "usual", "given": []interface{}{"Janie"}},
+		},
+		"birthDate":       "1970-03-30",
+		"deceasedBoolean": false,
+	}
+}
+
+func fhirPathOp(parts ...map[string]interface{}) map[string]interface{} {
+	items := make([]interface{}, len(parts))
+	for i, p := range parts {
+		items[i] = p
+	}
+	return map[string]interface{}{"name": "operation", "part": items}
+}
+
+func fhirPathPart(name string, valueName string, value interface{}) map[string]interface{} {
+	return map[string]interface{}{"name": name, valueName: value}
+}
+
+func fhirPathPatchData(operations ...map[string]interface{}) map[string]interface{} {
+	params := make([]interface{}, len(operations))
+	for i, op := range operations {
+		params[i] = op
+	}
+	return map[string]interface{}{"resourceType": "Parameters", "parameter": params}
+}
+
+func TestFHIRPathPatcher(t *testing.T) {
+	tests := []struct {
+		name     string
+		patch    map[string]interface{}
+		expected func(data map[string]interface{})
+	}{
+		{"add primitive", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "add"),
+			fhirPathPart("path", "valueString", "Patient"),
+			fhirPathPart("name", "valueString", "gender"),
+			fhirPathPart("value", "valueCode", "female"),
+		)), func(data map[string]interface{}) {
+			data["gender"] = "female"
+		}},
+		{"add list item", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "add"),
+			fhirPathPart("path", "valueString", "Patient.name.where(use='usual')"),
+			fhirPathPart("name", "valueString", "given"),
+			fhirPathPart("value", "valueString", "J"),
+		)), func(data map[string]interface{}) {
+			data["name"].([]interface{})[1].(map[string]interface{})["given"] = []interface{}{"Janie", "J"}
+		}},
+		{"add parts", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "add"),
+			fhirPathPart("path", "valueString", "Patient"),
+			fhirPathPart("name", "valueString", "contact"),
+			map[string]interface{}{"name": "value", "part": []interface{}{
+				map[string]interface{}{"name": "name", "part": []interface{}{
+					fhirPathPart("family", "valueString", "Doe"),
+					fhirPathPart("given", "valueString", "Joe"),
+					fhirPathPart("given", "valueString", "J"),
+				}},
+				fhirPathPart("gender", "valueCode", "male"),
+			}},
+		)), func(data map[string]interface{}) {
+			data["contact"] = []interface{}{map[string]interface{}{
+				"name":   map[string]interface{}{"family": "Doe", "given": []interface{}{"Joe", "J"}},
+				"gender": "male",
+			}}
+		}},
+		{"add complex value", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "add"),
+			fhirPathPart("path", "valueString", "Patient"),
+			fhirPathPart("name", "valueString", "maritalStatus"),
+			fhirPathPart("value", "valueCodeableConcept", map[string]interface{}{"text": "married"}),
+		)), func(data map[string]interface{}) {
+			data["maritalStatus"] = map[string]interface{}{"text": "married"}
+		}},
+		{"add choice", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "add"),
+			fhirPathPart("path", "valueString", "Patient"),
+			fhirPathPart("name", "valueString", "multipleBirth"),
+			fhirPathPart("value", "valueInteger", json.Number("2")),
+		)), func(data map[string]interface{}) {
+			data["multipleBirthInteger"] = json.Number("2")
+		}},
+		{"add primitive extension", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "add"),
+			fhirPathPart("path", "valueString", "Patient.birthDate"),
+			fhirPathPart("name", "valueString", "extension"),
+			map[string]interface{}{"name": "value", "part": []interface{}{
+				fhirPathPart("url", "valueUri", "http://example.com/accuracy"),
+				fhirPathPart("value", "valueCode", "estimated"),
+			}},
+		)), func(data map[string]interface{}) {
+			data["_birthDate"] = map[string]interface{}{"extension": []interface{}{
+				map[string]interface{}{"url": "http://example.com/accuracy", "valueCode": "estimated"},
+			}}
+		}},
+		{"add primitive list item extension", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "add"),
+			fhirPathPart("path", "valueString", "Patient.name[0].given[1]"),
+			fhirPathPart("name", "valueString", "extension"),
+			map[string]interface{}{"name": "value", "part": []interface{}{
+				fhirPathPart("url", "valueUri", "http://example.com/qualifier"),
+				fhirPathPart("value", "valueCode", "MID"),
+			}},
+		)), func(data map[string]interface{}) {
+			data["name"].([]interface{})[0].(map[string]interface{})["_given"] = []interface{}{
+				nil,
+				map[string]interface{}{"extension": []interface{}{
+					map[string]interface{}{"url": "http://example.com/qualifier", "valueCode": "MID"},
+				}},
+			}
+		}},
+		{"insert", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "insert"),
+			fhirPathPart("path", "valueString", "Patient.name[0].given"),
+			fhirPathPart("index", "valueInteger", json.Number("1")),
+			fhirPathPart("value", "valueString", "Ann"),
+		)), func(data map[string]interface{}) {
+			data["name"].([]interface{})[0].(map[string]interface{})["given"] = []interface{}{"Jane", "Ann", "Mary"}
+		}},
+		{"insert empty list", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "insert"),
+			fhirPathPart("path", "valueString", "telecom"),
+			fhirPathPart("index", "valueInteger", float64(0)),
+			fhirPathPart("value", "valueContactPoint", map[string]interface{}{"system": "phone", "value": "123"}),
+		)), func(data map[string]interface{}) {
+			data["telecom"] = []interface{}{map[string]interface{}{"system": "phone", "value": "123"}}
+		}},
+		{"delete", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "delete"),
+			fhirPathPart("path", "valueString", "Patient.identifier.where(system='http://example.com/mrn')"),
+		)), func(data map[string]interface{}) {
+			data["identifier"] = data["identifier"].([]interface{})[1:]
+		}},
+		{"delete primitive", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "delete"),
+			fhirPathPart("path", "valueString", "Patient.birthDate"),
+		)), func(data map[string]interface{}) {
+			delete(data, "birthDate")
+		}},
+		{"delete no match", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "delete"),
+			fhirPathPart("path", "valueString", "Patient.gender"),
+		)), func(data map[string]interface{}) {
+		}},
+		{"replace", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "replace"),
+			fhirPathPart("path", "valueString", "Patient.birthDate"),
+			fhirPathPart("value", "valueDate", "1970-03-31"),
+		)), func(data map[string]interface{}) {
+			data["birthDate"] = "1970-03-31"
+		}},
+		{"replace list item", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "replace"),
+			fhirPathPart("path", "valueString", "Patient.name[0].given[1]"),
+			fhirPathPart("value", "valueString", "Maria"),
+		)), func(data map[string]interface{}) {
+			data["name"].([]interface{})[0].(map[string]interface{})["given"] = []interface{}{"Jane", "Maria"}
+		}},
+		{"replace choice", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "replace"),
+			fhirPathPart("path", "valueString", "Patient.deceased"),
+			fhirPathPart("value", "valueDateTime", "2020-01-01"),
+		)), func(data map[string]interface{}) {
+			delete(data, "deceasedBoolean")
+			data["deceasedDateTime"] = "2020-01-01"
+		}},
+		{"move", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "move"),
+			fhirPathPart("path", "valueString", "Patient.name"),
+			fhirPathPart("source", "valueInteger", json.Number("1")),
+			fhirPathPart("destination", "valueInteger", json.Number("0")),
+		)), func(data map[string]interface{}) {
+			names := data["name"].([]interface{})
+			data["name"] = []interface{}{names[1], names[0]}
+		}},
+		{"several operations", fhirPathPatchData(
+			fhirPathOp(
+				fhirPathPart("type", "valueCode", "delete"),
+				fhirPathPart("path", "valueString", "Patient.name[1]"),
+			),
+			fhirPathOp(
+				fhirPathPart("type", "valueCode", "replace"),
+				fhirPathPart("path", "valueString", "Patient.name.family"),
+				fhirPathPart("value", "valueString", "Smith"),
+			),
+		), func(data map[string]interface{}) {
+			names := data["name"].([]interface{})
+			names[0].(map[string]interface{})["family"] = "Smith"
+			data["name"] = names[:1]
+		}},
+	}
+
+	p := NewFHIRPathPatcher(r4.TypeDefContainer())
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := newTestPatient()
+			expected := newTestPatient()
+			test.expected(expected)
+			result, err := p.ApplyData(data, test.patch)
+			if assert.NoError(t, err) {
+				assert.Equal(t, expected, result)
+				assert.Equal(t, newTestPatient(), data, "original data must not be modified")
+			}
+		})
+	}
+}
+
+func TestFHIRPathPatcherAddPrimitiveExtensionExisting(t *testing.T) {
+	data := newTestPatient()
+	data["_birthDate"] = map[string]interface{}{
+		"id": "bd",
+		"extension": []interface{}{
+			map[string]interface{}{"url": "http://example.com/source", "valueString": "registration"},
+		},
+	}
+	patch := fhirPathPatchData(fhirPathOp(
+		fhirPathPart("type", "valueCode", "add"),
+		fhirPathPart("path", "valueString", "birthDate"),
+		fhirPathPart("name", "valueString", "extension"),
+		map[string]interface{}{"name": "value", "part": []interface{}{
+			fhirPathPart("url", "valueUri", "http://example.com/accuracy"),
+			fhirPathPart("value", "valueCode", "estimated"),
+		}},
+	))
+
+	result, err := NewFHIRPathPatcher(r4.TypeDefContainer()).ApplyData(data, patch)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "1970-03-30", result["birthDate"])
+		assert.Equal(t, map[string]interface{}{
+			"id": "bd",
+			"extension": []interface{}{
+				map[string]interface{}{"url": "http://example.com/source", "valueString": "registration"},
+				map[string]interface{}{"url": "http://example.com/accuracy", "valueCode": "estimated"},
+			},
+		}, result["_birthDate"])
+	}
+}
+
+func TestFHIRPathPatcherErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		patch map[string]interface{}
+		err   string
+	}{
+		{"no parameters", map[string]interface{}{"resourceType": "Patient"},
+			"patch is not a Parameters resource: Patient"},
+		{"parameter name", fhirPathPatchData(map[string]interface{}{"name": "test"}),
+			"Parameters.parameter[0]: parameter must have name operation: test"},
+		{"unsupported type", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "copy"),
+			fhirPathPart("path", "valueString", "Patient"),
+		)), "Parameters.parameter[0]: unsupported operation type: copy"},
+		{"missing path", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "delete"),
+		)), "Parameters.parameter[0]: part path is missing"},
+		{"missing value", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "replace"),
+			fhirPathPart("path", "valueString", "Patient.birthDate"),
+		)), "Parameters.parameter[0]: part value is missing for operation type replace"},
+		{"missing index", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "insert"),
+			fhirPathPart("path", "valueString", "Patient.name"),
+			fhirPathPart("index", "valueString", "1"),
+			fhirPathPart("value", "valueString", "Doe"),
+		)), "Parameters.parameter[0]: part index must have an integer valueInteger"},
+		{"ambiguous delete", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "delete"),
+			fhirPathPart("path", "valueString", "Patient.name.given"),
+		)), "Parameters.parameter[0]: path Patient.name.given matches 3 elements"},
+		{"ambiguous add", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "add"),
+			fhirPathPart("path", "valueString", "Patient.name"),
+			fhirPathPart("name", "valueString", "text"),
+			fhirPathPart("value", "valueString", "Jane Doe"),
+		)), "Parameters.parameter[0]: path Patient.name matches 2 elements"},
+		{"replace no match", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "replace"),
+			fhirPathPart("path", "valueString", "Patient.gender"),
+			fhirPathPart("value", "valueCode", "female"),
+		)), "Parameters.parameter[0]: path Patient.gender matches no element"},
+		{"add existing", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "add"),
+			fhirPathPart("path", "valueString", "Patient"),
+			fhirPathPart("name", "valueString", "birthDate"),
+			fhirPathPart("value", "valueDate", "1970-03-31"),
+		)), "Parameters.parameter[0]: property birthDate of path Patient has already a value"},
+		{"add to primitive", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "add"),
+			fhirPathPart("path", "valueString", "Patient.birthDate"),
+			fhirPathPart("name", "valueString", "id"),
+			fhirPathPart("value", "valueString", "1"),
+		)), "Parameters.parameter[0]: path Patient.birthDate matches a primitive value"},
+		{"undefined property", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "add"),
+			fhirPathPart("path", "valueString", "Patient"),
+			fhirPathPart("name", "valueString", "test"),
+			fhirPathPart("value", "valueString", "1"),
+		)), "Parameters.parameter[0]: type Patient has no property named test"},
+		{"invalid value", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "replace"),
+			fhirPathPart("path", "valueString", "Patient.birthDate"),
+			fhirPathPart("value", "valueDate", "31.03.1970"),
+		)), "Parameters.parameter[0]: property birthDate of type Patient: "},
+		{"invalid path", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "delete"),
+			fhirPathPart("path", "valueString", "Patient.name."),
+		)), "Parameters.parameter[0]: invalid path Patient.name.: "},
+		{"move out of range", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "move"),
+			fhirPathPart("path", "valueString", "Patient.name"),
+			fhirPathPart("source", "valueInteger", json.Number("2")),
+			fhirPathPart("destination", "valueInteger", json.Number("0")),
+		)), "Parameters.parameter[0]: index 2 of list property name of type Patient is out of range"},
+		{"move no list", fhirPathPatchData(fhirPathOp(
+			fhirPathPart("type", "valueCode", "move"),
+			fhirPathPart("path", "valueString", "Patient.name.where("),
+			fhirPathPart("source", "valueInteger", json.Number("0")),
+			fhirPathPart("destination", "valueInteger", json.Number("1")),
+		)), "Parameters.parameter[0]: path Patient.name.where( does not end with a property name"},
+	}
+
+	p := NewFHIRPathPatcher(r4.TypeDefContainer())
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := p.ApplyData(newTestPatient(), test.patch)
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), test.err)
+			}
+		})
+	}
+}
+
+func TestFHIRPathPatcherInvalidResult(t *testing.T) {
+	_, err := NewFHIRPathPatcher(r4.TypeDefContainer()).ApplyData(newTestPatient(), fhirPathPatchData(
+		fhirPathOp(
+			fhirPathPart("type", "valueCode", "delete"),
+			fhirPathPart("path", "valueString", "Patient.name[1].use"),
+		),
+		fhirPathOp(
+			fhirPathPart("type", "valueCode", "delete"),
+			fhirPathPart("path", "valueString", "Patient.name[1].given"),
+		),
+	))
+	if assert.IsType(t, &InvalidResultError{}, err) {
+		assert.False(t, err.(*InvalidResultError).Outcome.Valid())
+		assert.Equal(t, "patched resource is invalid: Patient.name[1]: object must not be empty", err.Error())
+	}
+}
+
+func TestFHIRPathPatcherApply(t *testing.T) {
+	p := NewFHIRPathPatcher(r4.TypeDefContainer())
+	assert.Same(t, r4.TypeDefContainer(), p.TypeDefContainer())
+
+	r, err := dynamic.NewDynResource(r4.TypeDefContainer(), newTestPatient(), nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	patch, err := dynamic.NewDynResource(r4.TypeDefContainer(), fhirPathPatchData(fhirPathOp(
+		fhirPathPart("type", "valueCode", "delete"),
+		fhirPathPart("path", "valueString", "Patient.birthDate"),
+	)), nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	result, err := p.Apply(r, patch)
+	if assert.NoError(t, err) {
+		expected := newTestPatient()
+		delete(expected, "birthDate")
+		assert.Equal(t, expected, result.(dynamic.DynDataRetriever).Data())
+	}
+
+	r, err = dynamic.NewDynResource(stu3.TypeDefContainer(), newTestPatient(), nil)
+	if assert.NoError(t, err) {
+		_, err = p.Apply(r, patch)
+		assert.EqualError(t, err, "resource has FHIR version "+stu3.TypeDefContainer().VersionString()+
+			" instead of "+r4.TypeDefContainer().VersionString())
+	}
+}
@@ -0,0 +1,499 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package patch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/healthiop/hi"
+	"github.com/healthiop/hi/internal/common"
+	"github.com/healthiop/hi/internal/dynamic"
+	"github.com/healthiop/hi/internal/validation"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	addJSONOp     = "add"
+	removeJSONOp  = "remove"
+	replaceJSONOp = "replace"
+	moveJSONOp    = "move"
+	copyJSONOp    = "copy"
+	testJSONOp    = "test"
+
+	appendIndex = "-"
+)
+
+// jsonIndexRegexp matches array indexes of JSON pointers, which must not
+// have leading zeros.
+var jsonIndexRegexp = regexp.MustCompile(`^(0|[1-9][0-9]*)$`)
+
+var jsonPointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+// JSONPatcher applies JSON Patch documents (RFC 6902) to resources. Before
+// an operation is applied, its JSON pointers are checked against the
+// property definitions of the resource type, so that the failing operation
+// can be reported. Operations that replace the whole resource must keep its
+// resource type. The structure of the patched resource is validated
+// afterwards, which detects values with unexpected JSON types and empty
+// objects and arrays.
+type JSONPatcher struct {
+	typeDefContainer *common.TypeDefContainer
+	validator        *validation.StructureValidator
+}
+
+type jsonOperation struct {
+	op    string
+	path  string
+	from  string
+	value interface{}
+}
+
+func NewJSONPatcher(typeDefContainer *common.TypeDefContainer) *JSONPatcher {
+	return &JSONPatcher{
+		typeDefContainer: typeDefContainer,
+		validator:        validation.NewStructureValidator(typeDefContainer),
+	}
+}
+
+func (p *JSONPatcher) TypeDefContainer() *common.TypeDefContainer {
+	return p.typeDefContainer
+}
+
+// Apply applies the JSON Patch document to a copy of the resource and
+// returns the patched copy. The resource must have been created for the
+// FHIR version of the patcher.
+func (p *JSONPatcher) Apply(resource hi.DynResourceAccessor, patch []byte) (hi.DynResourceAccessor, error) {
+	data, err := resourceData(p.typeDefContainer, resource)
+	if err != nil {
+		return nil, err
+	}
+	d := json.NewDecoder(bytes.NewReader(patch))
+	d.UseNumber()
+	var operations []interface{}
+	if err := d.Decode(&operations); err != nil {
+		return nil, fmt.Errorf("patch is not a JSON array: %w", err)
+	}
+	result, err := p.ApplyData(data, operations)
+	if err != nil {
+		return nil, err
+	}
+	return dynamic.NewDynResource(p.typeDefContainer, result, nil)
+}
+
+// ApplyData applies the operations of the JSON Patch document to a copy of
+// the resource data and returns the patched copy. The operations are
+// applied in their order and the patch fails as a whole if one operation
+// fails. An InvalidResultError is returned if the patched resource is
+// invalid.
+func (p *JSONPatcher) ApplyData(data map[string]interface{}, patch []interface{}) (map[string]interface{}, error) {
+	resourceType, _ := data[dynamic.ResourceTypePropName].(string)
+	typeDef, ok := p.typeDefContainer.TypeByName(resourceType).(common.StructTypeDefAccessor)
+	if !ok || typeDef.TypeKind() != common.ResourceTypeKind {
+		return nil, fmt.Errorf("resource type undefined: %s", resourceType)
+	}
+
+	var doc interface{} = copyData(data)
+	for i, item := range patch {
+		op, err := parseJSONOperation(item)
+		if err == nil {
+			doc, err = p.apply(typeDef, doc, op)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("patch[%d]: %w", i, err)
+		}
+	}
+
+	result, ok := doc.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("patched resource is not an object: %T", doc)
+	}
+	if err := validate(p.validator, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func parseJSONOperation(item interface{}) (*jsonOperation, error) {
+	m, ok := item.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("operation must be an object: %T", item)
+	}
+	op := &jsonOperation{}
+	if op.op, ok = m["op"].(string); !ok {
+		return nil, fmt.Errorf("operation contains no op")
+	}
+	if op.path, ok = m["path"].(string); !ok {
+		return nil, fmt.Errorf("%s operation contains no path", op.op)
+	}
+
+	switch op.op {
+	case addJSONOp, replaceJSONOp, testJSONOp:
+		if op.value, ok = m["value"]; !ok {
+			return nil, fmt.Errorf("%s operation contains no value", op.op)
+		}
+	case moveJSONOp, copyJSONOp:
+		if op.from, ok = m["from"].(string); !ok {
+			return nil, fmt.Errorf("%s operation contains no from", op.op)
+		}
+	case removeJSONOp:
+	default:
+		return nil, fmt.Errorf("unsupported operation: %s", op.op)
+	}
+	return op, nil
+}
+
+func (p *JSONPatcher) apply(typeDef common.StructTypeDefAccessor, doc interface{}, op *jsonOperation) (interface{}, error) {
+	path, err := p.checkedPointer(typeDef, op.op, op.path)
+	if err != nil {
+		return nil, err
+	}
+	var from []string
+	if op.op == moveJSONOp || op.op == copyJSONOp {
+		if from, err = p.checkedPointer(typeDef, op.op, op.from); err != nil {
+			return nil, err
+		}
+	}
+
+	doc, err = applyJSONOperation(doc, op, path, from)
+	if err != nil {
+		return nil, err
+	}
+	if len(path) == 0 {
+		if err := checkRootValue(typeDef, doc); err != nil {
+			return nil, err
+		}
+	}
+	return doc, nil
+}
+
+// checkRootValue checks that a value that replaces the whole resource is a
+// resource of the same type. The properties of the value are checked when
+// the patched resource is validated.
+func checkRootValue(typeDef common.StructTypeDefAccessor, value interface{}) error {
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("resource must be replaced by an object: %T", value)
+	}
+	if m[dynamic.ResourceTypePropName] != typeDef.InternalName() {
+		return fmt.Errorf("resource type must not be modified")
+	}
+	return nil
+}
+
+// applyJSONOperation applies the operation to the document and returns the
+// modified document.
+func applyJSONOperation(doc interface{}, op *jsonOperation, path []string, from []string) (interface{}, error) {
+	var err error
+	switch op.op {
+	case addJSONOp:
+		return addJSONValue(doc, path, copyValue(op.value), op.path)
+	case removeJSONOp:
+		doc, _, err = removeJSONValue(doc, path, op.path)
+		return doc, err
+	case replaceJSONOp:
+		if len(path) == 0 {
+			return copyValue(op.value), nil
+		}
+		if doc, _, err = removeJSONValue(doc, path, op.path); err != nil {
+			return nil, err
+		}
+		return addJSONValue(doc, path, copyValue(op.value), op.path)
+	case moveJSONOp:
+		if strings.HasPrefix(op.path, op.from+"/") {
+			return nil, fmt.Errorf("%s cannot be moved into its child %s", op.from, op.path)
+		}
+		var value interface{}
+		if doc, value, err = removeJSONValue(doc, from, op.from); err != nil {
+			return nil, err
+		}
+		return addJSONValue(doc, path, value, op.path)
+	case copyJSONOp:
+		value, err := getJSONValue(doc, from, op.from)
+		if err != nil {
+			return nil, err
+		}
+		return addJSONValue(doc, path, copyValue(value), op.path)
+	default:
+		value, err := getJSONValue(doc, path, op.path)
+		if err != nil {
+			return nil, err
+		}
+		if !jsonValuesEqual(value, op.value) {
+			return nil, fmt.Errorf("value of %s differs from the tested value", op.path)
+		}
+		return doc, nil
+	}
+}
+
+// checkedPointer parses the JSON pointer and checks that it locates a value
+// that is allowed by the property definitions. Values of contained
+// resources are checked after the patch has been applied.
+func (p *JSONPatcher) checkedPointer(typeDef common.StructTypeDefAccessor, op string, pointer string) ([]string, error) {
+	tokens, err := parseJSONPointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) > 0 && tokens[0] == dynamic.ResourceTypePropName && op != testJSONOp {
+		return nil, fmt.Errorf("resource type must not be modified")
+	}
+
+	for i := 0; i < len(tokens) && typeDef != nil; i++ {
+		name := strings.TrimPrefix(tokens[i], "_")
+		element := name != tokens[i]
+		if i == 0 && name == dynamic.ResourceTypePropName {
+			break
+		}
+		propDef := typeDef.PropByName(name)
+		if propDef == nil {
+			return nil, fmt.Errorf("%s: property %s is not defined by type %s", pointer, name, typeDef.InternalName())
+		}
+		_, primitive := propDef.Type().(common.PrimitiveTypeDefAccessor)
+		if element && !primitive {
+			return nil, fmt.Errorf("%s: property %s is not primitive and cannot have an element %s",
+				pointer, name, tokens[i])
+		}
+
+		if propDef.Array() && i+1 < len(tokens) {
+			i++
+			if tokens[i] != appendIndex && !jsonIndexRegexp.MatchString(tokens[i]) {
+				return nil, fmt.Errorf("%s: property %s is a list and requires an index instead of %s",
+					pointer, name, tokens[i])
+			}
+		}
+
+		switch {
+		case element:
+			typeDef = p.typeDefContainer.ElementType()
+		case primitive:
+			if i+1 < len(tokens) {
+				return nil, fmt.Errorf("%s: property %s is primitive and has no property %s", pointer, name, tokens[i+1])
+			}
+		case propDef.Type().TypeKind() == common.ResourceTypeKind:
+			typeDef = nil
+		default:
+			typeDef = propDef.Type().(common.StructTypeDefAccessor)
+		}
+	}
+	return tokens, nil
+}
+
+// parseJSONPointer returns the unescaped reference tokens of the JSON
+// pointer (RFC 6901).
+func parseJSONPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("JSON pointer must start with /: %s", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, t := range tokens {
+		tokens[i] = jsonPointerUnescaper.Replace(t)
+	}
+	return tokens, nil
+}
+
+// addJSONValue adds the value at the location of the tokens and returns the
+// modified node. Values are inserted into arrays and replace the members of
+// objects.
+func addJSONValue(node interface{}, tokens []string, value interface{}, pointer string) (interface{}, error) {
+	if len(tokens) == 0 {
+		return value, nil
+	}
+	switch n := node.(type) {
+	case map[string]interface{}:
+		if len(tokens) == 1 {
+			n[tokens[0]] = value
+			return n, nil
+		}
+		child, found := n[tokens[0]]
+		if !found {
+			return nil, fmt.Errorf("%s does not exist", pointer)
+		}
+		child, err := addJSONValue(child, tokens[1:], value, pointer)
+		if err != nil {
+			return nil, err
+		}
+		n[tokens[0]] = child
+		return n, nil
+	case []interface{}:
+		if len(tokens) == 1 {
+			index := len(n)
+			if tokens[0] != appendIndex {
+				var err error
+				if index, err = jsonIndex(tokens[0], len(n)+1, pointer); err != nil {
+					return nil, err
+				}
+			}
+			return append(n[:index], append([]interface{}{value}, n[index:]...)...), nil
+		}
+		index, err := jsonIndex(tokens[0], len(n), pointer)
+		if err != nil {
+			return nil, err
+		}
+		if n[index], err = addJSONValue(n[index], tokens[1:], value, pointer); err != nil {
+			return nil, err
+		}
+		return n, nil
+	default:
+		return nil, fmt.Errorf("%s does not exist", pointer)
+	}
+}
+
+// removeJSONValue removes the value at the location of the tokens and
+// returns the modified node together with the removed value.
+func removeJSONValue(node interface{}, tokens []string, pointer string) (interface{}, interface{}, error) {
+	if len(tokens) == 0 {
+		return nil, nil, fmt.Errorf("resource must not be removed")
+	}
+	switch n := node.(type) {
+	case map[string]interface{}:
+		child, found := n[tokens[0]]
+		if !found {
+			return nil, nil, fmt.Errorf("%s does not exist", pointer)
+		}
+		if len(tokens) == 1 {
+			delete(n, tokens[0])
+			return n, child, nil
+		}
+		child, removed, err := removeJSONValue(child, tokens[1:], pointer)
+		if err != nil {
+			return nil, nil, err
+		}
+		n[tokens[0]] = child
+		return n, removed, nil
+	case []interface{}:
+		index, err := jsonIndex(tokens[0], len(n), pointer)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(tokens) == 1 {
+			removed := n[index]
+			return append(n[:index:index], n[index+1:]...), removed, nil
+		}
+		child, removed, err := removeJSONValue(n[index], tokens[1:], pointer)
+		if err != nil {
+			return nil, nil, err
+		}
+		n[index] = child
+		return n, removed, nil
+	default:
+		return nil, nil, fmt.Errorf("%s does not exist", pointer)
+	}
+}
+
+func getJSONValue(node interface{}, tokens []string, pointer string) (interface{}, error) {
+	for _, t := range tokens {
+		switch n := node.(type) {
+		case map[string]interface{}:
+			child, found := n[t]
+			if !found {
+				return nil, fmt.Errorf("%s does not exist", pointer)
+			}
+			node = child
+		case []interface{}:
+			index, err := jsonIndex(t, len(n), pointer)
+			if err != nil {
+				return nil, err
+			}
+			node = n[index]
+		default:
+			return nil, fmt.Errorf("%s does not exist", pointer)
+		}
+	}
+	return node, nil
+}
+
+// jsonIndex returns the array index of the token, which must be less than
+// the specified limit.
+func jsonIndex(token string, limit int, pointer string) (int, error) {
+	if !jsonIndexRegexp.MatchString(token) {
+		return 0, fmt.Errorf("%s contains invalid array index %s", pointer, token)
+	}
+	index, err := strconv.Atoi(token)
+	if err != nil || index >= limit {
+		return 0, fmt.Errorf("%s contains array index %s that is out of range", pointer, token)
+	}
+	return index, nil
+}
+
+// jsonValuesEqual returns if both JSON values are equal. Numbers are equal
+// if their values are equal (e.g. 1.5 and 1.50).
+func jsonValuesEqual(v1 interface{}, v2 interface{}) bool {
+	switch a := v1.(type) {
+	case map[string]interface{}:
+		b, ok := v2.(map[string]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for k, item := range a {
+			other, found := b[k]
+			if !found || !jsonValuesEqual(item, other) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		b, ok := v2.([]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !jsonValuesEqual(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	case string, bool, nil:
+		return v1 == v2
+	default:
+		n1, ok1 := jsonNumber(v1)
+		n2, ok2 := jsonNumber(v2)
+		return ok1 && ok2 && n1.Cmp(n2) == 0
+	}
+}
+
+func jsonNumber(value interface{}) (*big.Rat, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		return new(big.Rat).SetString(v.String())
+	case float64:
+		return new(big.Rat).SetString(strconv.FormatFloat(v, 'g', -1, 64))
+	case int:
+		return new(big.Rat).SetInt64(int64(v)), true
+	case int64:
+		return new(big.Rat).SetInt64(v), true
+	default:
+		return nil, false
+	}
+}
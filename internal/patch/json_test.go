@@ -0,0 +1,226 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package patch
+
+import (
+	"encoding/json"
+	"github.com/healthiop/hi/internal/dynamic"
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/healthiop/hi/internal/stu3"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func parseTestJSONPatch(t *testing.T, patch string) []interface{} {
+	var operations []interface{}
+	if err := json.Unmarshal([]byte(patch), &operations); err != nil {
+		t.Fatal(err)
+	}
+	return operations
+}
+
+func TestJSONPatcher(t *testing.T) {
+	tests := []struct {
+		name     string
+		patch    string
+		expected func(data map[string]interface{})
+	}{
+		{"add", `[{"op": "add", "path": "/gender", "value": "female"}]`,
+			func(data map[string]interface{}) {
+				data["gender"] = "female"
+			}},
+		{"add item", `[{"op": "add", "path": "/name/0/given/1", "value": "Ann"}]`,
+			func(data map[string]interface{}) {
+				data["name"].([]interface{})[0].(map[string]interface{})["given"] = []interface{}{"Jane", "Ann", "Mary"}
+			}},
+		{"append item", `[{"op": "add", "path": "/name/-", "value": {"family": "Smith"}}]`,
+			func(data map[string]interface{}) {
+				data["name"] = append(data["name"].([]interface{}), map[string]interface{}{"family": "Smith"})
+			}},
+		{"add element", `[{"op": "add", "path": "/_birthDate", "value": {"id": "1"}}]`,
+			func(data map[string]interface{}) {
+				data["_birthDate"] = map[string]interface{}{"id": "1"}
+			}},
+		{"remove", `[{"op": "remove", "path": "/identifier/0"}]`,
+			func(data map[string]interface{}) {
+				data["identifier"] = data["identifier"].([]interface{})[1:]
+			}},
+		{"replace", `[{"op": "replace", "path": "/birthDate", "value": "1970-03-31"}]`,
+			func(data map[string]interface{}) {
+				data["birthDate"] = "1970-03-31"
+			}},
+		{"move", `[{"op": "move", "from": "/name/1", "path": "/name/0"}]`,
+			func(data map[string]interface{}) {
+				names := data["name"].([]interface{})
+				data["name"] = []interface{}{names[1], names[0]}
+			}},
+		{"copy", `[{"op": "copy", "from": "/name/0/family", "path": "/name/1/family"}]`,
+			func(data map[string]interface{}) {
+				data["name"].([]interface{})[1].(map[string]interface{})["family"] = "Doe"
+			}},
+		{"test", `[{"op": "test", "path": "/name/0", "value": {"use": "official", "family": "Doe", "given": ["Jane", "Mary"]}},
+			{"op": "test", "path": "/resourceType", "value": "Patient"},
+			{"op": "remove", "path": "/deceasedBoolean"}]`,
+			func(data map[string]interface{}) {
+				delete(data, "deceasedBoolean")
+			}},
+		{"escaped", `[{"op": "test", "path": "/identifier/0/system", "value": "http://example.com/mrn"},
+			{"op": "add", "path": "/identifier/0/value", "value": "1~/"}]`,
+			func(data map[string]interface{}) {
+				data["identifier"].([]interface{})[0].(map[string]interface{})["value"] = "1~/"
+			}},
+		{"replace resource", `[{"op": "replace", "path": "", "value": {"resourceType": "Patient", "id": "1"}}]`,
+			func(data map[string]interface{}) {
+				for k := range data {
+					delete(data, k)
+				}
+				data["resourceType"] = "Patient"
+				data["id"] = "1"
+			}},
+	}
+
+	p := NewJSONPatcher(r4.TypeDefContainer())
+	assert.Same(t, r4.TypeDefContainer(), p.TypeDefContainer())
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := newTestPatient()
+			expected := newTestPatient()
+			test.expected(expected)
+			result, err := p.ApplyData(data, parseTestJSONPatch(t, test.patch))
+			if assert.NoError(t, err) {
+				assert.Equal(t, expected, result)
+				assert.Equal(t, newTestPatient(), data, "original data must not be modified")
+			}
+		})
+	}
+}
+
+func TestJSONPatcherErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		patch string
+		err   string
+	}{
+		{"no object", `[1]`, "patch[0]: operation must be an object: float64"},
+		{"no op", `[{"path": "/id"}]`, "patch[0]: operation contains no op"},
+		{"unsupported op", `[{"op": "merge", "path": "/id"}]`, "patch[0]: unsupported operation: merge"},
+		{"no path", `[{"op": "remove"}]`, "patch[0]: remove operation contains no path"},
+		{"no value", `[{"op": "add", "path": "/id"}]`, "patch[0]: add operation contains no value"},
+		{"no from", `[{"op": "move", "path": "/id"}]`, "patch[0]: move operation contains no from"},
+		{"invalid pointer", `[{"op": "remove", "path": "id"}]`, "patch[0]: JSON pointer must start with /: id"},
+		{"undefined property", `[{"op": "add", "path": "/test", "value": "1"}]`,
+			"patch[0]: /test: property test is not defined by type Patient"},
+		{"undefined nested property", `[{"op": "add", "path": "/name/0/test", "value": "1"}]`,
+			"patch[0]: /name/0/test: property test is not defined by type HumanName"},
+		{"no index", `[{"op": "add", "path": "/name/family", "value": "Doe"}]`,
+			"patch[0]: /name/family: property name is a list and requires an index instead of family"},
+		{"element of struct", `[{"op": "add", "path": "/_name", "value": {}}]`,
+			"patch[0]: /_name: property name is not primitive and cannot have an element _name"},
+		{"property of primitive", `[{"op": "add", "path": "/birthDate/id", "value": "1"}]`,
+			"patch[0]: /birthDate/id: property birthDate is primitive and has no property id"},
+		{"resource type", `[{"op": "replace", "path": "/resourceType", "value": "Person"}]`,
+			"patch[0]: resource type must not be modified"},
+		{"remove missing", `[{"op": "remove", "path": "/gender"}]`, "patch[0]: /gender does not exist"},
+		{"replace missing", `[{"op": "replace", "path": "/name/2", "value": {}}]`,
+			"patch[0]: /name/2 contains array index 2 that is out of range"},
+		{"add missing parent", `[{"op": "add", "path": "/maritalStatus/text", "value": "married"}]`,
+			"patch[0]: /maritalStatus/text does not exist"},
+		{"leading zero", `[{"op": "remove", "path": "/name/01"}]`,
+			"patch[0]: /name/01: property name is a list and requires an index instead of 01"},
+		{"move into child", `[{"op": "move", "from": "/name/0", "path": "/name/0/given/0"}]`,
+			"patch[0]: /name/0 cannot be moved into its child /name/0/given/0"},
+		{"test failed", `[{"op": "remove", "path": "/id"}, {"op": "test", "path": "/birthDate", "value": "1970"}]`,
+			"patch[1]: value of /birthDate differs from the tested value"},
+		{"remove resource", `[{"op": "remove", "path": ""}]`, "patch[0]: resource must not be removed"},
+		{"replace resource type", `[{"op": "replace", "path": "", "value": {"resourceType": "Observation", "status": "final", "code": {"text": "test"}}}]`,
+			"patch[0]: resource type must not be modified"},
+		{"add resource type", `[{"op": "add", "path": "", "value": {"resourceType": "Observation", "status": "final", "code": {"text": "test"}}}]`,
+			"patch[0]: resource type must not be modified"},
+		{"add resource without type", `[{"op": "add", "path": "", "value": {"id": "1"}}]`,
+			"patch[0]: resource type must not be modified"},
+		{"replace resource by array", `[{"op": "replace", "path": "", "value": []}]`,
+			"patch[0]: resource must be replaced by an object: []interface {}"},
+		{"move into resource", `[{"op": "move", "from": "/name/0", "path": ""}]`,
+			"patch[0]: resource type must not be modified"},
+		{"copy into resource", `[{"op": "add", "path": "/contained", "value": [{"resourceType": "Observation", "status": "final", "code": {"text": "test"}}]},
+			{"op": "copy", "from": "/contained/0", "path": ""}]`,
+			"patch[1]: resource type must not be modified"},
+		{"string instead of array", `[{"op": "add", "path": "/name/0/given", "value": "Jane"}]`,
+			"patched resource is invalid: Patient.name[0].given: "},
+		{"empty object", `[{"op": "add", "path": "/maritalStatus", "value": {}}]`,
+			"patched resource is invalid: Patient.maritalStatus: object must not be empty"},
+	}
+
+	p := NewJSONPatcher(r4.TypeDefContainer())
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := p.ApplyData(newTestPatient(), parseTestJSONPatch(t, test.patch))
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), test.err)
+			}
+		})
+	}
+}
+
+func TestJSONValuesEqual(t *testing.T) {
+	assert.True(t, jsonValuesEqual(json.Number("1.50"), 1.5))
+	assert.True(t, jsonValuesEqual([]interface{}{"a", nil}, []interface{}{"a", nil}))
+	assert.False(t, jsonValuesEqual("1", json.Number("1")))
+	assert.False(t, jsonValuesEqual(map[string]interface{}{"a": true}, map[string]interface{}{"b": true}))
+	assert.False(t, jsonValuesEqual([]interface{}{"a"}, "a"))
+}
+
+func TestJSONPatcherApply(t *testing.T) {
+	p := NewJSONPatcher(r4.TypeDefContainer())
+	r, err := dynamic.NewDynResource(r4.TypeDefContainer(), newTestPatient(), nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	result, err := p.Apply(r, []byte(`[{"op": "add", "path": "/multipleBirthInteger", "value": 2}]`))
+	if assert.NoError(t, err) {
+		expected := newTestPatient()
+		expected["multipleBirthInteger"] = json.Number("2")
+		assert.Equal(t, expected, result.(dynamic.DynDataRetriever).Data())
+	}
+
+	_, err = p.ApplyData(map[string]interface{}{"resourceType": "Test"}, nil)
+	assert.EqualError(t, err, "resource type undefined: Test")
+
+	_, err = p.Apply(r, []byte(`{"op": "remove", "path": "/id"}`))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "patch is not a JSON array: ")
+	}
+
+	r, err = dynamic.NewDynResource(stu3.TypeDefContainer(), newTestPatient(), nil)
+	if assert.NoError(t, err) {
+		_, err = p.Apply(r, []byte(`[]`))
+		assert.EqualError(t, err, "resource has FHIR version "+stu3.TypeDefContainer().VersionString()+
+			" instead of "+r4.TypeDefContainer().VersionString())
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package patch applies patch documents to resources of the dynamic model.
+// The patched resources are validated against the type definitions.
+package patch
+
+import (
+	"fmt"
+	"github.com/healthiop/hi/internal/validation"
+)
+
+// InvalidResultError is returned if the patched resource is invalid. The
+// outcome contains all issues of the patched resource.
+type InvalidResultError struct {
+	Outcome *validation.Outcome
+}
+
+func (e *InvalidResultError) Error() string {
+	for _, issue := range e.Outcome.Issues {
+		if issue.Severity == validation.FatalSeverity || issue.Severity == validation.ErrorSeverity {
+			return fmt.Sprintf("patched resource is invalid: %s: %s", issue.Expression, issue.Diagnostics)
+		}
+	}
+	return "patched resource is invalid"
+}
+
+// validate validates the structure of the patched resource data.
+func validate(validator *validation.StructureValidator, data map[string]interface{}) error {
+	if outcome := validator.ValidateData(data); !outcome.Valid() {
+		return &InvalidResultError{outcome}
+	}
+	return nil
+}
+
+func copyData(data map[string]interface{}) map[string]interface{} {
+	return copyValue(data).(map[string]interface{})
+}
+
+// copyValue returns a deep copy of the specified JSON value.
+func copyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			m[k] = copyValue(item)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(v))
+		for i, item := range v {
+			l[i] = copyValue(item)
+		}
+		return l
+	default:
+		return v
+	}
+}
@@ -0,0 +1,421 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package profile
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	// CoreProfileURLPrefix is the prefix of the canonical URLs of the base
+	// definitions of all FHIR core types.
+	CoreProfileURLPrefix = "http://hl7.org/fhir/StructureDefinition/"
+
+	structureDefinitionTypeName = "StructureDefinition"
+	unboundedMax                = -1
+)
+
+// Profile is a StructureDefinition that constrains a FHIR type. Validation
+// requires the snapshot of the profile.
+type Profile struct {
+	URL            string
+	Version        string
+	Name           string
+	Type           string
+	BaseDefinition string
+	Derivation     string
+	Snapshot       []*ElementDefinition
+	Differential   []*ElementDefinition
+	Data           map[string]interface{}
+	root           *ElementDefinition
+}
+
+// ElementDefinition is a single element of the snapshot or differential of a
+// profile. The original JSON data of the element definition is kept as Data.
+type ElementDefinition struct {
+	ID               string
+	Path             string
+	SliceName        string
+	Min              int
+	Max              int
+	Types            []ElementType
+	Fixed            interface{}
+	FixedTypeName    string
+	Pattern          interface{}
+	PatternTypeName  string
+	MustSupport      bool
+	Slicing          *Slicing
+	ContentReference string
+	Data             map[string]interface{}
+	children         []*ElementDefinition
+	slices           []*ElementDefinition
+}
+
+// ElementType is an allowed type of an element. Profiles and target profiles
+// contain canonical URLs.
+type ElementType struct {
+	Code           string
+	Profiles       []string
+	TargetProfiles []string
+}
+
+// Slicing describes how the values of a repeating element are divided into
+// slices.
+type Slicing struct {
+	Discriminators []Discriminator
+	Ordered        bool
+	Rules          string
+}
+
+// Discriminator identifies the slice of a value by the specified path.
+type Discriminator struct {
+	Type string
+	Path string
+}
+
+// ParseProfile parses the specified StructureDefinition JSON.
+func ParseProfile(data []byte) (*Profile, error) {
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("invalid profile JSON: %v", err)
+	}
+	return NewProfile(m)
+}
+
+// NewProfile creates a profile from the specified StructureDefinition data.
+// An error is returned if the data contains no valid StructureDefinition.
+func NewProfile(data map[string]interface{}) (*Profile, error) {
+	if rt, _ := data["resourceType"].(string); rt != structureDefinitionTypeName {
+		return nil, fmt.Errorf("data contains no %s", structureDefinitionTypeName)
+	}
+
+	p := &Profile{
+		URL:            stringValue(data, "url"),
+		Version:        stringValue(data, "version"),
+		Name:           stringValue(data, "name"),
+		Type:           stringValue(data, "type"),
+		BaseDefinition: stringValue(data, "baseDefinition"),
+		Derivation:     stringValue(data, "derivation"),
+		Data:           data,
+	}
+	if p.URL == "" {
+		return nil, fmt.Errorf("profile has no URL")
+	}
+	if p.Type == "" {
+		return nil, fmt.Errorf("profile %s has no type", p.URL)
+	}
+
+	var err error
+	if p.Snapshot, err = parseElementDefinitions(data, "snapshot"); err != nil {
+		return nil, fmt.Errorf("profile %s has invalid snapshot: %v", p.URL, err)
+	}
+	if p.Differential, err = parseElementDefinitions(data, "differential"); err != nil {
+		return nil, fmt.Errorf("profile %s has invalid differential: %v", p.URL, err)
+	}
+	if len(p.Snapshot) > 0 {
+		if p.root, err = buildElementTree(p.Snapshot); err != nil {
+			return nil, fmt.Errorf("profile %s has invalid snapshot: %v", p.URL, err)
+		}
+	}
+	return p, nil
+}
+
+// Canonical returns the URL and, if available, the version of the profile
+// separated by a vertical bar.
+func (p *Profile) Canonical() string {
+	if p.Version == "" {
+		return p.URL
+	}
+	return p.URL + "|" + p.Version
+}
+
+// HasSnapshot returns if the profile contains a snapshot.
+func (p *Profile) HasSnapshot() bool {
+	return p.root != nil
+}
+
+// ElementByID returns the snapshot element with the specified ID. If there is
+// no such element, nil is returned.
+func (p *Profile) ElementByID(id string) *ElementDefinition {
+	for _, e := range p.Snapshot {
+		if e.ID == id {
+			return e
+		}
+	}
+	return nil
+}
+
+// Name returns the last segment of the path of the element.
+func (e *ElementDefinition) Name() string {
+	return e.Path[strings.LastIndexByte(e.Path, '.')+1:]
+}
+
+// Choice returns if the element has a choice of types.
+func (e *ElementDefinition) Choice() bool {
+	return strings.HasSuffix(e.Path, "[x]")
+}
+
+// Children returns the child elements of the element in snapshot order.
+func (e *ElementDefinition) Children() []*ElementDefinition {
+	return e.children
+}
+
+// Slices returns the slices of the element in snapshot order.
+func (e *ElementDefinition) Slices() []*ElementDefinition {
+	return e.slices
+}
+
+// MaxString returns the maximum cardinality as it is used by element
+// definitions.
+func (e *ElementDefinition) MaxString() string {
+	if e.Max == unboundedMax {
+		return "*"
+	}
+	return fmt.Sprintf("%d", e.Max)
+}
+
+func (e *ElementDefinition) child(name string) *ElementDefinition {
+	for _, c := range e.children {
+		if n := c.Name(); n == name || n == name+"[x]" {
+			return c
+		}
+	}
+	return nil
+}
+
+// descendant returns the element at the specified relative FHIRPath. Only
+// simple paths that consist of element names are supported.
+func (e *ElementDefinition) descendant(path string) *ElementDefinition {
+	if path == "$this" {
+		return e
+	}
+	current := e
+	for _, segment := range strings.Split(path, ".") {
+		if current = current.child(segment); current == nil {
+			return nil
+		}
+	}
+	return current
+}
+
+func parseElementDefinitions(data map[string]interface{}, name string) ([]*ElementDefinition, error) {
+	container, ok := data[name].(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	elements, ok := container["element"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("no elements")
+	}
+
+	result := make([]*ElementDefinition, 0, len(elements))
+	activeSlices := make(map[string]string)
+	for i, element := range elements {
+		m, ok := element.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("element %d is no object", i)
+		}
+		e, err := NewElementDefinition(m)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %v", i, err)
+		}
+
+		if e.SliceName != "" {
+			activeSlices[e.Path] = e.SliceName
+		} else {
+			delete(activeSlices, e.Path)
+		}
+		if _, ok := m["id"]; !ok {
+			e.ID = elementID(e.Path, activeSlices)
+		}
+		result = append(result, e)
+	}
+	return result, nil
+}
+
+// elementID derives the ID of an element without ID from its path and the
+// slices that have been defined by the preceding elements.
+func elementID(path string, activeSlices map[string]string) string {
+	var id strings.Builder
+	segments := strings.Split(path, ".")
+	for i, segment := range segments {
+		if i > 0 {
+			id.WriteByte('.')
+		}
+		id.WriteString(segment)
+		if sliceName, ok := activeSlices[strings.Join(segments[:i+1], ".")]; ok {
+			id.WriteByte(':')
+			id.WriteString(sliceName)
+		}
+	}
+	return id.String()
+}
+
+// NewElementDefinition creates an element definition from the specified
+// ElementDefinition data. If the data contains no ID, the ID is derived from
+// the path and slice name.
+func NewElementDefinition(data map[string]interface{}) (*ElementDefinition, error) {
+	e := &ElementDefinition{
+		ID:               stringValue(data, "id"),
+		Path:             stringValue(data, "path"),
+		SliceName:        stringValue(data, "sliceName"),
+		Max:              unboundedMax,
+		ContentReference: stringValue(data, "contentReference"),
+		Data:             data,
+	}
+	if e.Path == "" {
+		return nil, fmt.Errorf("element has no path")
+	}
+	if e.ID == "" {
+		e.ID = e.Path
+		if e.SliceName != "" {
+			e.ID = e.ID + ":" + e.SliceName
+		}
+	}
+
+	if min, ok := data["min"].(float64); ok {
+		e.Min = int(min)
+	}
+	if max, ok := data["max"].(string); ok && max != "*" {
+		if _, err := fmt.Sscanf(max, "%d", &e.Max); err != nil {
+			return nil, fmt.Errorf("element %s has invalid maximum cardinality: %s", e.ID, max)
+		}
+	}
+	e.MustSupport, _ = data["mustSupport"].(bool)
+
+	if types, ok := data["type"].([]interface{}); ok {
+		for _, t := range types {
+			m, ok := t.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("element %s has invalid type", e.ID)
+			}
+			e.Types = append(e.Types, ElementType{
+				Code:           stringValue(m, "code"),
+				Profiles:       stringValues(m, "profile"),
+				TargetProfiles: stringValues(m, "targetProfile"),
+			})
+		}
+	}
+
+	for name, value := range data {
+		if strings.HasPrefix(name, "fixed") {
+			e.Fixed, e.FixedTypeName = value, name[len("fixed"):]
+		} else if strings.HasPrefix(name, "pattern") {
+			e.Pattern, e.PatternTypeName = value, name[len("pattern"):]
+		}
+	}
+
+	if slicing, ok := data["slicing"].(map[string]interface{}); ok {
+		e.Slicing = &Slicing{Rules: stringValue(slicing, "rules")}
+		e.Slicing.Ordered, _ = slicing["ordered"].(bool)
+		if discriminators, ok := slicing["discriminator"].([]interface{}); ok {
+			for _, d := range discriminators {
+				m, ok := d.(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("element %s has invalid discriminator", e.ID)
+				}
+				e.Slicing.Discriminators = append(e.Slicing.Discriminators, Discriminator{
+					Type: stringValue(m, "type"),
+					Path: stringValue(m, "path"),
+				})
+			}
+		}
+	}
+	return e, nil
+}
+
+// buildElementTree links the specified elements by their IDs and returns the
+// root element. The ID of a slice ends with the slice name that is separated
+// by a colon (resliced slices use a slash).
+func buildElementTree(elements []*ElementDefinition) (*ElementDefinition, error) {
+	byID := make(map[string]*ElementDefinition, len(elements))
+	root := elements[0]
+	if strings.ContainsAny(root.ID, ".:") {
+		return nil, fmt.Errorf("first element is no root element: %s", root.ID)
+	}
+	byID[root.ID] = root
+
+	for _, e := range elements[1:] {
+		if _, ok := byID[e.ID]; ok {
+			return nil, fmt.Errorf("duplicate element: %s", e.ID)
+		}
+		byID[e.ID] = e
+
+		lastDot := strings.LastIndexByte(e.ID, '.')
+		if lastDot < 0 {
+			return nil, fmt.Errorf("element is no descendant of the root element: %s", e.ID)
+		}
+		last := e.ID[lastDot+1:]
+		if colon := strings.IndexByte(last, ':'); colon >= 0 {
+			baseID := e.ID[:lastDot+1] + last[:colon]
+			if slash := strings.LastIndexByte(last, '/'); slash > colon {
+				baseID = e.ID[:lastDot+1] + last[:slash]
+			}
+			base, ok := byID[baseID]
+			if !ok {
+				return nil, fmt.Errorf("sliced element of slice %s is undefined", e.ID)
+			}
+			base.slices = append(base.slices, e)
+		} else {
+			parent, ok := byID[e.ID[:lastDot]]
+			if !ok {
+				return nil, fmt.Errorf("parent of element %s is undefined", e.ID)
+			}
+			parent.children = append(parent.children, e)
+		}
+	}
+	return root, nil
+}
+
+func stringValue(data map[string]interface{}, name string) string {
+	s, _ := data[name].(string)
+	return s
+}
+
+// stringValues returns the string values of the specified property. STU3
+// defines a single profile and target profile, whereas later versions
+// define lists of them.
+func stringValues(data map[string]interface{}, name string) []string {
+	switch v := data[name].(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		result := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	default:
+		return nil
+	}
+}
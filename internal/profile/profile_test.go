@@ -0,0 +1,261 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package profile
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseProfile(t *testing.T) {
+	p, err := ParseProfile(readTestFile(t, "patient_profile.json.golden"))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "http://example.org/fhir/StructureDefinition/test-patient", p.URL)
+	assert.Equal(t, "1.0.0", p.Version)
+	assert.Equal(t, "http://example.org/fhir/StructureDefinition/test-patient|1.0.0", p.Canonical())
+	assert.Equal(t, "TestPatient", p.Name)
+	assert.Equal(t, "Patient", p.Type)
+	assert.Equal(t, "http://hl7.org/fhir/StructureDefinition/Patient", p.BaseDefinition)
+	assert.Equal(t, "constraint", p.Derivation)
+	assert.True(t, p.HasSnapshot())
+	assert.Len(t, p.Snapshot, 19)
+	assert.Nil(t, p.Differential)
+
+	e := p.ElementByID("Patient.identifier")
+	if assert.NotNil(t, e) {
+		assert.Equal(t, "identifier", e.Name())
+		assert.Equal(t, 1, e.Min)
+		assert.Equal(t, -1, e.Max)
+		assert.Equal(t, "*", e.MaxString())
+		assert.True(t, e.MustSupport)
+		assert.Equal(t, []ElementType{{Code: "Identifier"}}, e.Types)
+		assert.Equal(t, &Slicing{Discriminators: []Discriminator{{"value", "system"}}, Rules: "open"}, e.Slicing)
+		if assert.Len(t, e.Children(), 2) {
+			assert.Equal(t, "Patient.identifier.system", e.Children()[0].ID)
+			assert.Equal(t, "Patient.identifier.value", e.Children()[1].ID)
+		}
+		if assert.Len(t, e.Slices(), 1) {
+			slice := e.Slices()[0]
+			assert.Equal(t, "mrn", slice.SliceName)
+			assert.Equal(t, "1", slice.MaxString())
+			assert.Len(t, slice.Children(), 2)
+			assert.Equal(t, "http://example.org/fhir/mrn", slice.descendant("system").Fixed)
+			assert.Equal(t, "Uri", slice.descendant("system").FixedTypeName)
+			assert.Equal(t, "CodeableConcept", slice.descendant("type").PatternTypeName)
+			assert.Same(t, slice, slice.descendant("$this"))
+		}
+	}
+
+	e = p.ElementByID("Patient.deceased[x]")
+	if assert.NotNil(t, e) {
+		assert.True(t, e.Choice())
+		assert.Equal(t, "deceased[x]", e.Name())
+		assert.Same(t, e, p.root.child("deceased"))
+	}
+	assert.Nil(t, p.ElementByID("Patient.test"))
+}
+
+func TestNewProfileSTU3TypeProfiles(t *testing.T) {
+	p, err := NewProfile(map[string]interface{}{
+		"resourceType": "StructureDefinition",
+		"url":          "http://example.org/test",
+		"type":         "Patient",
+		"snapshot": map[string]interface{}{
+			"element": []interface{}{
+				map[string]interface{}{"path": "Patient"},
+				map[string]interface{}{
+					"path": "Patient.generalPractitioner",
+					"type": []interface{}{
+						map[string]interface{}{
+							"code":          "Reference",
+							"profile":       "http://example.org/reference",
+							"targetProfile": "http://hl7.org/fhir/StructureDefinition/Practitioner",
+						},
+					},
+				},
+			},
+		},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	e := p.ElementByID("Patient.generalPractitioner")
+	if assert.NotNil(t, e) {
+		assert.Equal(t, []ElementType{{"Reference", []string{"http://example.org/reference"},
+			[]string{"http://hl7.org/fhir/StructureDefinition/Practitioner"}}}, e.Types)
+	}
+}
+
+func TestNewProfileNoStructureDefinition(t *testing.T) {
+	_, err := NewProfile(map[string]interface{}{"resourceType": "Patient"})
+	if assert.Error(t, err) {
+		assert.Equal(t, "data contains no StructureDefinition", err.Error())
+	}
+}
+
+func TestNewProfileNoURL(t *testing.T) {
+	_, err := NewProfile(map[string]interface{}{"resourceType": "StructureDefinition", "type": "Patient"})
+	if assert.Error(t, err) {
+		assert.Equal(t, "profile has no URL", err.Error())
+	}
+}
+
+func TestNewProfileNoType(t *testing.T) {
+	_, err := NewProfile(map[string]interface{}{"resourceType": "StructureDefinition", "url": "http://example.org/test"})
+	if assert.Error(t, err) {
+		assert.Equal(t, "profile http://example.org/test has no type", err.Error())
+	}
+}
+
+func TestNewProfileNoSnapshot(t *testing.T) {
+	p, err := NewProfile(map[string]interface{}{
+		"resourceType": "StructureDefinition",
+		"url":          "http://example.org/test",
+		"type":         "Patient",
+		"differential": map[string]interface{}{
+			"element": []interface{}{
+				map[string]interface{}{"id": "Patient.gender", "path": "Patient.gender", "min": 1.0},
+			},
+		},
+	})
+	if assert.NoError(t, err) {
+		assert.False(t, p.HasSnapshot())
+		if assert.Len(t, p.Differential, 1) {
+			assert.Equal(t, 1, p.Differential[0].Min)
+		}
+	}
+}
+
+func TestNewProfileInvalidMax(t *testing.T) {
+	_, err := NewProfile(newTestProfileData(
+		map[string]interface{}{"path": "Patient"},
+		map[string]interface{}{"path": "Patient.name", "max": "x"}))
+	if assert.Error(t, err) {
+		assert.Equal(t, "profile http://example.org/test has invalid snapshot: "+
+			"element 1: element Patient.name has invalid maximum cardinality: x", err.Error())
+	}
+}
+
+func TestNewProfileUndefinedParent(t *testing.T) {
+	_, err := NewProfile(newTestProfileData(
+		map[string]interface{}{"path": "Patient"},
+		map[string]interface{}{"path": "Patient.contact.name"}))
+	if assert.Error(t, err) {
+		assert.Equal(t, "profile http://example.org/test has invalid snapshot: "+
+			"parent of element Patient.contact.name is undefined", err.Error())
+	}
+}
+
+func TestNewProfileUndefinedSlicedElement(t *testing.T) {
+	_, err := NewProfile(newTestProfileData(
+		map[string]interface{}{"path": "Patient"},
+		map[string]interface{}{"path": "Patient.name", "sliceName": "official"}))
+	if assert.Error(t, err) {
+		assert.Equal(t, "profile http://example.org/test has invalid snapshot: "+
+			"sliced element of slice Patient.name:official is undefined", err.Error())
+	}
+}
+
+func TestNewProfileDuplicateElement(t *testing.T) {
+	_, err := NewProfile(newTestProfileData(
+		map[string]interface{}{"path": "Patient"},
+		map[string]interface{}{"path": "Patient.name"},
+		map[string]interface{}{"path": "Patient.name"}))
+	if assert.Error(t, err) {
+		assert.Equal(t, "profile http://example.org/test has invalid snapshot: "+
+			"duplicate element: Patient.name", err.Error())
+	}
+}
+
+func TestNewProfileResliced(t *testing.T) {
+	p, err := NewProfile(newTestProfileData(
+		map[string]interface{}{"path": "Patient"},
+		map[string]interface{}{"path": "Patient.name"},
+		map[string]interface{}{"id": "Patient.name:a", "path": "Patient.name", "sliceName": "a"},
+		map[string]interface{}{"id": "Patient.name:a/b", "path": "Patient.name", "sliceName": "a/b"}))
+	if assert.NoError(t, err) {
+		slices := p.ElementByID("Patient.name").Slices()
+		if assert.Len(t, slices, 1) && assert.Len(t, slices[0].Slices(), 1) {
+			assert.Equal(t, "Patient.name:a/b", slices[0].Slices()[0].ID)
+		}
+	}
+}
+
+func TestNewProfileDerivedIDs(t *testing.T) {
+	p, err := NewProfile(newTestProfileData(
+		map[string]interface{}{"path": "Patient"},
+		map[string]interface{}{"path": "Patient.identifier"},
+		map[string]interface{}{"path": "Patient.identifier.system"},
+		map[string]interface{}{"path": "Patient.identifier", "sliceName": "mrn"},
+		map[string]interface{}{"path": "Patient.identifier.system"},
+		map[string]interface{}{"path": "Patient.name"}))
+	if !assert.NoError(t, err) {
+		return
+	}
+	ids := make([]string, 0, len(p.Snapshot))
+	for _, e := range p.Snapshot {
+		ids = append(ids, e.ID)
+	}
+	assert.Equal(t, []string{"Patient", "Patient.identifier", "Patient.identifier.system",
+		"Patient.identifier:mrn", "Patient.identifier:mrn.system", "Patient.name"}, ids)
+}
+
+func newTestProfileData(elements ...interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"resourceType": "StructureDefinition",
+		"url":          "http://example.org/test",
+		"type":         "Patient",
+		"snapshot":     map[string]interface{}{"element": elements},
+	}
+}
+
+func readTestData(t *testing.T, fileName string) map[string]interface{} {
+	var data map[string]interface{}
+	if err := json.Unmarshal(readTestFile(t, fileName), &data); err != nil {
+		t.Fatal(err)
+		return nil
+	}
+	return data
+}
+
+func readTestFile(t *testing.T, fileName string) []byte {
+	golden := filepath.Join("testdata", fileName)
+	if content, err := ioutil.ReadFile(golden); err != nil {
+		t.Fatal(err)
+		return nil
+	} else {
+		return content
+	}
+}
@@ -0,0 +1,327 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package profile
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+const (
+	closedSlicingRules    = "closed"
+	openAtEndSlicingRules = "openAtEnd"
+)
+
+// validateSlices assigns the values of a sliced element to its slices and
+// validates the values against the matching slice. The values are assigned by
+// the discriminators of the specified slicing. A resliced slice without own
+// slicing inherits the discriminators of the slicing of its sliced element.
+func (va *validation) validateSlices(e *ElementDefinition, slicing *Slicing, items []item, location string) {
+	if e.Slicing != nil {
+		for _, d := range e.Slicing.Discriminators {
+			if !supportedDiscriminator(d) {
+				va.issue(SlicingIssueKind, WarningSeverity, location, e,
+					fmt.Sprintf("discriminator %s with path %s of element %s is not supported", d.Type, d.Path, e.ID))
+			}
+		}
+	}
+
+	matched := make([][]item, len(e.slices))
+	sliceIndexes := make([]int, len(items))
+	for i, it := range items {
+		sliceIndexes[i] = -1
+		for j, slice := range e.slices {
+			if va.sliceMatches(e, slicing, slice, it) {
+				sliceIndexes[i] = j
+				matched[j] = append(matched[j], it)
+				break
+			}
+		}
+	}
+
+	for j, slice := range e.slices {
+		va.validateCardinality(slice, matched[j], location, "slice")
+		for _, it := range matched[j] {
+			va.validateElement(slice, it)
+		}
+		if len(slice.slices) > 0 {
+			resliceSlicing := slice.Slicing
+			if resliceSlicing == nil && slicing != nil {
+				resliceSlicing = &Slicing{Discriminators: slicing.Discriminators}
+			}
+			va.validateSlices(slice, resliceSlicing, matched[j], location)
+		}
+	}
+
+	if e.Slicing != nil {
+		va.validateSlicingRules(e, items, sliceIndexes)
+	}
+}
+
+func (va *validation) validateSlicingRules(e *ElementDefinition, items []item, sliceIndexes []int) {
+	lastIndex, unmatched := -1, false
+	for i, it := range items {
+		index := sliceIndexes[i]
+		if index < 0 {
+			if e.Slicing.Rules == closedSlicingRules {
+				va.issue(SlicingIssueKind, ErrorSeverity, it.location, e,
+					fmt.Sprintf("value matches no slice of closed slicing of element %s", e.ID))
+			}
+			unmatched = true
+			continue
+		}
+		if unmatched && e.Slicing.Rules == openAtEndSlicingRules {
+			va.issue(SlicingIssueKind, ErrorSeverity, it.location, e,
+				fmt.Sprintf("value of slice %s follows values that match no slice of element %s", e.slices[index].ID, e.ID))
+		}
+		if e.Slicing.Ordered && index < lastIndex {
+			va.issue(SlicingIssueKind, ErrorSeverity, it.location, e,
+				fmt.Sprintf("value of slice %s violates slice order of element %s", e.slices[index].ID, e.ID))
+		}
+		if index > lastIndex {
+			lastIndex = index
+		}
+	}
+}
+
+// sliceMatches returns if the value matches all discriminators of the slicing.
+// Slices of a choice element without slicing are matched by their type.
+func (va *validation) sliceMatches(e *ElementDefinition, slicing *Slicing, slice *ElementDefinition, it item) bool {
+	if slicing == nil || len(slicing.Discriminators) == 0 {
+		if e.Choice() {
+			return it.name == slice.SliceName || va.matchingTypeCode(slice, it)
+		}
+		return false
+	}
+
+	for _, d := range slicing.Discriminators {
+		if !supportedDiscriminator(d) {
+			return false
+		}
+		target := slice.descendant(d.Path)
+		values := va.pathItems(it, d.Path)
+
+		var matches bool
+		switch {
+		case d.Type == "value" || d.Type == "pattern":
+			matches = va.valueDiscriminatorMatches(slice, target, d, values)
+		case target == nil:
+			matches = false
+		case d.Type == "exists":
+			if target.Min > 0 {
+				matches = len(values) > 0
+			} else if target.Max == 0 {
+				matches = len(values) == 0
+			}
+		case d.Type == "type":
+			for _, v := range values {
+				if va.matchingTypeCode(target, v) {
+					matches = true
+					break
+				}
+			}
+		case d.Type == "profile":
+			matches = va.profileDiscriminatorMatches(target, values)
+		}
+		if !matches {
+			return false
+		}
+	}
+	return true
+}
+
+func (va *validation) valueDiscriminatorMatches(slice *ElementDefinition, target *ElementDefinition, d Discriminator, values []item) bool {
+	var expected interface{}
+	pattern := false
+	if target != nil {
+		expected, pattern = target.Fixed, false
+		if expected == nil {
+			expected, pattern = target.Pattern, true
+		}
+	}
+	if expected == nil && d.Path == "url" {
+		// slices of extensions may define the URL by the extension profile only
+		if len(slice.Types) == 1 && len(slice.Types[0].Profiles) == 1 {
+			expected = slice.Types[0].Profiles[0]
+		}
+	}
+	if expected == nil {
+		return false
+	}
+
+	for _, v := range values {
+		if pattern && patternMatches(v.value, expected) || !pattern && fixedEqual(v.value, expected) {
+			return true
+		}
+	}
+	return false
+}
+
+func (va *validation) profileDiscriminatorMatches(target *ElementDefinition, values []item) bool {
+	for _, et := range target.Types {
+		for _, url := range et.Profiles {
+			p := va.ProfileByURL(url)
+			if p == nil {
+				continue
+			}
+			for _, v := range values {
+				if va.conforms(p, v) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// matchingTypeCode returns if the element restricts its types and the value
+// has one of these types.
+func (va *validation) matchingTypeCode(e *ElementDefinition, it item) bool {
+	if len(e.Types) == 0 || it.typeDef == nil {
+		return false
+	}
+	for _, et := range e.Types {
+		if va.typeCodeMatches(et.Code, it) {
+			return true
+		}
+	}
+	return false
+}
+
+func supportedDiscriminator(d Discriminator) bool {
+	switch d.Type {
+	case "value", "pattern", "exists", "type", "profile":
+		return d.Path != "" && !strings.ContainsAny(d.Path, "()")
+	default:
+		return false
+	}
+}
+
+// fixedEqual returns if the value is exactly equal to the fixed value.
+// Numbers are compared by their value, so that the result does not depend on
+// the Go type the numbers have been decoded to.
+func fixedEqual(value interface{}, fixed interface{}) bool {
+	switch f := fixed.(type) {
+	case map[string]interface{}:
+		m, ok := value.(map[string]interface{})
+		if !ok || len(m) != len(f) {
+			return false
+		}
+		for name, fv := range f {
+			v, found := m[name]
+			if !found || !fixedEqual(v, fv) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		l, ok := value.([]interface{})
+		if !ok || len(l) != len(f) {
+			return false
+		}
+		for i := range f {
+			if !fixedEqual(l[i], f[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return primitiveEqual(value, fixed)
+	}
+}
+
+// patternMatches returns if the value contains all elements of the pattern.
+// Each item of a list pattern must match at least one item of the value.
+func patternMatches(value interface{}, pattern interface{}) bool {
+	switch p := pattern.(type) {
+	case map[string]interface{}:
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for name, pv := range p {
+			if !patternMatches(m[name], pv) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		l, ok := value.([]interface{})
+		if !ok {
+			if value == nil {
+				return len(p) == 0
+			}
+			l = []interface{}{value}
+		}
+		for _, pv := range p {
+			found := false
+			for _, v := range l {
+				if patternMatches(v, pv) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	default:
+		return primitiveEqual(value, pattern)
+	}
+}
+
+// primitiveEqual returns if the primitive JSON values are equal. Numbers are
+// equal if their values are equal (e.g. 2, 2.0 and json.Number("2")).
+func primitiveEqual(v1 interface{}, v2 interface{}) bool {
+	n1, ok1 := numberValue(v1)
+	n2, ok2 := numberValue(v2)
+	if ok1 || ok2 {
+		return ok1 && ok2 && n1.Cmp(n2) == 0
+	}
+	return v1 == v2
+}
+
+func numberValue(value interface{}) (*big.Rat, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		return new(big.Rat).SetString(v.String())
+	case float64:
+		return new(big.Rat).SetString(strconv.FormatFloat(v, 'g', -1, 64))
+	case int:
+		return new(big.Rat).SetInt64(int64(v)), true
+	case int64:
+		return new(big.Rat).SetInt64(v), true
+	default:
+		return nil, false
+	}
+}
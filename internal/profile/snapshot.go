@@ -0,0 +1,609 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package profile
+
+import (
+	"fmt"
+	"github.com/healthiop/hi/internal/common"
+	"sort"
+	"strings"
+)
+
+const (
+	baseElementTypeCode = "BackboneElement"
+	extensionPropName   = "extension"
+	modifierExtPropName = "modifierExtension"
+)
+
+// ProfileResolver resolves profiles by their canonical URL that may contain a
+// version separated by a vertical bar. If the profile is unknown, nil is
+// returned.
+type ProfileResolver interface {
+	ProfileByURL(canonical string) *Profile
+}
+
+// SnapshotGenerator generates the snapshot of a profile by applying its
+// differential to the snapshot of its base definition. Base definitions of
+// core types are created from the type definitions.
+type SnapshotGenerator struct {
+	typeDefContainer *common.TypeDefContainer
+	resolver         ProfileResolver
+}
+
+type snapshotGeneration struct {
+	*SnapshotGenerator
+	profile *Profile
+	active  map[string]bool
+}
+
+// snapshotNode is an element of the generated snapshot. IDs and paths are
+// set when the tree is converted into the list of snapshot elements.
+type snapshotNode struct {
+	name     string
+	data     map[string]interface{}
+	children []*snapshotNode
+	slices   []*snapshotNode
+}
+
+// NewSnapshotGenerator returns a snapshot generator for the specified FHIR
+// version. The resolver resolves base definitions and type profiles that are
+// no core types. It may be nil.
+func NewSnapshotGenerator(typeDefContainer *common.TypeDefContainer, resolver ProfileResolver) *SnapshotGenerator {
+	return &SnapshotGenerator{typeDefContainer, resolver}
+}
+
+// Generate returns a copy of the specified profile that contains the
+// generated snapshot. An existing snapshot is replaced. An error is returned
+// if the base definition cannot be resolved or if the differential is
+// invalid.
+func (g *SnapshotGenerator) Generate(p *Profile) (*Profile, error) {
+	return g.generate(p, make(map[string]bool))
+}
+
+func (g *SnapshotGenerator) generate(p *Profile, active map[string]bool) (*Profile, error) {
+	if active[p.URL] {
+		return nil, fmt.Errorf("cannot generate snapshot of profile %s: circular base definition", p.URL)
+	}
+	active[p.URL] = true
+	defer delete(active, p.URL)
+
+	gen := &snapshotGeneration{g, p, active}
+	root, err := gen.baseRoot()
+	if err != nil {
+		return nil, fmt.Errorf("cannot generate snapshot of profile %s: %v", p.URL, err)
+	}
+	for _, e := range p.Differential {
+		if err := gen.apply(root, e); err != nil {
+			return nil, fmt.Errorf("cannot generate snapshot of profile %s: element %s: %v", p.URL, e.ID, err)
+		}
+	}
+
+	var elements []interface{}
+	root.flatten("", p.Type, &elements)
+	data := make(map[string]interface{}, len(p.Data)+1)
+	for k, v := range p.Data {
+		data[k] = v
+	}
+	data["snapshot"] = map[string]interface{}{"element": elements}
+	return NewProfile(data)
+}
+
+// baseRoot returns the root of the snapshot of the base definition.
+func (gen *snapshotGeneration) baseRoot() (*snapshotNode, error) {
+	base := gen.profile.BaseDefinition
+	if base == "" {
+		base = CoreProfileURLPrefix + gen.profile.Type
+	}
+
+	var baseProfile *Profile
+	if gen.resolver != nil {
+		baseProfile = gen.resolver.ProfileByURL(base)
+	}
+	if baseProfile == nil {
+		typeName := coreTypeName(base)
+		if typeName == "" {
+			return nil, fmt.Errorf("base definition is unknown: %s", base)
+		}
+		typeDef := gen.typeDefContainer.TypeByName(typeName)
+		if typeDef == nil {
+			return nil, fmt.Errorf("type of base definition is undefined in FHIR %s: %s",
+				gen.typeDefContainer.SymbolicVersion(), typeName)
+		}
+		if typeName != gen.profile.Type {
+			return nil, fmt.Errorf("type %s differs from type %s of base definition", gen.profile.Type, typeName)
+		}
+		root := newSnapshotNode(typeName, map[string]interface{}{"min": 0.0, "max": "*"}, typeName)
+		root.children = gen.typeChildren(typeDef, typeName, map[common.TypeDefAccessor]bool{})
+		return root, nil
+	}
+
+	if baseProfile.Type != gen.profile.Type {
+		return nil, fmt.Errorf("type %s differs from type %s of base definition", gen.profile.Type, baseProfile.Type)
+	}
+	root, err := gen.profileRoot(baseProfile)
+	if err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+// profileRoot returns a copy of the snapshot of the specified profile. If the
+// profile has no snapshot, the snapshot is generated.
+func (gen *snapshotGeneration) profileRoot(p *Profile) (*snapshotNode, error) {
+	if !p.HasSnapshot() {
+		generated, err := gen.generate(p, gen.active)
+		if err != nil {
+			return nil, err
+		}
+		p = generated
+	}
+	return newSnapshotTree(p.root), nil
+}
+
+func newSnapshotTree(e *ElementDefinition) *snapshotNode {
+	n := &snapshotNode{name: e.Name(), data: copyData(e.Data)}
+	for _, c := range e.children {
+		n.children = append(n.children, newSnapshotTree(c))
+	}
+	for _, s := range e.slices {
+		n.slices = append(n.slices, newSnapshotTree(s))
+	}
+	return n
+}
+
+func newSnapshotNode(name string, data map[string]interface{}, basePath string) *snapshotNode {
+	data["base"] = map[string]interface{}{
+		"path": basePath,
+		"min":  data["min"],
+		"max":  data["max"],
+	}
+	return &snapshotNode{name: name, data: data}
+}
+
+// typeChildren returns the elements of the specified type. Elements of
+// anonymous types are expanded. Recursive anonymous types are referenced by
+// a content reference.
+func (gen *snapshotGeneration) typeChildren(typeDef common.TypeDefAccessor, basePath string, visited map[common.TypeDefAccessor]bool) []*snapshotNode {
+	structTypeDef, ok := typeDef.(common.StructTypeDefAccessor)
+	if !ok {
+		structTypeDef = gen.typeDefContainer.ElementType()
+	}
+	visited[typeDef] = true
+	defer delete(visited, typeDef)
+
+	var nodes []*snapshotNode
+	choices := make(map[string]*snapshotNode)
+	for _, propDef := range structTypeDef.Props() {
+		if choice := propDef.Choice(); choice != "" {
+			if n, ok := choices[choice]; ok {
+				n.data["type"] = append(n.data["type"].([]interface{}), typeData(propDef))
+				continue
+			}
+			n := newSnapshotNode(choice+"[x]", propData(propDef), basePath+"."+choice+"[x]")
+			choices[choice] = n
+			nodes = append(nodes, n)
+			continue
+		}
+
+		path := basePath + "." + propDef.Name()
+		n := newSnapshotNode(propDef.Name(), propData(propDef), path)
+		if propType := propDef.Type(); propType.Anonymous() {
+			if visited[propType] {
+				delete(n.data, "type")
+				n.data["contentReference"] = "#" + anonymousTypePath(propType, basePath)
+			} else {
+				n.children = gen.typeChildren(propType, path, visited)
+			}
+		}
+		nodes = append(nodes, n)
+	}
+	return nodes
+}
+
+func anonymousTypePath(typeDef common.TypeDefAccessor, basePath string) string {
+	// the internal name of an anonymous type consists of the type path
+	// separated by underscores (e.g. Questionnaire_Item)
+	parts := strings.Split(typeDef.InternalName(), "_")
+	for i := range parts[1:] {
+		parts[i+1] = strings.ToLower(parts[i+1][:1]) + parts[i+1][1:]
+	}
+	if path := strings.Join(parts, "."); strings.HasPrefix(basePath, path) {
+		return path
+	}
+	return basePath
+}
+
+func propData(propDef *common.PropDef) map[string]interface{} {
+	max := "1"
+	if propDef.Array() {
+		max = "*"
+	}
+	return map[string]interface{}{
+		"min":  float64(propDef.Min()),
+		"max":  max,
+		"type": []interface{}{typeData(propDef)},
+	}
+}
+
+func typeData(propDef *common.PropDef) map[string]interface{} {
+	typeDef := propDef.Type()
+	code := typeDef.InternalName()
+	if typeDef.Anonymous() {
+		code = typeDef.Base().InternalName()
+	} else if len(propDef.Enum()) > 0 && code == common.StringTypeName {
+		code = common.CodeTypeName
+	}
+	return map[string]interface{}{"code": code}
+}
+
+// apply merges the differential element into the snapshot.
+func (gen *snapshotGeneration) apply(root *snapshotNode, e *ElementDefinition) error {
+	if e.Path != elementPathOfID(e.ID) {
+		return fmt.Errorf("path %s does not match ID", e.Path)
+	}
+	segments := strings.Split(e.ID, ".")
+	if segments[0] != root.name {
+		return fmt.Errorf("element is no element of type %s", root.name)
+	}
+
+	n := root
+	for _, segment := range segments[1:] {
+		name, sliceName := segment, ""
+		if i := strings.IndexByte(segment, ':'); i >= 0 {
+			name, sliceName = segment[:i], segment[i+1:]
+		}
+
+		child, err := gen.child(n, name)
+		if err != nil {
+			return err
+		}
+		if sliceName != "" {
+			if child, err = gen.slice(child, sliceName); err != nil {
+				return err
+			}
+		}
+		n = child
+	}
+	return gen.merge(n, e)
+}
+
+// child returns the child element with the specified name. The children of
+// elements with a single type are expanded if required. Names of choice
+// elements with the type name (e.g. valueQuantity) restrict the choice
+// element to that type.
+func (gen *snapshotGeneration) child(n *snapshotNode, name string) (*snapshotNode, error) {
+	if len(n.children) == 0 {
+		if err := gen.expand(n); err != nil {
+			return nil, err
+		}
+	}
+	for _, c := range n.children {
+		if c.name == name {
+			return c, nil
+		}
+	}
+
+	for _, c := range n.children {
+		if prefix := strings.TrimSuffix(c.name, "[x]"); prefix != c.name && strings.HasPrefix(name, prefix) {
+			typeName := name[len(prefix):]
+			for _, code := range typeCodes(c.data) {
+				if strings.EqualFold(code, typeName) && code[1:] == typeName[1:] {
+					if err := restrictTypes(c, code); err != nil {
+						return nil, err
+					}
+					return c, nil
+				}
+			}
+		}
+	}
+	return nil, fmt.Errorf("element %s is undefined", name)
+}
+
+// expand adds the elements of the type of the element as children.
+func (gen *snapshotGeneration) expand(n *snapshotNode) error {
+	if _, ok := n.data["contentReference"]; ok {
+		return fmt.Errorf("element %s uses a content reference and cannot be constrained", n.name)
+	}
+	types, _ := n.data["type"].([]interface{})
+	if len(types) != 1 {
+		return fmt.Errorf("element %s has %d types and cannot be expanded", n.name, len(types))
+	}
+	t, _ := types[0].(map[string]interface{})
+	code := stringValue(t, "code")
+
+	if profiles := stringValues(t, "profile"); len(profiles) == 1 && gen.resolver != nil {
+		if p := gen.resolver.ProfileByURL(profiles[0]); p != nil {
+			root, err := gen.profileRoot(p)
+			if err != nil {
+				return err
+			}
+			n.children = root.children
+			return nil
+		}
+	}
+
+	typeDef := gen.typeDefContainer.TypeByName(code)
+	if typeDef == nil {
+		return fmt.Errorf("type %s of element %s is undefined", code, n.name)
+	}
+	n.children = gen.typeChildren(typeDef, code, map[common.TypeDefAccessor]bool{})
+	return nil
+}
+
+// slice returns the slice with the specified name. Resliced slices have names
+// that are separated by slashes. A new slice is a copy of the sliced element
+// without slices. Extensions and choice elements are sliced implicitly.
+func (gen *snapshotGeneration) slice(n *snapshotNode, sliceName string) (*snapshotNode, error) {
+	parts := strings.Split(sliceName, "/")
+	current := n
+	for i := range parts {
+		name := strings.Join(parts[:i+1], "/")
+		var slice *snapshotNode
+		for _, s := range current.slices {
+			if stringValue(s.data, "sliceName") == name {
+				slice = s
+				break
+			}
+		}
+		if slice == nil {
+			var err error
+			if slice, err = newSlice(current, name); err != nil {
+				return nil, err
+			}
+			current.slices = append(current.slices, slice)
+		}
+		current = slice
+	}
+	return current, nil
+}
+
+func newSlice(n *snapshotNode, sliceName string) (*snapshotNode, error) {
+	if _, ok := n.data["slicing"]; !ok {
+		switch {
+		case n.name == extensionPropName || n.name == modifierExtPropName:
+			n.data["slicing"] = map[string]interface{}{
+				"discriminator": []interface{}{map[string]interface{}{"type": "value", "path": "url"}},
+				"ordered":       false,
+				"rules":         "open",
+			}
+		case strings.HasSuffix(n.name, "[x]"):
+			n.data["slicing"] = map[string]interface{}{
+				"discriminator": []interface{}{map[string]interface{}{"type": "type", "path": "$this"}},
+				"ordered":       false,
+				"rules":         "closed",
+			}
+		default:
+			return nil, fmt.Errorf("slice %s of element %s requires slicing", sliceName, n.name)
+		}
+	}
+
+	slice := n.copy()
+	delete(slice.data, "slicing")
+	slice.data["sliceName"] = sliceName
+	slice.data["min"] = 0.0
+
+	if strings.HasSuffix(n.name, "[x]") {
+		prefix := strings.TrimSuffix(n.name, "[x]")
+		for _, code := range typeCodes(n.data) {
+			if prefix+strings.ToUpper(code[:1])+code[1:] == sliceName {
+				return slice, restrictTypes(slice, code)
+			}
+		}
+	}
+	return slice, nil
+}
+
+// merge applies the properties of the differential element to the snapshot
+// element. Cardinalities and types must not be extended.
+func (gen *snapshotGeneration) merge(n *snapshotNode, e *ElementDefinition) error {
+	if _, ok := e.Data["min"]; ok {
+		if base := intValue(n.data, "min", 0); e.Min < base {
+			return fmt.Errorf("minimum cardinality %d is less than %d", e.Min, base)
+		}
+	}
+	if _, ok := e.Data["max"]; ok {
+		if base := maxValue(n.data); base != unboundedMax && (e.Max == unboundedMax || e.Max > base) {
+			return fmt.Errorf("maximum cardinality %s exceeds %s", e.MaxString(), stringValue(n.data, "max"))
+		}
+	}
+	if len(e.Types) > 0 {
+		if err := gen.validateTypes(n, e.Types); err != nil {
+			return err
+		}
+	}
+	if e.Fixed != nil && e.Pattern != nil {
+		return fmt.Errorf("element defines both fixed value and pattern")
+	}
+
+	names := make([]string, 0, len(e.Data))
+	for name := range e.Data {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value := e.Data[name]
+		switch {
+		case name == "id" || name == "path":
+		case name == "constraint":
+			existing, _ := n.data[name].([]interface{})
+			added, _ := copyValue(value).([]interface{})
+			n.data[name] = append(existing, added...)
+		case strings.HasPrefix(name, "fixed") || strings.HasPrefix(name, "pattern"):
+			for existing := range n.data {
+				if strings.HasPrefix(existing, "fixed") || strings.HasPrefix(existing, "pattern") {
+					delete(n.data, existing)
+				}
+			}
+			n.data[name] = copyValue(value)
+		default:
+			n.data[name] = copyValue(value)
+		}
+	}
+	return nil
+}
+
+// validateTypes checks that all types of the differential are the same as or
+// are derived from a type of the snapshot element.
+func (gen *snapshotGeneration) validateTypes(n *snapshotNode, types []ElementType) error {
+	baseCodes := typeCodes(n.data)
+	if len(baseCodes) == 0 {
+		return nil
+	}
+	for _, t := range types {
+		allowed := false
+		typeDef := gen.typeDefContainer.TypeByName(t.Code)
+		for _, code := range baseCodes {
+			if t.Code == code || (typeDef != nil && typeDef.ExtendsTypeName(code)) ||
+				(code == baseElementTypeCode && t.Code == common.ElementTypeName) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("type %s is not allowed (allowed: %s)", t.Code, strings.Join(baseCodes, ", "))
+		}
+	}
+	return nil
+}
+
+func (n *snapshotNode) copy() *snapshotNode {
+	c := &snapshotNode{name: n.name, data: copyData(n.data)}
+	for _, child := range n.children {
+		c.children = append(c.children, child.copy())
+	}
+	return c
+}
+
+// flatten appends the snapshot elements of the node and its descendants in
+// snapshot order: the element, its children and its slices.
+func (n *snapshotNode) flatten(parentID string, path string, elements *[]interface{}) {
+	id := path
+	if parentID != "" {
+		id = parentID + "." + n.name
+	}
+	if sliceName := stringValue(n.data, "sliceName"); sliceName != "" {
+		id = id + ":" + sliceName
+	}
+	n.flattenWithID(id, path, elements)
+}
+
+func (n *snapshotNode) flattenWithID(id string, path string, elements *[]interface{}) {
+	data := copyData(n.data)
+	data["id"] = id
+	data["path"] = path
+	*elements = append(*elements, data)
+
+	for _, c := range n.children {
+		c.flatten(id, path+"."+c.name, elements)
+	}
+	sliceIDPrefix := id
+	if i := strings.LastIndexByte(id, ':'); i > strings.LastIndexByte(id, '.') {
+		sliceIDPrefix = id[:i]
+	}
+	for _, s := range n.slices {
+		s.flattenWithID(sliceIDPrefix+":"+stringValue(s.data, "sliceName"), path, elements)
+	}
+}
+
+func restrictTypes(n *snapshotNode, code string) error {
+	types, _ := n.data["type"].([]interface{})
+	for _, t := range types {
+		if m, ok := t.(map[string]interface{}); ok && stringValue(m, "code") == code {
+			n.data["type"] = []interface{}{copyValue(m)}
+			return nil
+		}
+	}
+	return fmt.Errorf("type %s is not allowed", code)
+}
+
+// elementPathOfID returns the path of the element with the specified ID by
+// removing all slice names.
+func elementPathOfID(id string) string {
+	segments := strings.Split(id, ".")
+	for i, segment := range segments {
+		if colon := strings.IndexByte(segment, ':'); colon >= 0 {
+			segments[i] = segment[:colon]
+		}
+	}
+	return strings.Join(segments, ".")
+}
+
+func typeCodes(data map[string]interface{}) []string {
+	types, _ := data["type"].([]interface{})
+	codes := make([]string, 0, len(types))
+	for _, t := range types {
+		if m, ok := t.(map[string]interface{}); ok {
+			codes = append(codes, stringValue(m, "code"))
+		}
+	}
+	return codes
+}
+
+func intValue(data map[string]interface{}, name string, defaultValue int) int {
+	if v, ok := data[name].(float64); ok {
+		return int(v)
+	}
+	return defaultValue
+}
+
+func maxValue(data map[string]interface{}) int {
+	max := stringValue(data, "max")
+	if max == "" || max == "*" {
+		return unboundedMax
+	}
+	var v int
+	if _, err := fmt.Sscanf(max, "%d", &v); err != nil {
+		return unboundedMax
+	}
+	return v
+}
+
+func copyData(data map[string]interface{}) map[string]interface{} {
+	return copyValue(data).(map[string]interface{})
+}
+
+// copyValue returns a deep copy of the specified JSON value.
+func copyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			m[k] = copyValue(item)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(v))
+		for i, item := range v {
+			l[i] = copyValue(item)
+		}
+		return l
+	default:
+		return v
+	}
+}
@@ -0,0 +1,438 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package profile
+
+import (
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const testDifferentialPatientProfileURL = "http://example.org/fhir/StructureDefinition/differential-patient"
+
+func TestGenerateSnapshot(t *testing.T) {
+	p, err := ParseProfile(readTestFile(t, "patient_differential.json.golden"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.False(t, p.HasSnapshot())
+
+	g := NewSnapshotGenerator(r4.TypeDefContainer(), nil)
+	s, err := g.Generate(p)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.False(t, p.HasSnapshot(), "profile must not be modified")
+	assert.True(t, s.HasSnapshot())
+	assert.Equal(t, p.URL, s.URL)
+	assert.Len(t, s.Differential, len(p.Differential))
+
+	ids := make([]string, 0, len(s.Snapshot))
+	for _, e := range s.Snapshot {
+		ids = append(ids, e.ID)
+	}
+	assert.Subset(t, ids, []string{"Patient", "Patient.contact", "Patient.contact.name", "Patient.deceased[x]",
+		"Patient.extension", "Patient.extension:birthsex", "Patient.identifier", "Patient.identifier.value",
+		"Patient.identifier:mrn", "Patient.identifier:mrn.system", "Patient.identifier:mrn.value",
+		"Patient.maritalStatus.coding.system", "Patient.multipleBirth[x]"})
+	assert.NotContains(t, ids, "Patient.deceasedBoolean")
+	assert.NotContains(t, ids, "Patient.name.family", "children of data types must be expanded only if constrained")
+	assert.Equal(t, "Patient", ids[0])
+	assert.Less(t, indexOf(ids, "Patient.identifier.value"), indexOf(ids, "Patient.identifier:mrn"),
+		"children of sliced element must precede slices")
+
+	e := s.ElementByID("Patient.identifier")
+	if assert.NotNil(t, e) {
+		assert.Equal(t, 1, e.Min)
+		assert.Equal(t, unboundedMax, e.Max)
+		assert.Equal(t, &Slicing{Discriminators: []Discriminator{{"value", "system"}}, Rules: "open"}, e.Slicing)
+		assert.Equal(t, map[string]interface{}{"path": "Patient.identifier", "min": 0.0, "max": "*"}, e.Data["base"])
+		if assert.Len(t, e.Slices(), 1) {
+			assert.Equal(t, "mrn", e.Slices()[0].SliceName)
+		}
+	}
+	e = s.ElementByID("Patient.identifier:mrn.system")
+	if assert.NotNil(t, e) {
+		assert.Equal(t, "Patient.identifier.system", e.Path)
+		assert.Equal(t, 1, e.Min)
+		assert.Equal(t, "http://example.org/fhir/mrn", e.Fixed)
+		assert.Equal(t, map[string]interface{}{"path": "Identifier.system", "min": 0.0, "max": "1"}, e.Data["base"])
+	}
+	e = s.ElementByID("Patient.identifier:mrn.value")
+	if assert.NotNil(t, e) {
+		assert.Equal(t, 1, e.Min, "constraints of sliced element must be inherited by slices")
+	}
+	e = s.ElementByID("Patient.extension")
+	if assert.NotNil(t, e) {
+		assert.Equal(t, &Slicing{Discriminators: []Discriminator{{"value", "url"}}, Rules: "open"}, e.Slicing)
+	}
+	e = s.ElementByID("Patient.multipleBirth[x]")
+	if assert.NotNil(t, e) {
+		assert.Equal(t, []ElementType{{Code: "boolean"}, {Code: "integer"}}, e.Types)
+	}
+	e = s.ElementByID("Patient.gender")
+	if assert.NotNil(t, e) {
+		assert.Equal(t, []ElementType{{Code: "code"}}, e.Types)
+		assert.True(t, e.MustSupport)
+	}
+	e = s.ElementByID("Patient.contact")
+	if assert.NotNil(t, e) {
+		assert.Equal(t, []ElementType{{Code: "BackboneElement"}}, e.Types)
+	}
+	e = s.ElementByID("Patient.contact.name")
+	if assert.NotNil(t, e) {
+		assert.Equal(t, 1, e.Min)
+		assert.Equal(t, 1, e.Max)
+	}
+}
+
+func TestGenerateSnapshotValidate(t *testing.T) {
+	v := newTestValidator(t)
+	p, err := ParseProfile(readTestFile(t, "patient_differential.json.golden"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	s, err := NewSnapshotGenerator(v.TypeDefContainer(), v).Generate(p)
+	if !assert.NoError(t, err) || !assert.NoError(t, v.AddProfile(s)) {
+		return
+	}
+
+	result, err := v.ValidateData(readTestData(t, "patient_valid.json.golden"), testDifferentialPatientProfileURL)
+	if assert.NoError(t, err) {
+		assert.Empty(t, result.Issues)
+	}
+	result, err = v.ValidateData(readTestData(t, "patient_invalid.json.golden"), testDifferentialPatientProfileURL)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []Issue{
+			{TypeIssueKind, ErrorSeverity, "Patient.extension[0].valueString", "Extension.value[x]",
+				"type string is not allowed by element Extension.value[x]"},
+			{CardinalityIssueKind, ErrorSeverity, "Patient.identifier[0].value", "Patient.identifier.value",
+				"element Patient.identifier.value requires at least 1 value(s) but has 0"},
+			{CardinalityIssueKind, ErrorSeverity, "Patient.identifier[0].value", "Patient.identifier:mrn.value",
+				"element Patient.identifier:mrn.value requires at least 1 value(s) but has 0"},
+			{CardinalityIssueKind, ErrorSeverity, "Patient.gender", "Patient.gender",
+				"element Patient.gender requires at least 1 value(s) but has 0"},
+			{MustSupportIssueKind, InformationSeverity, "Patient.gender", "Patient.gender",
+				"must-support element Patient.gender has no value"},
+			{TypeIssueKind, ErrorSeverity, "Patient.deceasedDateTime", "Patient.deceased[x]",
+				"type dateTime is not allowed by element Patient.deceased[x]"},
+			{TypeIssueKind, ErrorSeverity, "Patient.generalPractitioner[0]", "Patient.generalPractitioner",
+				"reference target type Organization is not allowed by element Patient.generalPractitioner"},
+		}, result.Issues)
+	}
+}
+
+func TestGenerateSnapshotBaseProfile(t *testing.T) {
+	v := newTestValidator(t)
+	p, err := NewProfile(newTestDifferentialData(testPatientProfileURL,
+		map[string]interface{}{"id": "Patient.identifier.value", "path": "Patient.identifier.value", "maxLength": 10.0},
+		map[string]interface{}{"id": "Patient.identifier:ssn", "path": "Patient.identifier", "sliceName": "ssn", "max": "1"}))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	s, err := NewSnapshotGenerator(v.TypeDefContainer(), v).Generate(p)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, s.Snapshot, 22)
+	e := s.ElementByID("Patient.identifier.value")
+	if assert.NotNil(t, e) {
+		assert.Equal(t, 10.0, e.Data["maxLength"])
+		assert.True(t, e.MustSupport, "constraints of base profile must be kept")
+	}
+	e = s.ElementByID("Patient.identifier")
+	if assert.NotNil(t, e) && assert.Len(t, e.Slices(), 2) {
+		assert.Equal(t, "ssn", e.Slices()[1].SliceName)
+		assert.Equal(t, 0, e.Slices()[1].Min)
+		assert.Equal(t, 1, e.Slices()[1].Max)
+		assert.Len(t, e.Slices()[1].Children(), 2)
+	}
+}
+
+func TestGenerateSnapshotChoiceTypes(t *testing.T) {
+	p, err := NewProfile(map[string]interface{}{
+		"resourceType":   "StructureDefinition",
+		"url":            "http://example.org/test",
+		"type":           "Observation",
+		"baseDefinition": "http://hl7.org/fhir/StructureDefinition/Observation",
+		"differential": map[string]interface{}{
+			"element": []interface{}{
+				map[string]interface{}{"id": "Observation.effectiveDateTime", "path": "Observation.effectiveDateTime", "min": 1.0},
+				map[string]interface{}{"id": "Observation.value[x]:valueQuantity", "path": "Observation.value[x]", "sliceName": "valueQuantity"},
+				map[string]interface{}{"id": "Observation.value[x]:valueQuantity.system", "path": "Observation.value[x].system", "fixedUri": "http://unitsofmeasure.org"},
+			},
+		},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	s, err := NewSnapshotGenerator(r4.TypeDefContainer(), nil).Generate(p)
+	if !assert.NoError(t, err) {
+		return
+	}
+	e := s.ElementByID("Observation.effective[x]")
+	if assert.NotNil(t, e) {
+		assert.Equal(t, 1, e.Min)
+		assert.Equal(t, []ElementType{{Code: "dateTime"}}, e.Types)
+	}
+	e = s.ElementByID("Observation.value[x]")
+	if assert.NotNil(t, e) {
+		assert.Equal(t, &Slicing{Discriminators: []Discriminator{{"type", "$this"}}, Rules: "closed"}, e.Slicing)
+		assert.Greater(t, len(e.Types), 1)
+	}
+	e = s.ElementByID("Observation.value[x]:valueQuantity")
+	if assert.NotNil(t, e) {
+		assert.Equal(t, []ElementType{{Code: "Quantity"}}, e.Types)
+	}
+	e = s.ElementByID("Observation.value[x]:valueQuantity.system")
+	if assert.NotNil(t, e) {
+		assert.Equal(t, "Observation.value[x].system", e.Path)
+		assert.Equal(t, "http://unitsofmeasure.org", e.Fixed)
+	}
+}
+
+func TestGenerateSnapshotNoChoiceTypes(t *testing.T) {
+	p, err := NewProfile(map[string]interface{}{
+		"resourceType":   "StructureDefinition",
+		"url":            "http://example.org/test",
+		"type":           "CarePlan",
+		"baseDefinition": "http://hl7.org/fhir/StructureDefinition/CarePlan",
+		"differential": map[string]interface{}{
+			"element": []interface{}{
+				map[string]interface{}{"id": "CarePlan.instantiatesUri", "path": "CarePlan.instantiatesUri", "max": "1"},
+			},
+		},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	s, err := NewSnapshotGenerator(r4.TypeDefContainer(), nil).Generate(p)
+	if !assert.NoError(t, err) {
+		return
+	}
+	// the properties have the same prefix and their type name but are no choice
+	assert.Nil(t, s.ElementByID("CarePlan.instantiates[x]"))
+	assert.NotNil(t, s.ElementByID("CarePlan.instantiatesCanonical"))
+	e := s.ElementByID("CarePlan.instantiatesUri")
+	if assert.NotNil(t, e) {
+		assert.Equal(t, 1, e.Max)
+		assert.Equal(t, []ElementType{{Code: "uri"}}, e.Types)
+	}
+}
+
+func TestGenerateSnapshotContentReference(t *testing.T) {
+	p, err := NewProfile(map[string]interface{}{
+		"resourceType": "StructureDefinition",
+		"url":          "http://example.org/test",
+		"type":         "Questionnaire",
+		"differential": map[string]interface{}{
+			"element": []interface{}{
+				map[string]interface{}{"id": "Questionnaire.item.linkId", "path": "Questionnaire.item.linkId", "maxLength": 20.0},
+			},
+		},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	g := NewSnapshotGenerator(r4.TypeDefContainer(), nil)
+	s, err := g.Generate(p)
+	if !assert.NoError(t, err) {
+		return
+	}
+	e := s.ElementByID("Questionnaire.item.item")
+	if assert.NotNil(t, e) {
+		assert.Equal(t, "#Questionnaire.item", e.ContentReference)
+		assert.Empty(t, e.Types)
+	}
+
+	p.Differential[0].ID = "Questionnaire.item.item.linkId"
+	p.Differential[0].Path = "Questionnaire.item.item.linkId"
+	_, err = g.Generate(p)
+	if assert.Error(t, err) {
+		assert.Equal(t, "cannot generate snapshot of profile http://example.org/test: element Questionnaire.item.item.linkId: "+
+			"element item uses a content reference and cannot be constrained", err.Error())
+	}
+}
+
+func TestGenerateSnapshotInvalidDifferential(t *testing.T) {
+	tests := []struct {
+		element map[string]interface{}
+		message string
+	}{
+		{map[string]interface{}{"id": "Patient.test", "path": "Patient.test"},
+			"element Patient.test: element test is undefined"},
+		{map[string]interface{}{"id": "Observation.status", "path": "Observation.status"},
+			"element Observation.status: element is no element of type Patient"},
+		{map[string]interface{}{"id": "Patient.name", "path": "Patient.address"},
+			"element Patient.name: path Patient.address does not match ID"},
+		{map[string]interface{}{"id": "Patient.active", "path": "Patient.active", "max": "2"},
+			"element Patient.active: maximum cardinality 2 exceeds 1"},
+		{map[string]interface{}{"id": "Patient.active", "path": "Patient.active", "max": "*"},
+			"element Patient.active: maximum cardinality * exceeds 1"},
+		{map[string]interface{}{"id": "Patient.deceased[x]", "path": "Patient.deceased[x]", "type": []interface{}{map[string]interface{}{"code": "string"}}},
+			"element Patient.deceased[x]: type string is not allowed (allowed: boolean, dateTime)"},
+		{map[string]interface{}{"id": "Patient.deceasedString", "path": "Patient.deceasedString"},
+			"element Patient.deceasedString: element deceasedString is undefined"},
+		{map[string]interface{}{"id": "Patient.name:official", "path": "Patient.name", "sliceName": "official"},
+			"element Patient.name:official: slice official of element name requires slicing"},
+		{map[string]interface{}{"id": "Patient.multipleBirth[x].id", "path": "Patient.multipleBirth[x].id"},
+			"element Patient.multipleBirth[x].id: element multipleBirth[x] has 2 types and cannot be expanded"},
+		{map[string]interface{}{"id": "Patient.active", "path": "Patient.active", "fixedBoolean": true, "patternBoolean": true},
+			"element Patient.active: element defines both fixed value and pattern"},
+	}
+
+	g := NewSnapshotGenerator(r4.TypeDefContainer(), nil)
+	for _, test := range tests {
+		p, err := NewProfile(newTestDifferentialData("http://hl7.org/fhir/StructureDefinition/Patient", test.element))
+		if !assert.NoError(t, err) {
+			continue
+		}
+		_, err = g.Generate(p)
+		if assert.Error(t, err, test.message) {
+			assert.Equal(t, "cannot generate snapshot of profile http://example.org/test: "+test.message, err.Error())
+		}
+	}
+}
+
+func TestGenerateSnapshotMinLessThanBase(t *testing.T) {
+	v := newTestValidator(t)
+	p, err := NewProfile(newTestDifferentialData(testPatientProfileURL,
+		map[string]interface{}{"id": "Patient.identifier", "path": "Patient.identifier", "min": 0.0}))
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = NewSnapshotGenerator(v.TypeDefContainer(), v).Generate(p)
+	if assert.Error(t, err) {
+		assert.Equal(t, "cannot generate snapshot of profile http://example.org/test: "+
+			"element Patient.identifier: minimum cardinality 0 is less than 1", err.Error())
+	}
+}
+
+func TestGenerateSnapshotCoreMin(t *testing.T) {
+	g := NewSnapshotGenerator(r4.TypeDefContainer(), nil)
+	p, err := NewProfile(newTestDifferentialData("http://hl7.org/fhir/StructureDefinition/Patient"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	s, err := g.Generate(p)
+	if !assert.NoError(t, err) {
+		return
+	}
+	if e := s.ElementByID("Patient.link.other"); assert.NotNil(t, e, "element expected") {
+		assert.Equal(t, 1, e.Min)
+		assert.Equal(t, map[string]interface{}{"path": "Patient.link.other", "min": 1.0, "max": "1"}, e.Data["base"])
+	}
+	if e := s.ElementByID("Patient.link"); assert.NotNil(t, e, "element expected") {
+		assert.Equal(t, 0, e.Min)
+	}
+
+	p, err = NewProfile(newTestDifferentialData("http://hl7.org/fhir/StructureDefinition/Patient",
+		map[string]interface{}{"id": "Patient.link.other", "path": "Patient.link.other", "min": 0.0}))
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = g.Generate(p)
+	if assert.Error(t, err) {
+		assert.Equal(t, "cannot generate snapshot of profile http://example.org/test: "+
+			"element Patient.link.other: minimum cardinality 0 is less than 1", err.Error())
+	}
+}
+
+func TestGenerateSnapshotUnknownBase(t *testing.T) {
+	p, err := NewProfile(newTestDifferentialData("http://example.org/fhir/StructureDefinition/other"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = NewSnapshotGenerator(r4.TypeDefContainer(), nil).Generate(p)
+	if assert.Error(t, err) {
+		assert.Equal(t, "cannot generate snapshot of profile http://example.org/test: "+
+			"base definition is unknown: http://example.org/fhir/StructureDefinition/other", err.Error())
+	}
+}
+
+func TestGenerateSnapshotBaseTypeMismatch(t *testing.T) {
+	p, err := NewProfile(newTestDifferentialData("http://hl7.org/fhir/StructureDefinition/Observation"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = NewSnapshotGenerator(r4.TypeDefContainer(), nil).Generate(p)
+	if assert.Error(t, err) {
+		assert.Equal(t, "cannot generate snapshot of profile http://example.org/test: "+
+			"type Patient differs from type Observation of base definition", err.Error())
+	}
+}
+
+func TestGenerateSnapshotCircularBase(t *testing.T) {
+	p, err := NewProfile(newTestDifferentialData("http://example.org/test"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = NewSnapshotGenerator(r4.TypeDefContainer(), testProfileResolver{p}).Generate(p)
+	if assert.Error(t, err) {
+		assert.Equal(t, "cannot generate snapshot of profile http://example.org/test: "+
+			"cannot generate snapshot of profile http://example.org/test: circular base definition", err.Error())
+	}
+}
+
+type testProfileResolver struct {
+	profile *Profile
+}
+
+func (r testProfileResolver) ProfileByURL(canonical string) *Profile {
+	if canonical == r.profile.URL {
+		return r.profile
+	}
+	return nil
+}
+
+func newTestDifferentialData(baseDefinition string, elements ...interface{}) map[string]interface{} {
+	if elements == nil {
+		elements = []interface{}{}
+	}
+	return map[string]interface{}{
+		"resourceType":   "StructureDefinition",
+		"url":            "http://example.org/test",
+		"type":           "Patient",
+		"baseDefinition": baseDefinition,
+		"differential":   map[string]interface{}{"element": elements},
+	}
+}
+
+func indexOf(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
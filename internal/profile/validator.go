@@ -0,0 +1,566 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package profile
+
+import (
+	"fmt"
+	"github.com/healthiop/hi"
+	"github.com/healthiop/hi/internal/common"
+	"github.com/healthiop/hi/internal/dynamic"
+	"strings"
+	"sync"
+)
+
+type Severity int
+
+const (
+	ErrorSeverity Severity = iota + 1
+	WarningSeverity
+	InformationSeverity
+)
+
+type IssueKind int
+
+const (
+	// CardinalityIssueKind marks an element or slice with too few or too many values.
+	CardinalityIssueKind IssueKind = iota + 1
+	// FixedValueIssueKind marks a value that differs from the fixed value.
+	FixedValueIssueKind
+	// PatternIssueKind marks a value that does not match the pattern.
+	PatternIssueKind
+	// TypeIssueKind marks a value with a type that is not allowed.
+	TypeIssueKind
+	// SlicingIssueKind marks a value that violates the slicing rules.
+	SlicingIssueKind
+	// MustSupportIssueKind marks a must-support element without values.
+	MustSupportIssueKind
+	// ProfileIssueKind marks a profile that cannot be used for validation.
+	ProfileIssueKind
+)
+
+// maxProfileDepth limits the nesting of profiles that are referenced by
+// element types in order to prevent endless recursions.
+const maxProfileDepth = 16
+
+// Issue describes a value that does not conform to the profile. The location
+// is a FHIRPath expression relative to the validated resource. The element ID
+// is the ID of the violated element definition.
+type Issue struct {
+	Kind      IssueKind
+	Severity  Severity
+	Location  string
+	ElementID string
+	Message   string
+}
+
+// Result contains the issues of a validation.
+type Result struct {
+	ProfileURL string
+	Issues     []Issue
+}
+
+// Validator validates resources against profiles. Profiles that are
+// referenced by other profiles must be added to the validator as well.
+type Validator struct {
+	typeDefContainer *common.TypeDefContainer
+	lock             sync.RWMutex
+	profiles         map[string][]*Profile
+}
+
+type validation struct {
+	*Validator
+	result *Result
+	depth  int
+}
+
+// item is a value of the validated data together with its FHIR type. The
+// element contains the id and extensions of a primitive value.
+type item struct {
+	name     string
+	propDef  *common.PropDef
+	value    interface{}
+	element  map[string]interface{}
+	typeDef  common.TypeDefAccessor
+	location string
+}
+
+func NewValidator(typeDefContainer *common.TypeDefContainer) *Validator {
+	return &Validator{
+		typeDefContainer: typeDefContainer,
+		profiles:         make(map[string][]*Profile),
+	}
+}
+
+func (v *Validator) TypeDefContainer() *common.TypeDefContainer {
+	return v.typeDefContainer
+}
+
+// AddProfile adds a profile that can be used for validation. An error is
+// returned if the profile has no snapshot, if its type is undefined or if a
+// profile with the same canonical URL and version has already been added.
+func (v *Validator) AddProfile(p *Profile) error {
+	if !p.HasSnapshot() {
+		return fmt.Errorf("profile %s has no snapshot", p.URL)
+	}
+	if v.typeDefContainer.TypeByName(p.Type) == nil {
+		return fmt.Errorf("type of profile %s is undefined in FHIR %s: %s",
+			p.URL, v.typeDefContainer.SymbolicVersion(), p.Type)
+	}
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	for _, existing := range v.profiles[p.URL] {
+		if existing.Version == p.Version {
+			return fmt.Errorf("profile has already been added: %s", p.Canonical())
+		}
+	}
+	v.profiles[p.URL] = append(v.profiles[p.URL], p)
+	return nil
+}
+
+// ProfileByURL returns the profile with the specified canonical URL. The URL
+// may contain a version that is separated by a vertical bar. Without a
+// version, the most recently added profile with the URL is returned. If there
+// is no such profile, nil is returned.
+func (v *Validator) ProfileByURL(canonical string) *Profile {
+	url, version := canonical, ""
+	if i := strings.IndexByte(canonical, '|'); i >= 0 {
+		url, version = canonical[:i], canonical[i+1:]
+	}
+
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+	profiles := v.profiles[url]
+	if version == "" {
+		if len(profiles) == 0 {
+			return nil
+		}
+		return profiles[len(profiles)-1]
+	}
+	for _, p := range profiles {
+		if p.Version == version {
+			return p
+		}
+	}
+	return nil
+}
+
+// Validate validates the specified resource against the profile with the
+// specified canonical URL. The resource must have been created for the FHIR
+// version of the validator. An error is returned if the profile is unknown.
+func (v *Validator) Validate(resource hi.DynResourceAccessor, profileURL string) (*Result, error) {
+	if resource.VersionString() != v.typeDefContainer.VersionString() {
+		return nil, fmt.Errorf("resource has FHIR version %s instead of %s",
+			resource.VersionString(), v.typeDefContainer.VersionString())
+	}
+	dataRetriever, ok := resource.(dynamic.DynDataRetriever)
+	if !ok {
+		return nil, fmt.Errorf("resource data cannot be accessed: %T", resource)
+	}
+	return v.ValidateData(dataRetriever.Data(), profileURL)
+}
+
+// ValidateData validates the specified resource data against the profile
+// with the specified canonical URL. An error is returned if the profile is
+// unknown or if the data contains no resource type.
+func (v *Validator) ValidateData(data map[string]interface{}, profileURL string) (*Result, error) {
+	p := v.ProfileByURL(profileURL)
+	if p == nil {
+		return nil, fmt.Errorf("profile is unknown: %s", profileURL)
+	}
+	resourceType, ok := data[dynamic.ResourceTypePropName].(string)
+	if !ok {
+		return nil, fmt.Errorf("data contains no resource type")
+	}
+
+	va := &validation{Validator: v, result: &Result{ProfileURL: profileURL}}
+	it := item{name: resourceType, value: data, location: resourceType,
+		typeDef: v.typeDefContainer.TypeByName(resourceType)}
+	va.validateProfile(p, it)
+	return va.result, nil
+}
+
+// Valid returns if the result contains no error.
+func (r *Result) Valid() bool {
+	for _, issue := range r.Issues {
+		if issue.Severity == ErrorSeverity {
+			return false
+		}
+	}
+	return true
+}
+
+// Errors returns all issues with error severity.
+func (r *Result) Errors() []Issue {
+	return r.issuesWithSeverity(ErrorSeverity)
+}
+
+// MustSupportIssues returns all issues about must-support elements.
+func (r *Result) MustSupportIssues() []Issue {
+	var issues []Issue
+	for _, issue := range r.Issues {
+		if issue.Kind == MustSupportIssueKind {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+func (r *Result) issuesWithSeverity(severity Severity) []Issue {
+	var issues []Issue
+	for _, issue := range r.Issues {
+		if issue.Severity == severity {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+func (va *validation) issue(kind IssueKind, severity Severity, location string, e *ElementDefinition, message string) {
+	// the constraints of the sliced element are repeated by its slices
+	for _, existing := range va.result.Issues {
+		if existing.Kind == kind && existing.Location == location && existing.Message == message {
+			return
+		}
+	}
+	va.result.Issues = append(va.result.Issues, Issue{kind, severity, location, e.ID, message})
+}
+
+func (va *validation) validateProfile(p *Profile, it item) {
+	if it.typeDef == nil || !it.typeDef.ExtendsTypeName(p.Type) {
+		va.issue(TypeIssueKind, ErrorSeverity, it.location, p.root,
+			fmt.Sprintf("type %s does not conform to profile %s with type %s", typeName(it.typeDef), p.URL, p.Type))
+		return
+	}
+	va.depth++
+	defer func() { va.depth-- }()
+	va.validateElement(p.root, it)
+}
+
+func (va *validation) validateElement(e *ElementDefinition, it item) {
+	va.validateValue(e, it)
+	if _, ok := it.value.(map[string]interface{}); !ok {
+		return
+	}
+	for _, child := range e.children {
+		va.validateChild(child, it)
+	}
+}
+
+func (va *validation) validateChild(e *ElementDefinition, parent item) {
+	items := va.childItems(parent, e.Name())
+	location := parent.location + "." + strings.TrimSuffix(e.Name(), "[x]")
+	va.validateCardinality(e, items, location, "element")
+
+	for _, it := range items {
+		va.validateElement(e, it)
+	}
+	if len(e.slices) > 0 {
+		va.validateSlices(e, e.Slicing, items, location)
+	}
+}
+
+func (va *validation) validateCardinality(e *ElementDefinition, items []item, location string, kind string) {
+	count := len(items)
+	if count < e.Min {
+		va.issue(CardinalityIssueKind, ErrorSeverity, location, e,
+			fmt.Sprintf("%s %s requires at least %d value(s) but has %d", kind, e.ID, e.Min, count))
+	}
+	if e.Max != unboundedMax && count > e.Max {
+		va.issue(CardinalityIssueKind, ErrorSeverity, location, e,
+			fmt.Sprintf("%s %s allows at most %d value(s) but has %d", kind, e.ID, e.Max, count))
+	}
+	if e.MustSupport && count == 0 {
+		va.issue(MustSupportIssueKind, InformationSeverity, location, e,
+			fmt.Sprintf("must-support %s %s has no value", kind, e.ID))
+	}
+}
+
+// validateValue validates the type, fixed value, pattern and type profiles of
+// the specified value.
+func (va *validation) validateValue(e *ElementDefinition, it item) {
+	et := va.matchingType(e, it)
+	if et == nil {
+		va.issue(TypeIssueKind, ErrorSeverity, it.location, e,
+			fmt.Sprintf("type %s is not allowed by element %s", typeName(it.typeDef), e.ID))
+		return
+	}
+
+	if e.Fixed != nil && !fixedEqual(it.value, e.Fixed) {
+		va.issue(FixedValueIssueKind, ErrorSeverity, it.location, e,
+			fmt.Sprintf("value differs from fixed value of element %s", e.ID))
+	}
+	if e.Pattern != nil && !patternMatches(it.value, e.Pattern) {
+		va.issue(PatternIssueKind, ErrorSeverity, it.location, e,
+			fmt.Sprintf("value does not match pattern of element %s", e.ID))
+	}
+
+	if len(et.TargetProfiles) > 0 {
+		va.validateReferenceTarget(e, et, it)
+	}
+	if len(et.Profiles) > 0 {
+		va.validateTypeProfiles(e, et.Profiles, it)
+	}
+}
+
+// matchingType returns the type of the element that matches the type of the
+// value. Elements without types (e.g. the root element) match all values.
+func (va *validation) matchingType(e *ElementDefinition, it item) *ElementType {
+	if len(e.Types) == 0 {
+		return &ElementType{}
+	}
+	for i := range e.Types {
+		if va.typeCodeMatches(e.Types[i].Code, it) {
+			return &e.Types[i]
+		}
+	}
+	return nil
+}
+
+// typeCodeMatches returns if the value has the type with the specified code.
+// Properties with coded values are defined as strings with an enumeration,
+// therefore their values match all types that are derived from string.
+func (va *validation) typeCodeMatches(code string, it item) bool {
+	// FHIRPath system types are used by id and url elements
+	if strings.HasPrefix(code, "http://") || it.typeDef == nil || it.typeDef.ExtendsTypeName(code) {
+		return true
+	}
+	if it.propDef == nil || len(it.propDef.Enum()) == 0 {
+		return false
+	}
+	codeTypeDef := va.typeDefContainer.TypeByName(code)
+	return codeTypeDef != nil && codeTypeDef.ExtendsTypeName(it.typeDef.InternalName())
+}
+
+func (va *validation) validateReferenceTarget(e *ElementDefinition, et *ElementType, it item) {
+	m, ok := it.value.(map[string]interface{})
+	if !ok {
+		return
+	}
+	targetTypeName := stringValue(m, "type")
+	if targetTypeName == "" {
+		targetTypeName = referenceTypeName(stringValue(m, "reference"))
+	}
+	if targetTypeName == "" {
+		return
+	}
+	targetTypeDef := va.typeDefContainer.TypeByName(targetTypeName)
+
+	for _, targetProfile := range et.TargetProfiles {
+		profileTypeName := va.profileTypeName(targetProfile)
+		if profileTypeName == "" || (targetTypeDef != nil && targetTypeDef.ExtendsTypeName(profileTypeName)) {
+			return
+		}
+	}
+	va.issue(TypeIssueKind, ErrorSeverity, it.location, e,
+		fmt.Sprintf("reference target type %s is not allowed by element %s", targetTypeName, e.ID))
+}
+
+// profileTypeName returns the type that is constrained by the profile with the
+// specified canonical URL. If the profile is unknown, an empty string is
+// returned.
+func (va *validation) profileTypeName(canonical string) string {
+	if p := va.ProfileByURL(canonical); p != nil {
+		return p.Type
+	}
+	if name := coreTypeName(canonical); name != "" && va.typeDefContainer.TypeByName(name) != nil {
+		return name
+	}
+	return ""
+}
+
+// validateTypeProfiles validates that the value conforms to at least one of the
+// specified profiles. Issues of a single profile are reported directly.
+func (va *validation) validateTypeProfiles(e *ElementDefinition, profileURLs []string, it item) {
+	if va.depth >= maxProfileDepth {
+		va.issue(ProfileIssueKind, WarningSeverity, it.location, e,
+			fmt.Sprintf("profiles of element %s are nested too deeply", e.ID))
+		return
+	}
+
+	var profiles []*Profile
+	for _, url := range profileURLs {
+		if p := va.ProfileByURL(url); p != nil {
+			profiles = append(profiles, p)
+		} else if name := coreTypeName(url); name != "" && va.typeDefContainer.TypeByName(name) != nil {
+			// the base definition of a core type contains no additional constraints
+			return
+		} else {
+			va.issue(ProfileIssueKind, WarningSeverity, it.location, e,
+				fmt.Sprintf("profile %s of element %s is unknown", url, e.ID))
+		}
+	}
+
+	if len(profiles) == 1 {
+		va.validateProfile(profiles[0], it)
+		return
+	}
+	for _, p := range profiles {
+		if va.conforms(p, it) {
+			return
+		}
+	}
+	if len(profiles) > 0 {
+		va.issue(ProfileIssueKind, ErrorSeverity, it.location, e,
+			fmt.Sprintf("value conforms to none of the profiles of element %s", e.ID))
+	}
+}
+
+// conforms returns if the value conforms to the profile without reporting any
+// issue.
+func (va *validation) conforms(p *Profile, it item) bool {
+	nested := &validation{Validator: va.Validator, result: &Result{ProfileURL: p.URL}, depth: va.depth}
+	nested.validateProfile(p, it)
+	return nested.result.Valid()
+}
+
+// childItems returns the values of the element with the specified name. The
+// name of a choice element ends with [x].
+func (va *validation) childItems(parent item, name string) []item {
+	m, ok := parent.value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	structTypeDef, ok := parent.typeDef.(common.StructTypeDefAccessor)
+	if !ok {
+		return nil
+	}
+
+	if choice := strings.TrimSuffix(name, "[x]"); choice != name || structTypeDef.PropByName(name) == nil {
+		var items []item
+		for _, propDef := range structTypeDef.Props() {
+			if propDef.Choice() == choice {
+				items = append(items, va.propItems(parent.location, m, propDef)...)
+			}
+		}
+		return items
+	}
+	return va.propItems(parent.location, m, structTypeDef.PropByName(name))
+}
+
+func (va *validation) propItems(location string, m map[string]interface{}, propDef *common.PropDef) []item {
+	name := propDef.Name()
+	location = location + "." + name
+	values, elements := m[name], m["_"+name]
+	if values == nil && elements == nil {
+		return nil
+	}
+
+	if !propDef.Array() {
+		element, _ := elements.(map[string]interface{})
+		return []item{va.newItem(propDef, values, element, location)}
+	}
+
+	valueList, _ := values.([]interface{})
+	elementList, _ := elements.([]interface{})
+	count := len(valueList)
+	if len(elementList) > count {
+		count = len(elementList)
+	}
+	items := make([]item, 0, count)
+	for i := 0; i < count; i++ {
+		var value interface{}
+		var element map[string]interface{}
+		if i < len(valueList) {
+			value = valueList[i]
+		}
+		if i < len(elementList) {
+			element, _ = elementList[i].(map[string]interface{})
+		}
+		items = append(items, va.newItem(propDef, value, element, fmt.Sprintf("%s[%d]", location, i)))
+	}
+	return items
+}
+
+func (va *validation) newItem(propDef *common.PropDef, value interface{}, element map[string]interface{}, location string) item {
+	typeDef := propDef.Type()
+	if typeDef.TypeKind() == common.ResourceTypeKind {
+		if m, ok := value.(map[string]interface{}); ok {
+			if rt, ok := m[dynamic.ResourceTypePropName].(string); ok {
+				if resourceTypeDef := va.typeDefContainer.TypeByName(rt); resourceTypeDef != nil {
+					typeDef = resourceTypeDef
+				}
+			}
+		}
+	}
+	return item{propDef.Name(), propDef, value, element, typeDef, location}
+}
+
+// pathItems returns the values at the specified relative FHIRPath. Only
+// simple paths that consist of element names are supported.
+func (va *validation) pathItems(it item, path string) []item {
+	items := []item{it}
+	if path == "$this" {
+		return items
+	}
+	for _, segment := range strings.Split(path, ".") {
+		var next []item
+		for _, current := range items {
+			next = append(next, va.childItems(current, segment)...)
+		}
+		items = next
+	}
+	return items
+}
+
+func typeName(typeDef common.TypeDefAccessor) string {
+	if typeDef == nil {
+		return "undefined"
+	}
+	return typeDef.InternalName()
+}
+
+// coreTypeName returns the type name of the base definition with the
+// specified canonical URL. If the URL is no base definition URL, an empty
+// string is returned.
+func coreTypeName(canonical string) string {
+	if i := strings.IndexByte(canonical, '|'); i >= 0 {
+		canonical = canonical[:i]
+	}
+	if !strings.HasPrefix(canonical, CoreProfileURLPrefix) {
+		return ""
+	}
+	return canonical[len(CoreProfileURLPrefix):]
+}
+
+// referenceTypeName returns the resource type of a relative or absolute
+// literal reference. For other references an empty string is returned.
+func referenceTypeName(reference string) string {
+	if reference == "" || strings.HasPrefix(reference, "#") || strings.HasPrefix(reference, "urn:") {
+		return ""
+	}
+	if i := strings.Index(reference, "/_history/"); i >= 0 {
+		reference = reference[:i]
+	}
+	parts := strings.Split(reference, "/")
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[len(parts)-2]
+}
@@ -0,0 +1,377 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package profile
+
+import (
+	"encoding/json"
+	"github.com/healthiop/hi/internal/dynamic"
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/healthiop/hi/internal/stu3"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const (
+	testPatientProfileURL     = "http://example.org/fhir/StructureDefinition/test-patient"
+	testObservationProfileURL = "http://example.org/fhir/StructureDefinition/test-observation"
+)
+
+func TestValidatePatient(t *testing.T) {
+	v := newTestValidator(t)
+	resource, err := dynamic.NewDynResource(r4.TypeDefContainer(), readTestData(t, "patient_valid.json.golden"), nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	result, err := v.Validate(resource, testPatientProfileURL+"|1.0.0")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, testPatientProfileURL+"|1.0.0", result.ProfileURL)
+	assert.True(t, result.Valid())
+	assert.Empty(t, result.Errors())
+	assert.Equal(t, []Issue{
+		{MustSupportIssueKind, InformationSeverity, "Patient.telecom", "Patient.telecom",
+			"must-support element Patient.telecom has no value"},
+	}, result.Issues)
+}
+
+func TestValidatePatientInvalid(t *testing.T) {
+	v := newTestValidator(t)
+	result, err := v.ValidateData(readTestData(t, "patient_invalid.json.golden"), testPatientProfileURL)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.False(t, result.Valid())
+	assert.Equal(t, []Issue{
+		{TypeIssueKind, ErrorSeverity, "Patient.extension[0].valueString", "Extension.value[x]",
+			"type string is not allowed by element Extension.value[x]"},
+		{CardinalityIssueKind, ErrorSeverity, "Patient.identifier[0].value", "Patient.identifier.value",
+			"element Patient.identifier.value requires at least 1 value(s) but has 0"},
+		{MustSupportIssueKind, InformationSeverity, "Patient.identifier[0].value", "Patient.identifier.value",
+			"must-support element Patient.identifier.value has no value"},
+		{PatternIssueKind, ErrorSeverity, "Patient.identifier[0].type", "Patient.identifier:mrn.type",
+			"value does not match pattern of element Patient.identifier:mrn.type"},
+		{FixedValueIssueKind, ErrorSeverity, "Patient.active", "Patient.active",
+			"value differs from fixed value of element Patient.active"},
+		{CardinalityIssueKind, ErrorSeverity, "Patient.name[0].family", "Patient.name.family",
+			"element Patient.name.family requires at least 1 value(s) but has 0"},
+		{MustSupportIssueKind, InformationSeverity, "Patient.name[0].family", "Patient.name.family",
+			"must-support element Patient.name.family has no value"},
+		{MustSupportIssueKind, InformationSeverity, "Patient.telecom", "Patient.telecom",
+			"must-support element Patient.telecom has no value"},
+		{CardinalityIssueKind, ErrorSeverity, "Patient.gender", "Patient.gender",
+			"element Patient.gender requires at least 1 value(s) but has 0"},
+		{MustSupportIssueKind, InformationSeverity, "Patient.gender", "Patient.gender",
+			"must-support element Patient.gender has no value"},
+		{TypeIssueKind, ErrorSeverity, "Patient.deceasedDateTime", "Patient.deceased[x]",
+			"type dateTime is not allowed by element Patient.deceased[x]"},
+		{PatternIssueKind, ErrorSeverity, "Patient.maritalStatus", "Patient.maritalStatus",
+			"value does not match pattern of element Patient.maritalStatus"},
+		{TypeIssueKind, ErrorSeverity, "Patient.generalPractitioner[0]", "Patient.generalPractitioner",
+			"reference target type Organization is not allowed by element Patient.generalPractitioner"},
+		{CardinalityIssueKind, ErrorSeverity, "Patient.managingOrganization", "Patient.managingOrganization",
+			"element Patient.managingOrganization allows at most 0 value(s) but has 1"},
+	}, result.Issues)
+}
+
+func TestValidateObservation(t *testing.T) {
+	v := newTestValidator(t)
+	result, err := v.ValidateData(readTestData(t, "observation.json.golden"), testObservationProfileURL)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.False(t, result.Valid())
+	assert.Equal(t, []Issue{
+		{SlicingIssueKind, ErrorSeverity, "Observation.category[1]", "Observation.category",
+			"value of slice Observation.category:vitals violates slice order of element Observation.category"},
+		{SlicingIssueKind, ErrorSeverity, "Observation.category[2]", "Observation.category",
+			"value matches no slice of closed slicing of element Observation.category"},
+		{FixedValueIssueKind, ErrorSeverity, "Observation.valueQuantity.system", "Observation.value[x]:valueQuantity.system",
+			"value differs from fixed value of element Observation.value[x]:valueQuantity.system"},
+	}, result.Issues)
+}
+
+func TestValidateObservationSlicesMissing(t *testing.T) {
+	v := newTestValidator(t)
+	result, err := v.ValidateData(map[string]interface{}{
+		"resourceType": "Observation",
+		"extension": []interface{}{
+			map[string]interface{}{
+				"url":         "http://example.org/fhir/StructureDefinition/birthsex",
+				"valueString": "F",
+			},
+		},
+		"status": "final",
+		"category": []interface{}{
+			map[string]interface{}{"text": "Vital signs"},
+		},
+		"code":        map[string]interface{}{"text": "Test"},
+		"subject":     map[string]interface{}{"reference": "Patient/123"},
+		"valueString": "test",
+	}, testObservationProfileURL)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, []Issue{
+		{CardinalityIssueKind, ErrorSeverity, "Observation.extension", "Observation.extension:birthsex",
+			"slice Observation.extension:birthsex requires at least 1 value(s) but has 0"},
+		{CardinalityIssueKind, ErrorSeverity, "Observation.category", "Observation.category:vitals",
+			"slice Observation.category:vitals requires at least 1 value(s) but has 0"},
+		{SlicingIssueKind, ErrorSeverity, "Observation.category[0]", "Observation.category",
+			"value matches no slice of closed slicing of element Observation.category"},
+		{SlicingIssueKind, ErrorSeverity, "Observation.valueString", "Observation.value[x]",
+			"value matches no slice of closed slicing of element Observation.value[x]"},
+	}, result.Issues)
+}
+
+func TestValidateReslices(t *testing.T) {
+	p, err := ParseProfile([]byte(`{
+  "resourceType": "StructureDefinition",
+  "url": "http://example.org/fhir/StructureDefinition/test-reslices",
+  "type": "Observation",
+  "snapshot": {"element": [
+    {"id": "Observation", "path": "Observation", "min": 0, "max": "*"},
+    {"id": "Observation.category", "path": "Observation.category", "min": 0, "max": "*",
+     "slicing": {"discriminator": [{"type": "pattern", "path": "$this"}], "rules": "open"},
+     "type": [{"code": "CodeableConcept"}]},
+    {"id": "Observation.category:vitals", "path": "Observation.category", "sliceName": "vitals",
+     "min": 0, "max": "*", "type": [{"code": "CodeableConcept"}],
+     "patternCodeableConcept": {"coding": [{"system": "http://example.org/category", "code": "vitals"}]}},
+    {"id": "Observation.category:vitals/blood", "path": "Observation.category", "sliceName": "vitals/blood",
+     "min": 1, "max": "1", "type": [{"code": "CodeableConcept"}],
+     "patternCodeableConcept": {"coding": [{"system": "http://example.org/category", "code": "vitals"},
+       {"system": "http://example.org/category", "code": "blood"}]}}
+  ]}
+}`))
+	v := NewValidator(r4.TypeDefContainer())
+	if !assert.NoError(t, err) || !assert.NoError(t, v.AddProfile(p)) {
+		return
+	}
+
+	category := func(codes ...string) interface{} {
+		codings := make([]interface{}, len(codes))
+		for i, code := range codes {
+			codings[i] = map[string]interface{}{"system": "http://example.org/category", "code": code}
+		}
+		return map[string]interface{}{"coding": codings}
+	}
+	result, err := v.ValidateData(map[string]interface{}{
+		"resourceType": "Observation",
+		"category":     []interface{}{category("vitals"), category("vitals", "blood")},
+	}, p.URL)
+	if assert.NoError(t, err) {
+		assert.Empty(t, result.Issues)
+	}
+
+	result, err = v.ValidateData(map[string]interface{}{
+		"resourceType": "Observation",
+		"category":     []interface{}{category("vitals"), category("blood")},
+	}, p.URL)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []Issue{
+			{CardinalityIssueKind, ErrorSeverity, "Observation.category", "Observation.category:vitals/blood",
+				"slice Observation.category:vitals/blood requires at least 1 value(s) but has 0"},
+		}, result.Issues)
+	}
+}
+
+func TestValidateResourceTypeMismatch(t *testing.T) {
+	v := newTestValidator(t)
+	result, err := v.ValidateData(map[string]interface{}{"resourceType": "Observation"}, testPatientProfileURL)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []Issue{
+			{TypeIssueKind, ErrorSeverity, "Observation", "Patient",
+				"type Observation does not conform to profile " + testPatientProfileURL + " with type Patient"},
+		}, result.Issues)
+	}
+}
+
+func TestValidateUnknownTypeProfile(t *testing.T) {
+	v := NewValidator(r4.TypeDefContainer())
+	p, err := ParseProfile(readTestFile(t, "patient_profile.json.golden"))
+	if !assert.NoError(t, err) || !assert.NoError(t, v.AddProfile(p)) {
+		return
+	}
+
+	result, err := v.ValidateData(readTestData(t, "patient_valid.json.golden"), testPatientProfileURL)
+	if assert.NoError(t, err) {
+		assert.True(t, result.Valid())
+		assert.Contains(t, result.Issues, Issue{ProfileIssueKind, WarningSeverity,
+			"Patient.extension[1]", "Patient.extension:birthsex",
+			"profile http://example.org/fhir/StructureDefinition/birthsex of element Patient.extension:birthsex is unknown"})
+	}
+}
+
+func TestValidateUnknownProfile(t *testing.T) {
+	v := newTestValidator(t)
+	_, err := v.ValidateData(map[string]interface{}{"resourceType": "Patient"}, "http://example.org/test")
+	if assert.Error(t, err) {
+		assert.Equal(t, "profile is unknown: http://example.org/test", err.Error())
+	}
+}
+
+func TestValidateNoResourceType(t *testing.T) {
+	v := newTestValidator(t)
+	_, err := v.ValidateData(map[string]interface{}{}, testPatientProfileURL)
+	if assert.Error(t, err) {
+		assert.Equal(t, "data contains no resource type", err.Error())
+	}
+}
+
+func TestValidateVersionMismatch(t *testing.T) {
+	v := newTestValidator(t)
+	resource, err := dynamic.NewDynResource(stu3.TypeDefContainer(), map[string]interface{}{"resourceType": "Patient"}, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = v.Validate(resource, testPatientProfileURL)
+	if assert.Error(t, err) {
+		assert.Equal(t, "resource has FHIR version 3.0.2 instead of 4.0.1", err.Error())
+	}
+}
+
+func TestAddProfileDuplicate(t *testing.T) {
+	v := newTestValidator(t)
+	p, err := ParseProfile(readTestFile(t, "patient_profile.json.golden"))
+	if assert.NoError(t, err) {
+		err = v.AddProfile(p)
+		if assert.Error(t, err) {
+			assert.Equal(t, "profile has already been added: "+testPatientProfileURL+"|1.0.0", err.Error())
+		}
+	}
+}
+
+func TestAddProfileUndefinedType(t *testing.T) {
+	v := NewValidator(stu3.TypeDefContainer())
+	p, err := NewProfile(newTestProfileData(map[string]interface{}{"path": "Patient"}))
+	if assert.NoError(t, err) {
+		p.Type = "MedicationUsage"
+		err = v.AddProfile(p)
+		if assert.Error(t, err) {
+			assert.Equal(t, "type of profile http://example.org/test is undefined in FHIR STU3: MedicationUsage", err.Error())
+		}
+	}
+}
+
+func TestAddProfileNoSnapshot(t *testing.T) {
+	v := NewValidator(r4.TypeDefContainer())
+	p, err := NewProfile(map[string]interface{}{
+		"resourceType": "StructureDefinition",
+		"url":          "http://example.org/test",
+		"type":         "Patient",
+	})
+	if assert.NoError(t, err) {
+		err = v.AddProfile(p)
+		if assert.Error(t, err) {
+			assert.Equal(t, "profile http://example.org/test has no snapshot", err.Error())
+		}
+	}
+}
+
+func TestProfileByURL(t *testing.T) {
+	v := newTestValidator(t)
+	p2, err := ParseProfile(readTestFile(t, "patient_profile.json.golden"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	p2.Version = "2.0.0"
+	if !assert.NoError(t, v.AddProfile(p2)) {
+		return
+	}
+
+	assert.Same(t, p2, v.ProfileByURL(testPatientProfileURL))
+	assert.Same(t, p2, v.ProfileByURL(testPatientProfileURL+"|2.0.0"))
+	if p1 := v.ProfileByURL(testPatientProfileURL + "|1.0.0"); assert.NotNil(t, p1) {
+		assert.Equal(t, "1.0.0", p1.Version)
+	}
+	assert.Nil(t, v.ProfileByURL(testPatientProfileURL+"|3.0.0"))
+	assert.Nil(t, v.ProfileByURL("http://example.org/test"))
+}
+
+func TestPatternMatches(t *testing.T) {
+	assert.True(t, patternMatches("test", "test"))
+	assert.False(t, patternMatches("test", "other"))
+	assert.False(t, patternMatches(nil, "test"))
+	assert.True(t, patternMatches(map[string]interface{}{"a": "1", "b": "2"}, map[string]interface{}{"a": "1"}))
+	assert.False(t, patternMatches(map[string]interface{}{"a": "1"}, map[string]interface{}{"a": "1", "b": "2"}))
+	assert.False(t, patternMatches("test", map[string]interface{}{"a": "1"}))
+	assert.True(t, patternMatches([]interface{}{"a", "b"}, []interface{}{"b"}))
+	assert.False(t, patternMatches([]interface{}{"a", "b"}, []interface{}{"c"}))
+	assert.True(t, patternMatches("a", []interface{}{"a"}))
+	assert.True(t, patternMatches(nil, []interface{}{}))
+	assert.False(t, patternMatches(nil, []interface{}{"a"}))
+	assert.True(t, patternMatches(map[string]interface{}{"value": json.Number("2")}, map[string]interface{}{"value": 2.0}))
+	assert.True(t, patternMatches(json.Number("1.50"), json.Number("1.5")))
+	assert.False(t, patternMatches(json.Number("2"), 3.0))
+	assert.False(t, patternMatches("2", 2.0))
+}
+
+func TestFixedEqual(t *testing.T) {
+	assert.True(t, fixedEqual("test", "test"))
+	assert.False(t, fixedEqual("test", "other"))
+	assert.True(t, fixedEqual(json.Number("2"), 2.0))
+	assert.True(t, fixedEqual(2.0, json.Number("2.0")))
+	assert.False(t, fixedEqual(json.Number("2"), 2.5))
+	assert.False(t, fixedEqual("2", json.Number("2")))
+	assert.True(t, fixedEqual(map[string]interface{}{"value": json.Number("2"), "unit": "mg"},
+		map[string]interface{}{"value": 2.0, "unit": "mg"}))
+	assert.False(t, fixedEqual(map[string]interface{}{"value": json.Number("2"), "unit": "mg"},
+		map[string]interface{}{"value": 2.0}))
+	assert.True(t, fixedEqual([]interface{}{json.Number("1"), "a"}, []interface{}{1.0, "a"}))
+	assert.False(t, fixedEqual([]interface{}{json.Number("1")}, []interface{}{1.0, "a"}))
+	assert.False(t, fixedEqual(nil, "a"))
+}
+
+func TestReferenceTypeName(t *testing.T) {
+	assert.Equal(t, "Patient", referenceTypeName("Patient/123"))
+	assert.Equal(t, "Patient", referenceTypeName("http://example.org/fhir/Patient/123"))
+	assert.Equal(t, "Patient", referenceTypeName("Patient/123/_history/2"))
+	assert.Equal(t, "", referenceTypeName("#p1"))
+	assert.Equal(t, "", referenceTypeName("urn:uuid:c757873d-ec9a-4326-a141-556f43239520"))
+	assert.Equal(t, "", referenceTypeName("123"))
+	assert.Equal(t, "", referenceTypeName(""))
+}
+
+func newTestValidator(t *testing.T) *Validator {
+	v := NewValidator(r4.TypeDefContainer())
+	for _, fileName := range []string{"patient_profile.json.golden", "birthsex_profile.json.golden", "observation_profile.json.golden"} {
+		p, err := ParseProfile(readTestFile(t, fileName))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := v.AddProfile(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return v
+}
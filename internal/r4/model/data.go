@@ -0,0 +1,473 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+const (
+	elementPrefix        = "_"
+	resourceTypePropName = "resourceType"
+)
+
+type dataReadWriter interface {
+	readData(r *dataReader)
+	writeData(w *dataWriter)
+}
+
+// dataReader reads the properties of a struct from the data of the dynamic
+// model. The names of the properties that have been read are recorded, so
+// that properties that are not defined by the struct can be reported. Only
+// the first error is kept.
+type dataReader struct {
+	data map[string]interface{}
+	path string
+	read []string
+	err  error
+}
+
+func newDataReader(data map[string]interface{}, path string) *dataReader {
+	return &dataReader{data: data, path: path}
+}
+
+// value returns the value of the property and records that it has been
+// read.
+func (r *dataReader) value(name string) interface{} {
+	v, found := r.data[name]
+	if found {
+		r.read = append(r.read, name)
+	}
+	return v
+}
+
+func (r *dataReader) fail(name string, format string, a ...interface{}) {
+	if r.err == nil {
+		r.err = fmt.Errorf("%s.%s: %s", r.path, name, fmt.Sprintf(format, a...))
+	}
+}
+
+func (r *dataReader) failErr(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+// complete checks that all properties have been read and returns the first
+// error.
+func (r *dataReader) complete() error {
+	if r.err != nil || len(r.read) == len(r.data) {
+		return r.err
+	}
+	read := make(map[string]bool, len(r.read))
+	for _, name := range r.read {
+		read[name] = true
+	}
+	var undefined []string
+	for name := range r.data {
+		if !read[name] {
+			undefined = append(undefined, name)
+		}
+	}
+	sort.Strings(undefined)
+	r.fail(undefined[0], "property is not defined")
+	return r.err
+}
+
+func (r *dataReader) childPath(name string, index int) string {
+	if index < 0 {
+		return r.path + "." + name
+	}
+	return r.path + "." + name + "[" + strconv.Itoa(index) + "]"
+}
+
+// list returns the items of the list property.
+func (r *dataReader) list(name string) []interface{} {
+	v := r.value(name)
+	if v == nil {
+		return nil
+	}
+	items, ok := v.([]interface{})
+	if !ok {
+		r.fail(name, "list property contains data with type %T", v)
+	}
+	return items
+}
+
+func (r *dataReader) string(name string, value *string, element **Element) {
+	if v := r.value(name); v != nil {
+		*value = r.stringValue(name, v)
+	}
+	readStruct(r, elementPrefix+name, element)
+}
+
+func (r *dataReader) strings(name string, values *[]string, elements *[]*Element) {
+	if items := r.list(name); items != nil {
+		*values = make([]string, len(items))
+		for i, item := range items {
+			if item != nil {
+				(*values)[i] = r.stringValue(name, item)
+			}
+		}
+	}
+	r.elements(name, elements)
+}
+
+func (r *dataReader) stringValue(name string, v interface{}) string {
+	s, ok := v.(string)
+	if !ok {
+		r.fail(name, "value must be a string: %T", v)
+	}
+	return s
+}
+
+func (r *dataReader) bool(name string, value **bool, element **Element) {
+	if v := r.value(name); v != nil {
+		if b, ok := v.(bool); ok {
+			*value = &b
+		} else {
+			r.fail(name, "value must be a boolean: %T", v)
+		}
+	}
+	readStruct(r, elementPrefix+name, element)
+}
+
+func (r *dataReader) integer(name string, value **int64, element **Element) {
+	if v := r.value(name); v != nil {
+		i := r.integerValue(name, v)
+		*value = &i
+	}
+	readStruct(r, elementPrefix+name, element)
+}
+
+func (r *dataReader) integers(name string, values *[]*int64, elements *[]*Element) {
+	if items := r.list(name); items != nil {
+		*values = make([]*int64, len(items))
+		for i, item := range items {
+			if item != nil {
+				v := r.integerValue(name, item)
+				(*values)[i] = &v
+			}
+		}
+	}
+	r.elements(name, elements)
+}
+
+func (r *dataReader) integerValue(name string, v interface{}) int64 {
+	switch n := v.(type) {
+	case float64:
+		if n == math.Trunc(n) && math.Abs(n) <= 1<<53 {
+			return int64(n)
+		}
+	case json.Number:
+		if i, err := n.Int64(); err == nil {
+			return i
+		}
+	case int:
+		return int64(n)
+	case int64:
+		return n
+	}
+	r.fail(name, "value must be an integer: %v", v)
+	return 0
+}
+
+func (r *dataReader) decimal(name string, value *json.Number, element **Element) {
+	if v := r.value(name); v != nil {
+		*value = r.decimalValue(name, v)
+	}
+	readStruct(r, elementPrefix+name, element)
+}
+
+func (r *dataReader) decimals(name string, values *[]json.Number, elements *[]*Element) {
+	if items := r.list(name); items != nil {
+		*values = make([]json.Number, len(items))
+		for i, item := range items {
+			if item != nil {
+				(*values)[i] = r.decimalValue(name, item)
+			}
+		}
+	}
+	r.elements(name, elements)
+}
+
+func (r *dataReader) decimalValue(name string, v interface{}) json.Number {
+	switch n := v.(type) {
+	case float64:
+		return json.Number(strconv.FormatFloat(n, 'f', -1, 64))
+	case json.Number:
+		if _, err := n.Float64(); err == nil {
+			return n
+		}
+	case int:
+		return json.Number(strconv.Itoa(n))
+	case int64:
+		return json.Number(strconv.FormatInt(n, 10))
+	}
+	r.fail(name, "value must be a decimal: %v", v)
+	return ""
+}
+
+// elements reads the elements of a primitive list. Elements of items
+// without id and extensions are nil.
+func (r *dataReader) elements(name string, elements *[]*Element) {
+	name = elementPrefix + name
+	items := r.list(name)
+	if items == nil {
+		return
+	}
+	*elements = make([]*Element, len(items))
+	for i, item := range items {
+		if item != nil {
+			(*elements)[i] = readItem[Element](r, name, i, item)
+		}
+	}
+}
+
+func (r *dataReader) resource(name string, value *AnyResource) {
+	if v := r.value(name); v != nil {
+		*value = r.resourceValue(name, -1, v)
+	}
+}
+
+func (r *dataReader) resources(name string, values *[]AnyResource) {
+	if items := r.list(name); items != nil {
+		*values = make([]AnyResource, len(items))
+		for i, item := range items {
+			if item != nil {
+				(*values)[i] = r.resourceValue(name, i, item)
+			}
+		}
+	}
+}
+
+func (r *dataReader) resourceValue(name string, index int, v interface{}) AnyResource {
+	data, ok := v.(map[string]interface{})
+	if !ok {
+		r.fail(name, "resource must be an object: %T", v)
+		return nil
+	}
+	resource, err := resourceFromData(data, r.childPath(name, index))
+	if err != nil {
+		r.failErr(err)
+		return nil
+	}
+	return resource
+}
+
+// readStruct reads the struct of the property. The struct is created if
+// the property has a value.
+func readStruct[T any, P interface {
+	*T
+	dataReadWriter
+}](r *dataReader, name string, value **T) {
+	if v := r.value(name); v != nil {
+		*value = readItem[T, P](r, name, -1, v)
+	}
+}
+
+func readStructs[T any, P interface {
+	*T
+	dataReadWriter
+}](r *dataReader, name string, values *[]*T) {
+	if items := r.list(name); items != nil {
+		*values = make([]*T, len(items))
+		for i, item := range items {
+			if item != nil {
+				(*values)[i] = readItem[T, P](r, name, i, item)
+			}
+		}
+	}
+}
+
+func readItem[T any, P interface {
+	*T
+	dataReadWriter
+}](r *dataReader, name string, index int, v interface{}) *T {
+	data, ok := v.(map[string]interface{})
+	if !ok {
+		r.fail(name, "value must be an object: %T", v)
+		return nil
+	}
+	o := P(new(T))
+	child := newDataReader(data, r.childPath(name, index))
+	o.readData(child)
+	if err := child.complete(); err != nil {
+		r.failErr(err)
+	}
+	return (*T)(o)
+}
+
+// dataWriter writes the properties of a struct into the data of the dynamic
+// model. Empty values are not written.
+type dataWriter struct {
+	data map[string]interface{}
+}
+
+func newDataWriter() *dataWriter {
+	return &dataWriter{make(map[string]interface{})}
+}
+
+func (w *dataWriter) string(name string, value string, element *Element) {
+	if value != "" {
+		w.data[name] = value
+	}
+	writeStruct(w, elementPrefix+name, element)
+}
+
+func (w *dataWriter) strings(name string, values []string, elements []*Element) {
+	items := make([]interface{}, len(values))
+	for i, v := range values {
+		if v != "" {
+			items[i] = v
+		}
+	}
+	w.list(name, items)
+	w.elements(name, elements)
+}
+
+func (w *dataWriter) bool(name string, value *bool, element *Element) {
+	if value != nil {
+		w.data[name] = *value
+	}
+	writeStruct(w, elementPrefix+name, element)
+}
+
+func (w *dataWriter) integer(name string, value *int64, element *Element) {
+	if value != nil {
+		w.data[name] = json.Number(strconv.FormatInt(*value, 10))
+	}
+	writeStruct(w, elementPrefix+name, element)
+}
+
+func (w *dataWriter) integers(name string, values []*int64, elements []*Element) {
+	items := make([]interface{}, len(values))
+	for i, v := range values {
+		if v != nil {
+			items[i] = json.Number(strconv.FormatInt(*v, 10))
+		}
+	}
+	w.list(name, items)
+	w.elements(name, elements)
+}
+
+func (w *dataWriter) decimal(name string, value json.Number, element *Element) {
+	if value != "" {
+		w.data[name] = value
+	}
+	writeStruct(w, elementPrefix+name, element)
+}
+
+func (w *dataWriter) decimals(name string, values []json.Number, elements []*Element) {
+	items := make([]interface{}, len(values))
+	for i, v := range values {
+		if v != "" {
+			items[i] = v
+		}
+	}
+	w.list(name, items)
+	w.elements(name, elements)
+}
+
+func (w *dataWriter) elements(name string, elements []*Element) {
+	items := make([]interface{}, len(elements))
+	for i, e := range elements {
+		if e != nil {
+			if data := writeItem(e); len(data) > 0 {
+				items[i] = data
+			}
+		}
+	}
+	w.list(elementPrefix+name, items)
+}
+
+func (w *dataWriter) resource(name string, value AnyResource) {
+	if value != nil {
+		w.data[name] = resourceToData(value)
+	}
+}
+
+func (w *dataWriter) resources(name string, values []AnyResource) {
+	items := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		if v != nil {
+			items = append(items, resourceToData(v))
+		}
+	}
+	w.list(name, items)
+}
+
+// list writes the items if at least one of them is not nil.
+func (w *dataWriter) list(name string, items []interface{}) {
+	for _, item := range items {
+		if item != nil {
+			w.data[name] = items
+			return
+		}
+	}
+}
+
+func writeStruct[T any, P interface {
+	*T
+	dataReadWriter
+}](w *dataWriter, name string, value *T) {
+	if value != nil {
+		if data := writeItem[T, P](value); len(data) > 0 {
+			w.data[name] = data
+		}
+	}
+}
+
+func writeStructs[T any, P interface {
+	*T
+	dataReadWriter
+}](w *dataWriter, name string, values []*T) {
+	items := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		if v != nil {
+			if data := writeItem[T, P](v); len(data) > 0 {
+				items = append(items, data)
+			}
+		}
+	}
+	w.list(name, items)
+}
+
+func writeItem[T any, P interface {
+	*T
+	dataReadWriter
+}](value *T) map[string]interface{} {
+	w := newDataWriter()
+	P(value).writeData(w)
+	return w.data
+}
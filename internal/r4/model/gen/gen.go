@@ -0,0 +1,315 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package gen generates the Go structs of the FHIR types and the code that
+// converts them from and into the data of the dynamic model.
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/healthiop/hi/internal/common"
+	"go/format"
+	"sort"
+	"strings"
+)
+
+// FileName is the name of the file that contains the generated code.
+const FileName = "model_gen.go"
+
+const license = `// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+`
+
+// acronyms contains the suffixes of property names that are written in
+// upper case in Go names.
+var acronyms = []string{"Id", "Oid", "Uri", "Url", "Uuid"}
+
+type generation struct {
+	typeDefContainer *common.TypeDefContainer
+	goNames          map[string]string
+	buf              bytes.Buffer
+}
+
+type field struct {
+	propDef *common.PropDef
+	goName  string
+	goType  string
+	read    string
+	write   string
+}
+
+// Generate returns the formatted Go code of the structs of all struct types
+// of the type definition container.
+func Generate(typeDefContainer *common.TypeDefContainer) ([]byte, error) {
+	g := &generation{typeDefContainer: typeDefContainer, goNames: make(map[string]string)}
+
+	var typeDefs []common.StructTypeDefAccessor
+	internalNames := make(map[string]string)
+	for _, name := range typeDefContainer.TypeNames() {
+		typeDef, ok := typeDefContainer.TypeByName(name).(common.StructTypeDefAccessor)
+		if !ok {
+			continue
+		}
+		goName := strings.ReplaceAll(typeDef.InternalName(), "_", "")
+		if other, found := internalNames[goName]; found {
+			return nil, fmt.Errorf("types %s and %s have the same Go name %s", other, typeDef.InternalName(), goName)
+		}
+		internalNames[goName] = typeDef.InternalName()
+		g.goNames[typeDef.InternalName()] = goName
+		typeDefs = append(typeDefs, typeDef)
+	}
+	sort.Slice(typeDefs, func(i, j int) bool {
+		return g.goNames[typeDefs[i].InternalName()] < g.goNames[typeDefs[j].InternalName()]
+	})
+
+	g.header()
+	for _, typeDef := range typeDefs {
+		if err := g.structType(typeDef); err != nil {
+			return nil, err
+		}
+	}
+	g.newResource(typeDefs)
+
+	b, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("generated code cannot be formatted: %w", err)
+	}
+	return b, nil
+}
+
+func (g *generation) header() {
+	g.printf("%s\n", license)
+	g.printf("// Code generated by generate.go from the FHIR %s type definitions. DO NOT EDIT.\n\n",
+		g.typeDefContainer.SymbolicVersion())
+	g.printf("package model\n\n")
+	g.printf("import \"encoding/json\"\n\n")
+}
+
+func (g *generation) structType(typeDef common.StructTypeDefAccessor) error {
+	goName := g.goNames[typeDef.InternalName()]
+	fields, err := g.fields(typeDef)
+	if err != nil {
+		return err
+	}
+
+	var baseGoName string
+	if base, ok := typeDef.Base().(common.StructTypeDefAccessor); ok {
+		baseGoName = g.goNames[base.InternalName()]
+	}
+	names := map[string]bool{baseGoName: true}
+	for _, f := range fields {
+		for _, name := range []string{f.goName, f.goName + "Element"} {
+			if names[name] {
+				return fmt.Errorf("type %s contains Go field %s more than once", typeDef.InternalName(), name)
+			}
+		}
+		names[f.goName] = true
+		if _, ok := f.propDef.Type().(common.PrimitiveTypeDefAccessor); ok {
+			names[f.goName+"Element"] = true
+		}
+	}
+
+	g.printf("// %s is the FHIR %s type %s.\n", goName, g.typeDefContainer.SymbolicVersion(), typeDef.InternalName())
+	g.printf("type %s struct {\n", goName)
+	if baseGoName != "" {
+		g.printf("%s\n", baseGoName)
+	}
+	for _, f := range fields {
+		g.printf("%s %s\n", f.goName, f.goType)
+		if _, ok := f.propDef.Type().(common.PrimitiveTypeDefAccessor); ok {
+			if f.propDef.Array() {
+				g.printf("%sElement []*Element\n", f.goName)
+			} else {
+				g.printf("%sElement *Element\n", f.goName)
+			}
+		}
+	}
+	g.printf("}\n\n")
+
+	if typeDef.TypeKind() == common.ResourceTypeKind && !abstractResourceType(typeDef) {
+		g.printf("func (o *%s) ResourceType() string {\nreturn %q\n}\n\n", goName, typeDef.Name())
+	}
+
+	g.printf("func (o *%s) readData(r *dataReader) {\n", goName)
+	if baseGoName != "" {
+		g.printf("o.%s.readData(r)\n", baseGoName)
+	}
+	for _, f := range fields {
+		g.printf("%s\n", f.read)
+	}
+	g.printf("}\n\n")
+
+	g.printf("func (o *%s) writeData(w *dataWriter) {\n", goName)
+	if baseGoName != "" {
+		g.printf("o.%s.writeData(w)\n", baseGoName)
+	}
+	for _, f := range fields {
+		g.printf("%s\n", f.write)
+	}
+	g.printf("}\n\n")
+	return nil
+}
+
+// fields returns the fields of the properties that are not defined by the
+// base type.
+func (g *generation) fields(typeDef common.StructTypeDefAccessor) ([]field, error) {
+	inherited := make(map[string]bool)
+	if base, ok := typeDef.Base().(common.StructTypeDefAccessor); ok {
+		for _, p := range base.Props() {
+			inherited[p.Name()] = true
+		}
+	}
+
+	var fields []field
+	for _, p := range typeDef.Props() {
+		if inherited[p.Name()] {
+			continue
+		}
+		f := field{propDef: p, goName: goFieldName(p.Name())}
+		name := p.Name()
+		switch t := p.Type().(type) {
+		case common.PrimitiveTypeDefAccessor:
+			kind, goType, err := primitiveKind(t)
+			if err != nil {
+				return nil, fmt.Errorf("property %s of type %s: %w", name, typeDef.InternalName(), err)
+			}
+			if p.Array() {
+				f.goType = "[]" + strings.TrimPrefix(goType, "*")
+				if goType[0] == '*' {
+					f.goType = "[]" + goType
+				}
+				kind += "s"
+			} else {
+				f.goType = goType
+			}
+			f.read = fmt.Sprintf("r.%s(%q, &o.%s, &o.%sElement)", kind, name, f.goName, f.goName)
+			f.write = fmt.Sprintf("w.%s(%q, o.%s, o.%sElement)", kind, name, f.goName, f.goName)
+		case common.StructTypeDefAccessor:
+			if t.TypeKind() == common.ResourceTypeKind {
+				if p.Array() {
+					f.goType = "[]AnyResource"
+					f.read = fmt.Sprintf("r.resources(%q, &o.%s)", name, f.goName)
+					f.write = fmt.Sprintf("w.resources(%q, o.%s)", name, f.goName)
+				} else {
+					f.goType = "AnyResource"
+					f.read = fmt.Sprintf("r.resource(%q, &o.%s)", name, f.goName)
+					f.write = fmt.Sprintf("w.resource(%q, o.%s)", name, f.goName)
+				}
+			} else if p.Array() {
+				f.goType = "[]*" + g.goNames[t.InternalName()]
+				f.read = fmt.Sprintf("readStructs(r, %q, &o.%s)", name, f.goName)
+				f.write = fmt.Sprintf("writeStructs(w, %q, o.%s)", name, f.goName)
+			} else {
+				f.goType = "*" + g.goNames[t.InternalName()]
+				f.read = fmt.Sprintf("readStruct(r, %q, &o.%s)", name, f.goName)
+				f.write = fmt.Sprintf("writeStruct(w, %q, o.%s)", name, f.goName)
+			}
+		default:
+			return nil, fmt.Errorf("property %s of type %s has unsupported type %T", name, typeDef.InternalName(), t)
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+func (g *generation) newResource(typeDefs []common.StructTypeDefAccessor) {
+	g.printf("// newResource returns a new struct of the resource type or nil if the\n")
+	g.printf("// resource type is undefined.\n")
+	g.printf("func newResource(resourceType string) AnyResource {\nswitch resourceType {\n")
+	for _, typeDef := range typeDefs {
+		if typeDef.TypeKind() == common.ResourceTypeKind && !abstractResourceType(typeDef) {
+			g.printf("case %q:\nreturn &%s{}\n", typeDef.Name(), g.goNames[typeDef.InternalName()])
+		}
+	}
+	g.printf("}\nreturn nil\n}\n")
+}
+
+func (g *generation) printf(format string, a ...interface{}) {
+	fmt.Fprintf(&g.buf, format, a...)
+}
+
+// primitiveKind returns the name of the functions that read and write
+// values of the primitive type and the Go type of its values.
+func primitiveKind(typeDef common.PrimitiveTypeDefAccessor) (string, string, error) {
+	switch typeDef.SimpleType() {
+	case common.StringSimpleType:
+		return "string", "string", nil
+	case common.BoolSimpleType:
+		return "bool", "*bool", nil
+	case common.NumberSimpleType:
+		for t := common.TypeDefAccessor(typeDef); t != nil; t = t.Base() {
+			switch t.Name() {
+			case common.DecimalTypeName:
+				return "decimal", "json.Number", nil
+			case common.IntegerTypeName:
+				return "integer", "*int64", nil
+			}
+		}
+	}
+	return "", "", fmt.Errorf("unsupported primitive type %s", typeDef.Name())
+}
+
+func abstractResourceType(typeDef common.StructTypeDefAccessor) bool {
+	return typeDef.Name() == common.ResourceTypeName || typeDef.Name() == common.DomainResourceTypeName
+}
+
+func goFieldName(name string) string {
+	goName := strings.ToUpper(name[:1]) + name[1:]
+	for _, a := range acronyms {
+		if strings.HasSuffix(goName, a) {
+			return strings.TrimSuffix(goName, a) + strings.ToUpper(a)
+		}
+	}
+	return goName
+}
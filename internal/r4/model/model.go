@@ -0,0 +1,124 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package model contains Go structs for the FHIR R4 types that are generated
+// from the type definitions of package r4. The structs can be converted from
+// and into the data of the dynamic model without loss.
+//
+// Primitive values are stored in plain fields. Values of string based types
+// are stored as string, where an empty string represents an absent value.
+// Decimals are stored as json.Number to keep their exact representation,
+// integers as *int64 and booleans as *bool. The id and the extensions of a
+// primitive value are stored in the corresponding field with suffix Element.
+// Each type of a choice is stored in a field of its own (e.g. ValueString
+// and ValueQuantity).
+package model
+
+//go:generate go run generate.go
+
+import (
+	"fmt"
+	"github.com/healthiop/hi"
+	"github.com/healthiop/hi/internal/dynamic"
+	"github.com/healthiop/hi/internal/r4"
+)
+
+// AnyResource is implemented by the structs of all resource types.
+type AnyResource interface {
+	dataReadWriter
+	ResourceType() string
+}
+
+// FromResource converts the resource of the dynamic model into the struct
+// of its resource type.
+func FromResource(resource hi.DynResourceAccessor) (AnyResource, error) {
+	if resource.VersionString() != r4.TypeDefContainer().VersionString() {
+		return nil, fmt.Errorf("resource has FHIR version %s instead of %s",
+			resource.VersionString(), r4.TypeDefContainer().VersionString())
+	}
+	dataRetriever, ok := resource.(dynamic.DynDataRetriever)
+	if !ok {
+		return nil, fmt.Errorf("resource data cannot be accessed: %T", resource)
+	}
+	return FromData(dataRetriever.Data())
+}
+
+// FromData converts the resource data into the struct of its resource type.
+// An error is returned if the data contains properties that are not defined
+// by the resource type or values that do not match the types of the
+// properties.
+func FromData(data map[string]interface{}) (AnyResource, error) {
+	return resourceFromData(data, "")
+}
+
+// ToResource converts the struct into a resource of the dynamic model.
+func ToResource(resource AnyResource) (hi.DynResourceAccessor, error) {
+	return dynamic.NewDynResource(r4.TypeDefContainer(), ToData(resource), nil)
+}
+
+// ToData converts the struct into resource data. Decimals and integers are
+// returned as json.Number.
+func ToData(resource AnyResource) map[string]interface{} {
+	return resourceToData(resource)
+}
+
+func resourceFromData(data map[string]interface{}, path string) (AnyResource, error) {
+	resourceType, ok := data[resourceTypePropName].(string)
+	if !ok {
+		return nil, fmt.Errorf("%sdata contains no resource type", pathPrefix(path))
+	}
+	resource := newResource(resourceType)
+	if resource == nil {
+		return nil, fmt.Errorf("%sresource type undefined: %s", pathPrefix(path), resourceType)
+	}
+
+	if path == "" {
+		path = resourceType
+	}
+	r := newDataReader(data, path)
+	r.value(resourceTypePropName)
+	resource.readData(r)
+	if err := r.complete(); err != nil {
+		return nil, err
+	}
+	return resource, nil
+}
+
+func resourceToData(resource AnyResource) map[string]interface{} {
+	w := newDataWriter()
+	w.data[resourceTypePropName] = resource.ResourceType()
+	resource.writeData(w)
+	return w.data
+}
+
+func pathPrefix(path string) string {
+	if path == "" {
+		return ""
+	}
+	return path + ": "
+}
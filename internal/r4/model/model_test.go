@@ -0,0 +1,276 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/healthiop/hi/internal/dynamic"
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/healthiop/hi/internal/r4/model/gen"
+	"github.com/healthiop/hi/internal/serialization"
+	"github.com/healthiop/hi/internal/stu3"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"testing"
+)
+
+func TestGenerated(t *testing.T) {
+	expected, err := gen.Generate(r4.TypeDefContainer())
+	if assert.NoError(t, err) {
+		assert.Equal(t, string(expected), string(readTestFile(t, gen.FileName)),
+			"generated code is not up to date, run go generate")
+	}
+}
+
+func TestFromData(t *testing.T) {
+	resource, err := FromData(readTestData(t, "testdata/patient.json"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	if !assert.IsType(t, &Patient{}, resource) {
+		return
+	}
+	p := resource.(*Patient)
+	assert.Equal(t, "Patient", p.ResourceType())
+	assert.Equal(t, "example", p.ID)
+	assert.Equal(t, "2", p.Meta.VersionID)
+	if assert.Len(t, p.Contained, 1) && assert.IsType(t, &Organization{}, p.Contained[0]) {
+		assert.Equal(t, "Example Organization", p.Contained[0].(*Organization).Name)
+	}
+	if assert.Len(t, p.Extension, 1) {
+		assert.Equal(t, json.Number("72.50"), p.Extension[0].ValueQuantity.Value)
+	}
+	if assert.NotNil(t, p.Active) {
+		assert.True(t, *p.Active)
+	}
+	if assert.Len(t, p.Name, 1) {
+		assert.Equal(t, []string{"Jane", "", "Mary"}, p.Name[0].Given)
+		if assert.Len(t, p.Name[0].GivenElement, 2) {
+			assert.Nil(t, p.Name[0].GivenElement[0])
+			assert.Equal(t, "g2", p.Name[0].GivenElement[1].ID)
+		}
+	}
+	assert.Equal(t, "female", p.Gender)
+	if assert.NotNil(t, p.GenderElement) && assert.Len(t, p.GenderElement.Extension, 1) {
+		assert.Equal(t, "self-reported", p.GenderElement.Extension[0].ValueString)
+	}
+	assert.Equal(t, "", p.BirthDate)
+	assert.NotNil(t, p.BirthDateElement)
+	if assert.NotNil(t, p.DeceasedBoolean) {
+		assert.False(t, *p.DeceasedBoolean)
+	}
+	if assert.NotNil(t, p.MultipleBirthInteger) {
+		assert.Equal(t, int64(2), *p.MultipleBirthInteger)
+	}
+	if assert.Len(t, p.Contact, 1) {
+		assert.Equal(t, "Doe", p.Contact[0].Name.Family)
+	}
+	assert.Equal(t, "#org1", p.ManagingOrganization.Reference)
+}
+
+func TestFromDataToData(t *testing.T) {
+	c := serialization.NewJSONCanonicalizer(r4.TypeDefContainer(), serialization.JSONCanonicalization)
+	for _, name := range []string{"patient", "bundle"} {
+		t.Run(name, func(t *testing.T) {
+			data := readTestData(t, "testdata/"+name+".json")
+			expected, err := c.CanonicalizeData(data)
+			if !assert.NoError(t, err) {
+				return
+			}
+			resource, err := FromData(data)
+			if !assert.NoError(t, err) {
+				return
+			}
+			b, err := c.CanonicalizeData(ToData(resource))
+			if assert.NoError(t, err) {
+				assert.Equal(t, string(expected), string(b))
+			}
+		})
+	}
+}
+
+func TestFromDataChoice(t *testing.T) {
+	resource, err := FromData(readTestData(t, "testdata/bundle.json"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	b := resource.(*Bundle)
+	if !assert.Len(t, b.Entry, 2) {
+		return
+	}
+	o1 := b.Entry[0].Resource.(*Observation)
+	assert.Equal(t, json.Number("1.50"), o1.ValueQuantity.Value)
+	assert.Nil(t, o1.ValueSampledData)
+	if assert.Len(t, o1.Component, 1) {
+		assert.Equal(t, "at rest", o1.Component[0].ValueString)
+	}
+	o2 := b.Entry[1].Resource.(*Observation)
+	assert.Nil(t, o2.ValueQuantity)
+	if assert.NotNil(t, o2.ValueSampledData) && assert.NotNil(t, o2.ValueSampledData.Dimensions) {
+		assert.Equal(t, int64(1), *o2.ValueSampledData.Dimensions)
+	}
+}
+
+func TestToData(t *testing.T) {
+	active := true
+	p := &Patient{
+		Active: &active,
+		Name: []*HumanName{
+			{Family: "Doe", Given: []string{"Jane", ""}, GivenElement: []*Element{nil, {ID: "g2"}}},
+			nil,
+		},
+		Gender: "female",
+		GenderElement: &Element{Extension: []*Extension{
+			{URL: "http://example.com/gender-source", ValueString: "self-reported"},
+		}},
+		BirthDateElement: &Element{},
+	}
+	p.ID = "example"
+	p.Contained = []AnyResource{&Organization{Name: "Example Organization"}}
+
+	assert.Equal(t, map[string]interface{}{
+		"resourceType": "Patient",
+		"id":           "example",
+		"contained": []interface{}{
+			map[string]interface{}{"resourceType": "Organization", "name": "Example Organization"},
+		},
+		"active": true,
+		"name": []interface{}{
+			map[string]interface{}{
+				"family": "Doe",
+				"given":  []interface{}{"Jane", nil},
+				"_given": []interface{}{nil, map[string]interface{}{"id": "g2"}},
+			},
+		},
+		"gender": "female",
+		"_gender": map[string]interface{}{
+			"extension": []interface{}{
+				map[string]interface{}{"url": "http://example.com/gender-source", "valueString": "self-reported"},
+			},
+		},
+	}, ToData(p))
+}
+
+func TestFromResourceToResource(t *testing.T) {
+	data := readTestData(t, "testdata/patient.json")
+	r, err := dynamic.NewDynResource(r4.TypeDefContainer(), data, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	resource, err := FromResource(r)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "female", resource.(*Patient).Gender)
+
+	r, err = ToResource(resource)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Patient", r.TypeName())
+		assert.Equal(t, r4.TypeDefContainer().VersionString(), r.VersionString())
+		assert.Equal(t, ToData(resource), r.(dynamic.DynDataRetriever).Data())
+	}
+}
+
+func TestFromResourceVersion(t *testing.T) {
+	r, err := dynamic.NewDynResource(stu3.TypeDefContainer(),
+		map[string]interface{}{"resourceType": "Patient"}, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = FromResource(r)
+	assert.EqualError(t, err, "resource has FHIR version "+stu3.TypeDefContainer().VersionString()+
+		" instead of "+r4.TypeDefContainer().VersionString())
+}
+
+func TestFromDataInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data map[string]interface{}
+		err  string
+	}{
+		{"no resource type", map[string]interface{}{"id": "1"},
+			"data contains no resource type"},
+		{"unknown resource type", map[string]interface{}{"resourceType": "Test"},
+			"resource type undefined: Test"},
+		{"abstract resource type", map[string]interface{}{"resourceType": "DomainResource"},
+			"resource type undefined: DomainResource"},
+		{"unknown property", map[string]interface{}{"resourceType": "Patient", "test": "1"},
+			"Patient.test: property is not defined"},
+		{"unknown nested property", map[string]interface{}{"resourceType": "Patient",
+			"name": []interface{}{nil, map[string]interface{}{"test": "1"}}},
+			"Patient.name[1].test: property is not defined"},
+		{"element of struct", map[string]interface{}{"resourceType": "Patient", "_name": map[string]interface{}{}},
+			"Patient._name: property is not defined"},
+		{"string", map[string]interface{}{"resourceType": "Patient", "gender": true},
+			"Patient.gender: value must be a string: bool"},
+		{"boolean", map[string]interface{}{"resourceType": "Patient", "active": "true"},
+			"Patient.active: value must be a boolean: string"},
+		{"integer", map[string]interface{}{"resourceType": "Patient", "multipleBirthInteger": json.Number("1.5")},
+			"Patient.multipleBirthInteger: value must be an integer: 1.5"},
+		{"decimal", map[string]interface{}{"resourceType": "Observation",
+			"valueQuantity": map[string]interface{}{"value": "1.5"}},
+			"Observation.valueQuantity.value: value must be a decimal: 1.5"},
+		{"list", map[string]interface{}{"resourceType": "Patient", "name": map[string]interface{}{}},
+			"Patient.name: list property contains data with type map[string]interface {}"},
+		{"object", map[string]interface{}{"resourceType": "Patient", "maritalStatus": "M"},
+			"Patient.maritalStatus: value must be an object: string"},
+		{"contained", map[string]interface{}{"resourceType": "Patient",
+			"contained": []interface{}{map[string]interface{}{"id": "1"}}},
+			"Patient.contained[0]: data contains no resource type"},
+		{"contained property", map[string]interface{}{"resourceType": "Patient",
+			"contained": []interface{}{map[string]interface{}{"resourceType": "Organization", "test": "1"}}},
+			"Patient.contained[0].test: property is not defined"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := FromData(test.data)
+			assert.EqualError(t, err, test.err)
+		})
+	}
+}
+
+func readTestData(t *testing.T, fileName string) map[string]interface{} {
+	d := json.NewDecoder(bytes.NewReader(readTestFile(t, fileName)))
+	d.UseNumber()
+	var data map[string]interface{}
+	if err := d.Decode(&data); err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func readTestFile(t *testing.T, fileName string) []byte {
+	b, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
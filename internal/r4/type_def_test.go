@@ -112,6 +112,13 @@ func TestTypeDefContainerSingleton(t *testing.T) {
 	assert.Same(t, tsc, TypeDefContainer(), "typeDefContainer must be a singleton")
 }
 
+func TestNewTypeDefContainer(t *testing.T) {
+	tsc := NewTypeDefContainer()
+	assert.NotSame(t, TypeDefContainer(), tsc)
+	assert.NotSame(t, TypeDefContainer().TypeByName("Patient"), tsc.TypeByName("Patient"))
+	assert.Equal(t, TypeDefContainer().VersionString(), tsc.VersionString())
+}
+
 func TestTypeSpecSymbolicVersion(t *testing.T) {
 	tsc := TypeDefContainer()
 	assert.Equal(t, "R4", tsc.SymbolicVersion())
@@ -208,3 +215,108 @@ func TestTypeProps(t *testing.T) {
 		})
 	}
 }
+
+func TestTypeDefContainerLazy(t *testing.T) {
+	tsc := createTypeDefContainer()
+	assert.Len(t, tsc.TypeNames(), 682)
+	for _, name := range tsc.TypeNames() {
+		td := tsc.TypeByName(name)
+		if std, ok := td.(common.StructTypeDefAccessor); ok {
+			for _, p := range std.Props() {
+				assert.NotNil(t, p.Type(), "property %s.%s must have a type", name, p.Name())
+			}
+		} else if ptd, ok := td.(common.PrimitiveTypeDefAccessor); ok {
+			ptd.Pattern()
+		}
+	}
+}
+
+func TestTypeDefContainerConstraints(t *testing.T) {
+	tsc := TypeDefContainer()
+	keys := func(name string) []string {
+		var keys []string
+		for _, c := range tsc.MandatoryStructTypeByName(name).Constraints() {
+			keys = append(keys, c.Key())
+		}
+		return keys
+	}
+	assert.Equal(t, []string{"ele-1"}, keys("Element"))
+	assert.Equal(t, []string{"dom-2", "dom-3", "dom-4", "dom-5", "dom-6"}, keys("DomainResource"))
+	assert.Equal(t, []string{"Observation-1", "Observation-2"}, keys("Observation"))
+	assert.Equal(t, []string{"Patient_Contact-1"}, keys("Patient_Contact"))
+	assert.Equal(t, []string{"Organization-1", "Organization-2", "Organization-3"}, keys("Organization"))
+	assert.Empty(t, keys("Patient"))
+	assert.Equal(t, "`telecom`.all(where(use = 'home').empty())",
+		tsc.MandatoryStructTypeByName("Organization").Constraints()[1].Expression())
+}
+
+func TestTypePropsDefinitionOrder(t *testing.T) {
+	tsc := TypeDefContainer()
+	names := func(name string) []string {
+		var names []string
+		for _, p := range tsc.MandatoryStructTypeByName(name).Props() {
+			names = append(names, p.Name())
+		}
+		return names
+	}
+	assert.Equal(t, []string{"id", "extension", "use", "text", "family", "given", "prefix", "suffix", "period"},
+		names("HumanName"))
+	assert.Equal(t, []string{"id", "extension", "modifierExtension", "link", "fullUrl", "resource", "search", "request", "response"},
+		names("Bundle_Entry"))
+	assert.Equal(t, []string{"id", "extension", "url"}, names("Extension")[:3])
+	patient := names("Patient")
+	assert.Equal(t, []string{"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension",
+		"identifier", "active", "name", "telecom", "gender", "birthDate", "deceasedBoolean", "deceasedDateTime"}, patient[:16])
+	assert.Equal(t, "link", patient[len(patient)-1])
+}
+
+func TestRenamedTypeNamesDefined(t *testing.T) {
+	tsc := TypeDefContainer()
+	for name, renamed := range renamedTypeNames {
+		assert.Nil(t, tsc.TypeByName(name), "type must not be defined: %s", name)
+		for _, n := range renamed {
+			assert.NotNil(t, tsc.TypeByName(n), "type must be defined: %s", n)
+		}
+	}
+}
+
+// Benchmark results (ns/op, B/op, allocs/op) of the type definitions that
+// have been generated as Go source before (all type definitions are created
+// eagerly) and of the lazily loaded compact type definitions:
+//
+//	Benchmark                            Go source (before)       compact (after)
+//	CreateTypeDefContainer               3.1 ms 1709 KB 14691     0.5 ms  319 KB  5125
+//	CreateTypeDefContainerAccessPatient  3.1 ms 1709 KB 14691     0.6 ms  323 KB  5162
+//	CreateTypeDefContainerLoadAll        3.1 ms 1709 KB 14691     6.3 ms 2085 KB 19665
+//
+// The test binary of this package shrinks from 30.7 MB to 7.7 MB.
+
+func BenchmarkCreateTypeDefContainer(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		createTypeDefContainer()
+	}
+}
+
+func BenchmarkCreateTypeDefContainerAccessPatient(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		tsc := createTypeDefContainer()
+		tsc.MandatoryStructTypeByName("Patient").PropByName("gender")
+	}
+}
+
+func BenchmarkCreateTypeDefContainerLoadAll(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		tsc := createTypeDefContainer()
+		for _, name := range tsc.TypeNames() {
+			switch td := tsc.TypeByName(name).(type) {
+			case common.StructTypeDefAccessor:
+				td.Props()
+			case common.PrimitiveTypeDefAccessor:
+				td.Pattern()
+			}
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package r5
+
+import (
+	_ "embed"
+	"fmt"
+	"github.com/healthiop/hi/internal/common"
+	"sync"
+)
+
+//go:generate sh -c "cd ../typedefgen && go run . -version R5-preview -out ../r5/type_defs.bin"
+
+// compactTypeDefs contains the type definitions in the compact format of
+// common.MarshalCompactTypeDefs that are generated by go generate. The
+// properties of the types are decoded when they are accessed for the first
+// time.
+//
+//go:embed type_defs.bin
+var compactTypeDefs []byte
+
+var typeDefsContainerOnce sync.Once
+var cachedTypeDefContainer *common.TypeDefContainer
+
+// TypeDefContainer returns the shared type definition container of the R5
+// preview release 4.2.0 (symbolic version R5-preview), which is the latest R5
+// release of the protocol buffer definitions the type definitions are
+// generated from. It does not match resources of the R5 release 5.0.0.
+func TypeDefContainer() *common.TypeDefContainer {
+	typeDefsContainerOnce.Do(func() {
+		cachedTypeDefContainer = createTypeDefContainer()
+	})
+	return cachedTypeDefContainer
+}
+
+// NewTypeDefContainer returns a new type definition container that is not
+// shared. Custom types can be registered on it without affecting the shared
+// container that is returned by TypeDefContainer.
+func NewTypeDefContainer() *common.TypeDefContainer {
+	return createTypeDefContainer()
+}
+
+func createTypeDefContainer() *common.TypeDefContainer {
+	typeDefContainer, err := common.NewCompactTypeDefContainer(compactTypeDefs)
+	if err != nil {
+		panic(fmt.Sprintf("invalid embedded type definitions: %v", err))
+	}
+	typeDefContainer.InitRenamedTypeNames(renamedTypeNames)
+	return typeDefContainer
+}
@@ -0,0 +1,260 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package serialization
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/healthiop/hi"
+	"github.com/healthiop/hi/internal/common"
+	"github.com/healthiop/hi/internal/dynamic"
+	"io"
+)
+
+const (
+	bundleTypeName   = "Bundle"
+	entryPropName    = "entry"
+	requestPropName  = "request"
+	responsePropName = "response"
+	resourcePropName = "resource"
+)
+
+// BundleReader reads a Bundle in FHIR JSON format entry by entry. Only the
+// entry that is currently read is decoded into memory. The properties of the
+// Bundle that precede the entries are read when the reader is created. The
+// properties that follow the entries (e.g. the signature) are read when all
+// entries have been read.
+type BundleReader struct {
+	typeDefContainer *common.TypeDefContainer
+	entryTypeDef     common.StructTypeDefAccessor
+	decoder          *json.Decoder
+	data             map[string]interface{}
+	inEntries        bool
+	index            int
+	err              error
+}
+
+// BundleEntry contains an entry of a Bundle that has been read by the
+// BundleReader. Request, Response and Resource are nil if the entry does
+// not contain them.
+type BundleEntry struct {
+	Index    int
+	FullURL  string
+	Request  hi.DynElementAccessor
+	Response hi.DynElementAccessor
+	Resource hi.DynResourceAccessor
+	Element  hi.DynElementAccessor
+}
+
+// NewBundleReader returns a reader for the Bundle in FHIR JSON format and
+// reads the properties of the Bundle that precede the entries. The resource
+// type must precede the entries.
+func NewBundleReader(typeDefContainer *common.TypeDefContainer, r io.Reader) (*BundleReader, error) {
+	bundleTypeDef := typeDefContainer.MandatoryStructTypeByName(bundleTypeName)
+	reader := &BundleReader{
+		typeDefContainer: typeDefContainer,
+		entryTypeDef:     bundleTypeDef.PropByName(entryPropName).Type().(common.StructTypeDefAccessor),
+		decoder:          json.NewDecoder(r),
+		data:             make(map[string]interface{}),
+	}
+	reader.decoder.UseNumber()
+
+	if err := reader.delim('{'); err != nil {
+		return nil, err
+	}
+	if err := reader.readProps(); err != nil {
+		return nil, err
+	}
+	if !reader.inEntries {
+		if err := reader.end(); err != nil {
+			return nil, err
+		}
+	}
+	return reader, nil
+}
+
+func (r *BundleReader) TypeDefContainer() *common.TypeDefContainer {
+	return r.typeDefContainer
+}
+
+// Bundle returns the Bundle without its entries. Before all entries have
+// been read, the Bundle contains only the properties that precede the
+// entries.
+func (r *BundleReader) Bundle() (hi.DynResourceAccessor, error) {
+	return dynamic.NewDynResource(r.typeDefContainer, r.data, nil)
+}
+
+// Next returns the next entry of the Bundle. When all entries have been
+// read, the remaining properties of the Bundle are read and io.EOF is
+// returned. Errors terminate the reading and are returned by all further
+// invocations.
+func (r *BundleReader) Next() (*BundleEntry, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	entry, err := r.next()
+	if err != nil {
+		r.err = err
+		return nil, err
+	}
+	return entry, nil
+}
+
+func (r *BundleReader) next() (*BundleEntry, error) {
+	if !r.inEntries {
+		return nil, io.EOF
+	}
+	if !r.decoder.More() {
+		if err := r.delim(']'); err != nil {
+			return nil, err
+		}
+		r.inEntries = false
+		if err := r.readProps(); err != nil {
+			return nil, err
+		}
+		if r.inEntries {
+			return nil, fmt.Errorf("Bundle contains property %s more than once", entryPropName)
+		}
+		if err := r.end(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+
+	index := r.index
+	r.index++
+	var data map[string]interface{}
+	if err := r.decoder.Decode(&data); err != nil {
+		return nil, fmt.Errorf("Bundle.entry[%d]: %w", index, err)
+	}
+	entry, err := r.entry(index, data)
+	if err != nil {
+		return nil, fmt.Errorf("Bundle.entry[%d]: %w", index, err)
+	}
+	return entry, nil
+}
+
+func (r *BundleReader) entry(index int, data map[string]interface{}) (*BundleEntry, error) {
+	element, err := dynamic.NewDynElement(r.typeDefContainer, r.entryTypeDef, data, nil)
+	if err != nil {
+		return nil, err
+	}
+	entry := &BundleEntry{Index: index, Element: element}
+	if entry.FullURL, err = element.StringPropValue(fullURLPropName); err != nil {
+		return nil, err
+	}
+	if entry.Request, err = element.ElementProp(requestPropName); err != nil {
+		return nil, err
+	}
+	if entry.Response, err = element.ElementProp(responsePropName); err != nil {
+		return nil, err
+	}
+	if resourceData, ok := data[resourcePropName].(map[string]interface{}); ok {
+		if entry.Resource, err = dynamic.NewDynResource(r.typeDefContainer, resourceData, element); err != nil {
+			return nil, err
+		}
+	} else if data[resourcePropName] != nil {
+		return nil, fmt.Errorf("resource is not a JSON object")
+	}
+	return entry, nil
+}
+
+// readProps reads the properties of the Bundle until the entries start or
+// until the end of the Bundle has been reached.
+func (r *BundleReader) readProps() error {
+	for r.decoder.More() {
+		t, err := r.decoder.Token()
+		if err != nil {
+			return err
+		}
+		name, ok := t.(string)
+		if !ok {
+			return fmt.Errorf("invalid property name: %v", t)
+		}
+
+		if name == entryPropName {
+			if err := r.checkResourceType(); err != nil {
+				return err
+			}
+			if err := r.delim('['); err != nil {
+				return err
+			}
+			r.inEntries = true
+			return nil
+		}
+
+		var value interface{}
+		if err := r.decoder.Decode(&value); err != nil {
+			return err
+		}
+		r.data[name] = value
+	}
+	return nil
+}
+
+// end reads the end of the Bundle and checks the resource type.
+func (r *BundleReader) end() error {
+	if err := r.delim('}'); err != nil {
+		return err
+	}
+	if err := r.checkResourceType(); err != nil {
+		return err
+	}
+	if _, err := r.decoder.Token(); err != io.EOF {
+		return fmt.Errorf("unexpected data after Bundle")
+	}
+	return nil
+}
+
+// checkResourceType checks that the properties that have been read contain
+// the resource type Bundle.
+func (r *BundleReader) checkResourceType() error {
+	resourceType, ok := r.data[dynamic.ResourceTypePropName]
+	if !ok {
+		return fmt.Errorf("data contains no resource type before the entries")
+	}
+	if resourceType != bundleTypeName {
+		return fmt.Errorf("resource is not a Bundle: %v", resourceType)
+	}
+	return nil
+}
+
+func (r *BundleReader) delim(expected json.Delim) error {
+	t, err := r.decoder.Token()
+	if err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	if t != expected {
+		return fmt.Errorf("expected %s instead of %v", expected, t)
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package serialization
+
+import (
+	"bytes"
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestBundleReader(t *testing.T) {
+	r, err := NewBundleReader(r4.TypeDefContainer(), bytes.NewReader(readTestFile(t, "bundle")))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Same(t, r4.TypeDefContainer(), r.TypeDefContainer())
+
+	bundle, err := r.Bundle()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Bundle", bundle.TypeName())
+		bundleType, _ := bundle.StringPropValue("type")
+		assert.Equal(t, "collection", bundleType)
+		entries, err := bundle.ListProp("entry")
+		assert.NoError(t, err)
+		assert.Nil(t, entries)
+	}
+
+	entry, err := r.Next()
+	if assert.NoError(t, err) {
+		assert.Equal(t, 0, entry.Index)
+		assert.Equal(t, "http://example.com/fhir/Patient/example", entry.FullURL)
+		assert.Nil(t, entry.Request)
+		assert.Nil(t, entry.Response)
+		if assert.NotNil(t, entry.Resource) {
+			assert.Equal(t, "Patient", entry.Resource.TypeName())
+			assert.Same(t, entry.Element, entry.Resource.Parent())
+		}
+	}
+
+	entry, err = r.Next()
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1, entry.Index)
+		assert.Equal(t, "", entry.FullURL)
+		if assert.NotNil(t, entry.Resource) {
+			assert.Equal(t, "Observation", entry.Resource.TypeName())
+			value, err := entry.Resource.ElementProp("valueQuantity")
+			if assert.NoError(t, err) {
+				number, _ := value.NumberPropValue("value")
+				assert.Equal(t, 67.5, number)
+			}
+		}
+	}
+
+	_, err = r.Next()
+	assert.Equal(t, io.EOF, err)
+	_, err = r.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestBundleReaderTransactionResponse(t *testing.T) {
+	r, err := NewBundleReader(r4.TypeDefContainer(), strings.NewReader(`{
+  "resourceType": "Bundle",
+  "type": "transaction-response",
+  "entry": [
+    {
+      "response": {"status": "201 Created", "location": "Patient/p1/_history/1"}
+    },
+    {
+      "fullUrl": "urn:uuid:61ebe359-bfdc-4613-8bf2-c5e300945f0a",
+      "request": {"method": "POST", "url": "Patient"},
+      "resource": {"resourceType": "Patient", "active": true}
+    }
+  ],
+  "signature": {"data": "VGVzdA=="}
+}`))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	entry, err := r.Next()
+	if assert.NoError(t, err) {
+		assert.Nil(t, entry.Request)
+		assert.Nil(t, entry.Resource)
+		if assert.NotNil(t, entry.Response) {
+			status, _ := entry.Response.StringPropValue("status")
+			assert.Equal(t, "201 Created", status)
+		}
+	}
+
+	entry, err = r.Next()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "urn:uuid:61ebe359-bfdc-4613-8bf2-c5e300945f0a", entry.FullURL)
+		if assert.NotNil(t, entry.Request) {
+			method, _ := entry.Request.StringPropValue("method")
+			assert.Equal(t, "POST", method)
+		}
+		if assert.NotNil(t, entry.Resource) {
+			active, _ := entry.Resource.BoolPropValue("active")
+			assert.True(t, active)
+		}
+	}
+
+	bundle, err := r.Bundle()
+	if assert.NoError(t, err) {
+		signature, err := bundle.ElementProp("signature")
+		assert.NoError(t, err)
+		assert.Nil(t, signature)
+	}
+
+	_, err = r.Next()
+	assert.Equal(t, io.EOF, err)
+	bundle, err = r.Bundle()
+	if assert.NoError(t, err) {
+		signature, err := bundle.ElementProp("signature")
+		if assert.NoError(t, err) && assert.NotNil(t, signature) {
+			data, _ := signature.StringPropValue("data")
+			assert.Equal(t, "VGVzdA==", data)
+		}
+	}
+}
+
+func TestBundleReaderWithoutEntries(t *testing.T) {
+	r, err := NewBundleReader(r4.TypeDefContainer(), strings.NewReader(`{"resourceType":"Bundle","type":"searchset","total":0}`))
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = r.Next()
+	assert.Equal(t, io.EOF, err)
+	bundle, err := r.Bundle()
+	if assert.NoError(t, err) {
+		total, _ := bundle.NumberPropValue("total")
+		assert.Equal(t, 0.0, total)
+	}
+}
+
+func TestBundleReaderInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{"no object", `[]`, "expected { instead of ["},
+		{"empty", ``, "unexpected EOF"},
+		{"no bundle", `{"resourceType":"Patient"}`, "resource is not a Bundle: Patient"},
+		{"no bundle entries", `{"resourceType":"Patient","entry":[]}`, "resource is not a Bundle: Patient"},
+		{"resource type after entries", `{"entry":[],"resourceType":"Bundle"}`, "data contains no resource type before the entries"},
+		{"entries no array", `{"resourceType":"Bundle","entry":{}}`, "expected [ instead of {"},
+		{"trailing data", `{"resourceType":"Bundle"} {}`, "unexpected data after Bundle"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewBundleReader(r4.TypeDefContainer(), strings.NewReader(tt.input))
+			if assert.Error(t, err) {
+				assert.Equal(t, tt.err, err.Error())
+			}
+		})
+	}
+}
+
+func TestBundleReaderInvalidEntry(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{"resource type", `{"resourceType":"Bundle","entry":[{},{"resource":{"resourceType":"Test"}}]}`,
+			"Bundle.entry[1]: resource type undefined: Test"},
+		{"resource no object", `{"resourceType":"Bundle","entry":[{"resource":"Patient"}]}`,
+			"Bundle.entry[0]: resource is not a JSON object"},
+		{"entry no object", `{"resourceType":"Bundle","entry":[1]}`,
+			"Bundle.entry[0]: json: cannot unmarshal number into Go value of type map[string]interface {}"},
+		{"full URL", `{"resourceType":"Bundle","entry":[{"fullUrl":1}]}`,
+			"Bundle.entry[0]: expected string value: json.Number"},
+		{"entries twice", `{"resourceType":"Bundle","entry":[],"entry":[]}`,
+			"Bundle contains property entry more than once"},
+		{"unterminated", `{"resourceType":"Bundle","entry":[{}`, "Bundle.entry[1]: unexpected end of JSON input"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewBundleReader(r4.TypeDefContainer(), strings.NewReader(tt.input))
+			if !assert.NoError(t, err) {
+				return
+			}
+			for err == nil {
+				_, err = r.Next()
+			}
+			if assert.Error(t, err) {
+				assert.Equal(t, tt.err, err.Error())
+			}
+			_, next := r.Next()
+			assert.Equal(t, err, next)
+		})
+	}
+}
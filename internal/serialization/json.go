@@ -0,0 +1,442 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package serialization
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/healthiop/hi"
+	"github.com/healthiop/hi/internal/common"
+	"github.com/healthiop/hi/internal/dynamic"
+	"io"
+	"strconv"
+)
+
+const jsonIndent = "  "
+
+// JSONSerializer writes resources in FHIR JSON format. The resource type is
+// written first and the remaining properties are written in the order of
+// the type definitions. Primitive values and their elements are written as
+// property pairs (e.g. gender and _gender) and lists of primitive values are
+// aligned with null. Empty objects, arrays and strings are not written.
+type JSONSerializer struct {
+	typeDefContainer *common.TypeDefContainer
+	pretty           bool
+}
+
+// jsonObject contains the members of a JSON object in output order.
+type jsonObject []jsonMember
+
+type jsonMember struct {
+	name  string
+	value interface{}
+}
+
+func NewJSONSerializer(typeDefContainer *common.TypeDefContainer, pretty bool) *JSONSerializer {
+	return &JSONSerializer{typeDefContainer, pretty}
+}
+
+func (s *JSONSerializer) TypeDefContainer() *common.TypeDefContainer {
+	return s.typeDefContainer
+}
+
+func (s *JSONSerializer) Pretty() bool {
+	return s.pretty
+}
+
+// Serialize returns the resource in FHIR JSON format. The resource must have
+// been created for the FHIR version of the serializer.
+func (s *JSONSerializer) Serialize(resource hi.DynResourceAccessor) ([]byte, error) {
+	data, err := resourceData(s.typeDefContainer, resource)
+	if err != nil {
+		return nil, err
+	}
+	return s.SerializeData(data)
+}
+
+// SerializeData returns the resource data in FHIR JSON format. An error is
+// returned if the data contains properties that are not defined or values
+// that do not match the type definitions.
+func (s *JSONSerializer) SerializeData(data map[string]interface{}) ([]byte, error) {
+	o, err := newJSONNormalizer(s.typeDefContainer).resource(data, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	w := &jsonWriter{buf: &buf, pretty: s.pretty}
+	w.value(o, 0)
+	if s.pretty {
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+// Write writes the resource in FHIR JSON format to the writer.
+func (s *JSONSerializer) Write(w io.Writer, resource hi.DynResourceAccessor) error {
+	b, err := s.Serialize(resource)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+func resourceData(typeDefContainer *common.TypeDefContainer, resource hi.DynResourceAccessor) (map[string]interface{}, error) {
+	if resource.VersionString() != typeDefContainer.VersionString() {
+		return nil, fmt.Errorf("resource has FHIR version %s instead of %s",
+			resource.VersionString(), typeDefContainer.VersionString())
+	}
+	dataRetriever, ok := resource.(dynamic.DynDataRetriever)
+	if !ok {
+		return nil, fmt.Errorf("resource data cannot be accessed: %T", resource)
+	}
+	return dataRetriever.Data(), nil
+}
+
+// jsonNormalizer converts resource data into ordered JSON objects. Values
+// are checked against the type definitions and empty values are removed.
+type jsonNormalizer struct {
+	typeDefContainer *common.TypeDefContainer
+}
+
+func newJSONNormalizer(typeDefContainer *common.TypeDefContainer) *jsonNormalizer {
+	return &jsonNormalizer{typeDefContainer}
+}
+
+func (n *jsonNormalizer) resource(data map[string]interface{}, expected common.TypeDefAccessor, path string) (jsonObject, error) {
+	resourceType, ok := data[dynamic.ResourceTypePropName].(string)
+	if !ok {
+		return nil, fmt.Errorf("%s: resource contains no resource type", locationName(path, "resource"))
+	}
+	typeDef, ok := n.typeDefContainer.TypeByName(resourceType).(common.StructTypeDefAccessor)
+	if !ok || typeDef.TypeKind() != common.ResourceTypeKind {
+		return nil, fmt.Errorf("%s: resource type undefined in FHIR %s: %s",
+			locationName(path, "resource"), n.typeDefContainer.SymbolicVersion(), resourceType)
+	}
+	if expected != nil && !typeDef.ExtendsTypeName(expected.InternalName()) {
+		return nil, fmt.Errorf("%s: resource type %s is not allowed, expected %s",
+			path, resourceType, expected.Name())
+	}
+
+	if path == "" {
+		path = resourceType
+	}
+	o, err := n.structData(typeDef, data, path)
+	if err != nil {
+		return nil, err
+	}
+	return append(jsonObject{{dynamic.ResourceTypePropName, resourceType}}, o...), nil
+}
+
+// structData returns the members of the struct data in the order of the
+// property definitions. The resource type is not included.
+func (n *jsonNormalizer) structData(typeDef common.StructTypeDefAccessor, data map[string]interface{}, path string) (jsonObject, error) {
+	for name := range data {
+		if name == dynamic.ResourceTypePropName && typeDef.TypeKind() == common.ResourceTypeKind {
+			continue
+		}
+		propName := name
+		if len(name) > 1 && name[0] == '_' {
+			propName = name[1:]
+		}
+		propDef := typeDef.PropByName(propName)
+		if propDef == nil {
+			return nil, fmt.Errorf("%s: property %s is not defined by type %s",
+				path, propName, typeDef.InternalName())
+		}
+		if propName != name && propDef.Type().TypeKind() != common.PrimitiveTypeKind {
+			return nil, fmt.Errorf("%s: property %s is not primitive and cannot have an element %s",
+				path, propName, name)
+		}
+	}
+
+	var o jsonObject
+	for _, propDef := range typeDef.Props() {
+		name := propDef.Name()
+		location := path + "." + name
+		if propDef.Type().TypeKind() == common.PrimitiveTypeKind {
+			value, element, err := n.primitiveProp(propDef, data[name], data["_"+name], location)
+			if err != nil {
+				return nil, err
+			}
+			if value != nil {
+				o = append(o, jsonMember{name, value})
+			}
+			if element != nil {
+				o = append(o, jsonMember{"_" + name, element})
+			}
+			continue
+		}
+
+		value, err := n.prop(propDef, data[name], location)
+		if err != nil {
+			return nil, err
+		}
+		if value != nil {
+			o = append(o, jsonMember{name, value})
+		}
+	}
+	return o, nil
+}
+
+func (n *jsonNormalizer) prop(propDef *common.PropDef, value interface{}, path string) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if !propDef.Array() {
+		return n.structValue(propDef.Type().(common.StructTypeDefAccessor), value, path)
+	}
+
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: value must be an array but is %T", path, value)
+	}
+	var result []interface{}
+	for i, item := range items {
+		v, err := n.structValue(propDef.Type().(common.StructTypeDefAccessor), item, fmt.Sprintf("%s[%d]", path, i))
+		if err != nil {
+			return nil, err
+		}
+		if v != nil {
+			result = append(result, v)
+		}
+	}
+	if len(result) == 0 {
+		return nil, nil
+	}
+	return result, nil
+}
+
+func (n *jsonNormalizer) structValue(typeDef common.StructTypeDefAccessor, value interface{}, path string) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	data, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: value must be an object but is %T", path, value)
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	if typeDef.TypeKind() == common.ResourceTypeKind {
+		return n.resource(data, typeDef, path)
+	}
+
+	o, err := n.structData(typeDef, data, path)
+	if err != nil || len(o) == 0 {
+		return nil, err
+	}
+	return o, nil
+}
+
+// primitiveProp returns the value and the element of a primitive property.
+// Lists of values and elements are aligned with null. Items without value
+// and element are removed.
+func (n *jsonNormalizer) primitiveProp(propDef *common.PropDef, value interface{}, element interface{}, path string) (interface{}, interface{}, error) {
+	typeDef := propDef.Type().(common.PrimitiveTypeDefAccessor)
+	if !propDef.Array() {
+		v, err := primitiveValue(typeDef, value, path)
+		if err != nil {
+			return nil, nil, err
+		}
+		e, err := n.structValue(n.typeDefContainer.ElementType(), element, path)
+		if err != nil {
+			return nil, nil, err
+		}
+		return v, e, nil
+	}
+
+	var values, elements []interface{}
+	var ok bool
+	if value != nil {
+		if values, ok = value.([]interface{}); !ok {
+			return nil, nil, fmt.Errorf("%s: value must be an array but is %T", path, value)
+		}
+	}
+	if element != nil {
+		if elements, ok = element.([]interface{}); !ok {
+			return nil, nil, fmt.Errorf("%s: element must be an array but is %T", path, element)
+		}
+	}
+
+	count := len(values)
+	if count < len(elements) {
+		count = len(elements)
+	}
+	var resultValues, resultElements []interface{}
+	var valueFound, elementFound bool
+	for i := 0; i < count; i++ {
+		location := fmt.Sprintf("%s[%d]", path, i)
+		var v, e interface{}
+		var err error
+		if i < len(values) {
+			if v, err = primitiveValue(typeDef, values[i], location); err != nil {
+				return nil, nil, err
+			}
+		}
+		if i < len(elements) {
+			if e, err = n.structValue(n.typeDefContainer.ElementType(), elements[i], location); err != nil {
+				return nil, nil, err
+			}
+		}
+		if v == nil && e == nil {
+			continue
+		}
+		valueFound = valueFound || v != nil
+		elementFound = elementFound || e != nil
+		resultValues = append(resultValues, v)
+		resultElements = append(resultElements, e)
+	}
+
+	var resultValue, resultElement interface{}
+	if valueFound {
+		resultValue = resultValues
+	}
+	if elementFound {
+		resultElement = resultElements
+	}
+	return resultValue, resultElement, nil
+}
+
+// primitiveValue returns the JSON value of a primitive value. Empty strings
+// are returned as nil.
+func primitiveValue(typeDef common.PrimitiveTypeDefAccessor, value interface{}, path string) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	switch typeDef.SimpleType() {
+	case common.StringSimpleType:
+		if v, ok := value.(string); ok {
+			if v == "" {
+				return nil, nil
+			}
+			return v, nil
+		}
+	case common.NumberSimpleType:
+		switch v := value.(type) {
+		case float64:
+			return json.Number(strconv.FormatFloat(v, 'f', -1, 64)), nil
+		case json.Number:
+			if _, err := v.Float64(); err != nil {
+				return nil, fmt.Errorf("%s: invalid number: %s", path, v)
+			}
+			return v, nil
+		case int:
+			return json.Number(strconv.Itoa(v)), nil
+		case int64:
+			return json.Number(strconv.FormatInt(v, 10)), nil
+		}
+	case common.BoolSimpleType:
+		if v, ok := value.(bool); ok {
+			return v, nil
+		}
+	}
+	return nil, fmt.Errorf("%s: value of type %s has unexpected type %T", path, typeDef.Name(), value)
+}
+
+func locationName(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path
+}
+
+// jsonWriter writes normalized JSON values. Strings are written without
+// escaping HTML characters.
+type jsonWriter struct {
+	buf    *bytes.Buffer
+	pretty bool
+}
+
+func (w *jsonWriter) value(value interface{}, depth int) {
+	switch v := value.(type) {
+	case nil:
+		w.buf.WriteString("null")
+	case string:
+		w.string(v)
+	case json.Number:
+		w.buf.WriteString(v.String())
+	case bool:
+		w.buf.WriteString(strconv.FormatBool(v))
+	case jsonObject:
+		w.buf.WriteByte('{')
+		for i, m := range v {
+			if i > 0 {
+				w.buf.WriteByte(',')
+			}
+			w.newLine(depth + 1)
+			w.string(m.name)
+			w.buf.WriteByte(':')
+			if w.pretty {
+				w.buf.WriteByte(' ')
+			}
+			w.value(m.value, depth+1)
+		}
+		if len(v) > 0 {
+			w.newLine(depth)
+		}
+		w.buf.WriteByte('}')
+	case []interface{}:
+		w.buf.WriteByte('[')
+		for i, item := range v {
+			if i > 0 {
+				w.buf.WriteByte(',')
+			}
+			w.newLine(depth + 1)
+			w.value(item, depth+1)
+		}
+		if len(v) > 0 {
+			w.newLine(depth)
+		}
+		w.buf.WriteByte(']')
+	default:
+		panic(fmt.Sprintf("unhandled JSON value: %T", value))
+	}
+}
+
+func (w *jsonWriter) string(s string) {
+	var buf bytes.Buffer
+	e := json.NewEncoder(&buf)
+	e.SetEscapeHTML(false)
+	if err := e.Encode(s); err != nil {
+		panic(err)
+	}
+	w.buf.Write(bytes.TrimSuffix(buf.Bytes(), []byte{'\n'}))
+}
+
+func (w *jsonWriter) newLine(depth int) {
+	if w.pretty {
+		w.buf.WriteByte('\n')
+		for i := 0; i < depth; i++ {
+			w.buf.WriteString(jsonIndent)
+		}
+	}
+}
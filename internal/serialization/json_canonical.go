@@ -0,0 +1,229 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package serialization
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/healthiop/hi"
+	"github.com/healthiop/hi/internal/common"
+	"github.com/healthiop/hi/internal/dynamic"
+	"io"
+	"sort"
+	"strconv"
+)
+
+// CanonicalizationMethod is the URI of a FHIR canonicalization method as it
+// is used by Signature.sigFormat and by the canonicalization extension.
+type CanonicalizationMethod string
+
+const (
+	// JSONCanonicalization keeps all elements of the resources.
+	JSONCanonicalization CanonicalizationMethod = "http://hl7.org/fhir/canonicalization/json"
+	// JSONDataCanonicalization removes the narrative (Resource.text) of
+	// all resources.
+	JSONDataCanonicalization CanonicalizationMethod = "http://hl7.org/fhir/canonicalization/json#data"
+	// JSONStaticCanonicalization removes the narrative and the meta data
+	// (Resource.meta) of all resources.
+	JSONStaticCanonicalization CanonicalizationMethod = "http://hl7.org/fhir/canonicalization/json#static"
+)
+
+const (
+	textPropName = "text"
+	metaPropName = "meta"
+)
+
+// JSONCanonicalizer writes resources in FHIR canonical JSON format that is
+// used to create and verify signatures and to compare resources by their
+// hash value. The properties of all objects are sorted by the code points of
+// their names, the output contains no whitespace outside of strings, strings
+// contain only the escape sequences that are required and decimal values are
+// written with their original text. Empty values are removed like by the
+// JSONSerializer. Resources that are identical produce the identical output.
+type JSONCanonicalizer struct {
+	typeDefContainer *common.TypeDefContainer
+	method           CanonicalizationMethod
+}
+
+func NewJSONCanonicalizer(typeDefContainer *common.TypeDefContainer, method CanonicalizationMethod) *JSONCanonicalizer {
+	return &JSONCanonicalizer{typeDefContainer, method}
+}
+
+func (c *JSONCanonicalizer) TypeDefContainer() *common.TypeDefContainer {
+	return c.typeDefContainer
+}
+
+func (c *JSONCanonicalizer) Method() CanonicalizationMethod {
+	return c.method
+}
+
+// Canonicalize returns the resource in FHIR canonical JSON format. The
+// resource must have been created for the FHIR version of the
+// canonicalizer.
+func (c *JSONCanonicalizer) Canonicalize(resource hi.DynResourceAccessor) ([]byte, error) {
+	data, err := resourceData(c.typeDefContainer, resource)
+	if err != nil {
+		return nil, err
+	}
+	return c.CanonicalizeData(data)
+}
+
+// CanonicalizeData returns the resource data in FHIR canonical JSON format.
+// An error is returned if the canonicalization method is not supported or if
+// the data does not match the type definitions.
+func (c *JSONCanonicalizer) CanonicalizeData(data map[string]interface{}) ([]byte, error) {
+	var removedPropNames []string
+	switch c.method {
+	case JSONCanonicalization:
+	case JSONDataCanonicalization:
+		removedPropNames = []string{textPropName}
+	case JSONStaticCanonicalization:
+		removedPropNames = []string{textPropName, metaPropName}
+	default:
+		return nil, fmt.Errorf("unsupported canonicalization method: %s", c.method)
+	}
+
+	o, err := newJSONNormalizer(c.typeDefContainer).resource(data, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	w := &canonicalJSONWriter{buf: &buf, removedPropNames: removedPropNames}
+	w.value(o)
+	return buf.Bytes(), nil
+}
+
+// Write writes the resource in FHIR canonical JSON format to the writer.
+func (c *JSONCanonicalizer) Write(w io.Writer, resource hi.DynResourceAccessor) error {
+	b, err := c.Canonicalize(resource)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// canonicalJSONWriter writes normalized JSON values in canonical JSON
+// format. The specified properties are removed from all resources.
+type canonicalJSONWriter struct {
+	buf              *bytes.Buffer
+	removedPropNames []string
+}
+
+func (w *canonicalJSONWriter) value(value interface{}) {
+	switch v := value.(type) {
+	case jsonObject:
+		w.object(v)
+	case []interface{}:
+		w.buf.WriteByte('[')
+		for i, item := range v {
+			if i > 0 {
+				w.buf.WriteByte(',')
+			}
+			w.value(item)
+		}
+		w.buf.WriteByte(']')
+	case string:
+		w.string(v)
+	case nil:
+		w.buf.WriteString("null")
+	default:
+		(&jsonWriter{buf: w.buf}).value(value, 0)
+	}
+}
+
+func (w *canonicalJSONWriter) object(o jsonObject) {
+	resource := len(o) > 0 && o[0].name == dynamic.ResourceTypePropName
+	members := make(jsonObject, 0, len(o))
+	for _, m := range o {
+		if !resource || !w.removed(m.name) {
+			members = append(members, m)
+		}
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].name < members[j].name
+	})
+
+	w.buf.WriteByte('{')
+	for i, m := range members {
+		if i > 0 {
+			w.buf.WriteByte(',')
+		}
+		w.string(m.name)
+		w.buf.WriteByte(':')
+		w.value(m.value)
+	}
+	w.buf.WriteByte('}')
+}
+
+func (w *canonicalJSONWriter) removed(name string) bool {
+	for _, n := range w.removedPropNames {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// string writes the string as JSON string. Only quotation marks, reverse
+// solidi and control characters are escaped. Control characters that have
+// no short escape sequence are written with lowercase hexadecimal digits.
+func (w *canonicalJSONWriter) string(s string) {
+	w.buf.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			w.buf.WriteString(`\"`)
+		case '\\':
+			w.buf.WriteString(`\\`)
+		case '\b':
+			w.buf.WriteString(`\b`)
+		case '\f':
+			w.buf.WriteString(`\f`)
+		case '\n':
+			w.buf.WriteString(`\n`)
+		case '\r':
+			w.buf.WriteString(`\r`)
+		case '\t':
+			w.buf.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				w.buf.WriteString(`\u00`)
+				if r < 0x10 {
+					w.buf.WriteByte('0')
+				}
+				w.buf.WriteString(strconv.FormatInt(int64(r), 16))
+			} else {
+				w.buf.WriteRune(r)
+			}
+		}
+	}
+	w.buf.WriteByte('"')
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package serialization
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/healthiop/hi/internal/dynamic"
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/healthiop/hi/internal/stu3"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestJSONCanonicalizer(t *testing.T) {
+	c := NewJSONCanonicalizer(r4.TypeDefContainer(), JSONCanonicalization)
+	assert.Same(t, r4.TypeDefContainer(), c.TypeDefContainer())
+	assert.Equal(t, JSONCanonicalization, c.Method())
+
+	b, err := c.CanonicalizeData(readTestDataNumber(t, "patient"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, string(bytes.TrimSpace(readTestFile(t, "patient_canonical"))), string(b))
+	}
+}
+
+func TestJSONCanonicalizerIdentical(t *testing.T) {
+	c := NewJSONCanonicalizer(r4.TypeDefContainer(), JSONCanonicalization)
+	expected, err := c.CanonicalizeData(readTestDataNumber(t, "patient"))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	for _, name := range []string{"patient_pretty", "patient_compact"} {
+		t.Run(name, func(t *testing.T) {
+			b, err := c.CanonicalizeData(readTestDataNumber(t, name))
+			if assert.NoError(t, err) {
+				assert.Equal(t, expected, b)
+			}
+		})
+	}
+}
+
+func TestJSONCanonicalizerIdenticalXML(t *testing.T) {
+	c := NewJSONCanonicalizer(r4.TypeDefContainer(), JSONCanonicalization)
+	expected, err := c.CanonicalizeData(readTestDataNumber(t, "bundle"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	data, err := NewXMLParser(r4.TypeDefContainer()).ParseData(bytes.NewReader(readTestXMLFile(t, "bundle")))
+	if !assert.NoError(t, err) {
+		return
+	}
+	b, err := c.CanonicalizeData(data)
+	if assert.NoError(t, err) {
+		assert.Equal(t, string(expected), string(b))
+		assert.Contains(t, string(b), `{"unit":"kg","value":67.50}`)
+	}
+}
+
+func TestJSONCanonicalizerDecimal(t *testing.T) {
+	c := NewJSONCanonicalizer(r4.TypeDefContainer(), JSONCanonicalization)
+	canonicalize := func(value interface{}) string {
+		b, err := c.CanonicalizeData(map[string]interface{}{
+			"resourceType": "Observation",
+			"status":       "final",
+			"code":         map[string]interface{}{"text": "Weight"},
+			"valueQuantity": map[string]interface{}{
+				"value": value,
+			},
+		})
+		if !assert.NoError(t, err) {
+			return ""
+		}
+		return string(b)
+	}
+
+	assert.Equal(t, `{"code":{"text":"Weight"},"resourceType":"Observation","status":"final","valueQuantity":{"value":1.5}}`,
+		canonicalize(1.5))
+	assert.Equal(t, `{"code":{"text":"Weight"},"resourceType":"Observation","status":"final","valueQuantity":{"value":1.50}}`,
+		canonicalize(json.Number("1.50")))
+	assert.Equal(t, `{"code":{"text":"Weight"},"resourceType":"Observation","status":"final","valueQuantity":{"value":1E+2}}`,
+		canonicalize(json.Number("1E+2")))
+}
+
+func TestJSONCanonicalizerData(t *testing.T) {
+	b, err := NewJSONCanonicalizer(r4.TypeDefContainer(), JSONDataCanonicalization).CanonicalizeData(map[string]interface{}{
+		"resourceType": "Patient",
+		"meta":         map[string]interface{}{"versionId": "2"},
+		"text":         map[string]interface{}{"status": "generated", "div": "<div>Test</div>"},
+		"contained": []interface{}{
+			map[string]interface{}{
+				"resourceType": "Organization",
+				"text":         map[string]interface{}{"status": "generated", "div": "<div>Org</div>"},
+				"name":         "Test",
+			},
+		},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{"contained":[{"name":"Test","resourceType":"Organization"}],`+
+			`"meta":{"versionId":"2"},"resourceType":"Patient"}`, string(b))
+	}
+}
+
+func TestJSONCanonicalizerStatic(t *testing.T) {
+	b, err := NewJSONCanonicalizer(r4.TypeDefContainer(), JSONStaticCanonicalization).CanonicalizeData(map[string]interface{}{
+		"resourceType": "Patient",
+		"id":           "p1",
+		"meta":         map[string]interface{}{"versionId": "2"},
+		"text":         map[string]interface{}{"status": "generated", "div": "<div>Test</div>"},
+		"name": []interface{}{
+			map[string]interface{}{"text": "Jane Doe"},
+		},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{"id":"p1","name":[{"text":"Jane Doe"}],"resourceType":"Patient"}`, string(b))
+	}
+}
+
+func TestJSONCanonicalizerString(t *testing.T) {
+	b, err := NewJSONCanonicalizer(r4.TypeDefContainer(), JSONCanonicalization).CanonicalizeData(map[string]interface{}{
+		"resourceType": "Patient",
+		"name": []interface{}{
+			map[string]interface{}{"text": "\"A\" & <B>\\\n\t\u0001\u001f é"},
+		},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{"name":[{"text":"\"A\" & <B>\\\n\t\u0001\u001f`+" é"+`"}],"resourceType":"Patient"}`, string(b))
+	}
+}
+
+func TestJSONCanonicalizerUnsupportedMethod(t *testing.T) {
+	_, err := NewJSONCanonicalizer(r4.TypeDefContainer(), "http://hl7.org/fhir/canonicalization/xml").CanonicalizeData(
+		map[string]interface{}{"resourceType": "Patient"})
+	if assert.Error(t, err) {
+		assert.Equal(t, "unsupported canonicalization method: http://hl7.org/fhir/canonicalization/xml", err.Error())
+	}
+}
+
+func TestJSONCanonicalizerCanonicalize(t *testing.T) {
+	resource, err := dynamic.NewDynResource(r4.TypeDefContainer(), readTestData(t, "patient"), nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	var buf bytes.Buffer
+	if assert.NoError(t, NewJSONCanonicalizer(r4.TypeDefContainer(), JSONStaticCanonicalization).Write(&buf, resource)) {
+		assert.NotContains(t, buf.String(), `"text":{`)
+		assert.Contains(t, buf.String(), `"resourceType":"Patient"}`)
+	}
+
+	_, err = NewJSONCanonicalizer(stu3.TypeDefContainer(), JSONCanonicalization).Canonicalize(resource)
+	if assert.Error(t, err) {
+		assert.Equal(t, "resource has FHIR version 4.0.1 instead of 3.0.2", err.Error())
+	}
+}
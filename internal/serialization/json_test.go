@@ -0,0 +1,185 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package serialization
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/healthiop/hi/internal/dynamic"
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/healthiop/hi/internal/stu3"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"testing"
+)
+
+func TestJSONSerializerPretty(t *testing.T) {
+	s := NewJSONSerializer(r4.TypeDefContainer(), true)
+	assert.Same(t, r4.TypeDefContainer(), s.TypeDefContainer())
+	assert.True(t, s.Pretty())
+
+	b, err := s.SerializeData(readTestData(t, "patient"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, string(readTestFile(t, "patient_pretty")), string(b))
+	}
+}
+
+func TestJSONSerializerCompact(t *testing.T) {
+	s := NewJSONSerializer(r4.TypeDefContainer(), false)
+	assert.False(t, s.Pretty())
+
+	b, err := s.SerializeData(readTestData(t, "patient"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, string(bytes.TrimSpace(readTestFile(t, "patient_compact"))), string(b))
+	}
+}
+
+func TestJSONSerializerSerialize(t *testing.T) {
+	resource, err := dynamic.NewDynResource(r4.TypeDefContainer(), readTestData(t, "patient"), nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	var buf bytes.Buffer
+	if assert.NoError(t, NewJSONSerializer(r4.TypeDefContainer(), true).Write(&buf, resource)) {
+		assert.Equal(t, string(readTestFile(t, "patient_pretty")), buf.String())
+	}
+}
+
+func TestJSONSerializerSerializeVersion(t *testing.T) {
+	resource, err := dynamic.NewDynResource(r4.TypeDefContainer(), readTestData(t, "patient"), nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = NewJSONSerializer(stu3.TypeDefContainer(), true).Serialize(resource)
+	if assert.Error(t, err) {
+		assert.Equal(t, "resource has FHIR version 4.0.1 instead of 3.0.2", err.Error())
+	}
+}
+
+func TestJSONSerializerRoundTrip(t *testing.T) {
+	s := NewJSONSerializer(r4.TypeDefContainer(), true)
+	b, err := s.SerializeData(readTestData(t, "patient"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	var data map[string]interface{}
+	if assert.NoError(t, json.Unmarshal(b, &data)) {
+		b2, err := s.SerializeData(data)
+		if assert.NoError(t, err) {
+			assert.Equal(t, string(b), string(b2))
+		}
+	}
+}
+
+func TestJSONSerializerEmpty(t *testing.T) {
+	b, err := NewJSONSerializer(r4.TypeDefContainer(), true).SerializeData(map[string]interface{}{
+		"resourceType": "Patient",
+		"name":         []interface{}{map[string]interface{}{"given": []interface{}{nil, ""}}},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "{\n  \"resourceType\": \"Patient\"\n}\n", string(b))
+	}
+}
+
+func TestJSONSerializerNumber(t *testing.T) {
+	b, err := NewJSONSerializer(r4.TypeDefContainer(), false).SerializeData(map[string]interface{}{
+		"resourceType": "Observation",
+		"status":       "final",
+		"code":         map[string]interface{}{"text": "Test"},
+		"valueQuantity": map[string]interface{}{
+			"value": json.Number("1.50"),
+		},
+		"component": []interface{}{
+			map[string]interface{}{
+				"code":         map[string]interface{}{"text": "Test"},
+				"valueInteger": 12,
+			},
+			map[string]interface{}{
+				"code":         map[string]interface{}{"text": "Test"},
+				"valueInteger": float64(1200000),
+			},
+		},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{"resourceType":"Observation","status":"final","code":{"text":"Test"},"valueQuantity":{"value":1.50},"component":[{"code":{"text":"Test"},"valueInteger":12},{"code":{"text":"Test"},"valueInteger":1200000}]}`, string(b))
+	}
+}
+
+func TestJSONSerializerInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data map[string]interface{}
+		err  string
+	}{
+		{"no resource type", map[string]interface{}{"id": "1"},
+			"resource: resource contains no resource type"},
+		{"unknown resource type", map[string]interface{}{"resourceType": "Test"},
+			"resource: resource type undefined in FHIR R4: Test"},
+		{"unknown property", map[string]interface{}{"resourceType": "Patient", "test": "1"},
+			"Patient: property test is not defined by type Patient"},
+		{"element of struct", map[string]interface{}{"resourceType": "Patient", "_name": map[string]interface{}{}},
+			"Patient: property name is not primitive and cannot have an element _name"},
+		{"primitive type", map[string]interface{}{"resourceType": "Patient", "active": "true"},
+			"Patient.active: value of type boolean has unexpected type string"},
+		{"array", map[string]interface{}{"resourceType": "Patient", "name": map[string]interface{}{}},
+			"Patient.name: value must be an array but is map[string]interface {}"},
+		{"primitive array", map[string]interface{}{"resourceType": "Patient",
+			"name": []interface{}{map[string]interface{}{"given": "Jane"}}},
+			"Patient.name[0].given: value must be an array but is string"},
+		{"object", map[string]interface{}{"resourceType": "Patient", "maritalStatus": "M"},
+			"Patient.maritalStatus: value must be an object but is string"},
+		{"contained", map[string]interface{}{"resourceType": "Patient",
+			"contained": []interface{}{map[string]interface{}{"id": "1"}}},
+			"Patient.contained[0]: resource contains no resource type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewJSONSerializer(r4.TypeDefContainer(), true).SerializeData(tt.data)
+			if assert.Error(t, err) {
+				assert.Equal(t, tt.err, err.Error())
+			}
+		})
+	}
+}
+
+func readTestData(t *testing.T, name string) map[string]interface{} {
+	var data map[string]interface{}
+	if err := json.Unmarshal(readTestFile(t, name), &data); err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func readTestFile(t *testing.T, name string) []byte {
+	b, err := ioutil.ReadFile("testdata/" + name + ".json.golden")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
@@ -0,0 +1,264 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package serialization
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/healthiop/hi"
+	"github.com/healthiop/hi/internal/common"
+	"github.com/healthiop/hi/internal/dynamic"
+	"io"
+	"sync"
+)
+
+// DefaultNDJSONMaxLineSize is the maximum size of a line of NDJSON input
+// unless another maximum size has been specified.
+const DefaultNDJSONMaxLineSize = 16 * 1024 * 1024
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// NDJSONReader reads resources from newline delimited JSON as it is used by
+// FHIR Bulk Data. Each non-empty line must contain one resource in FHIR
+// JSON format. Input that has been compressed with gzip is decompressed
+// automatically.
+//
+// Memory is bounded by the maximum line size. If more than one worker is
+// used, lines are parsed concurrently and the number of lines that are held
+// in memory is bounded by the number of workers. Resources are always returned in the order of the input.
+// A reader that uses workers must be closed.
+type NDJSONReader struct {
+	typeDefContainer *common.TypeDefContainer
+	workers          int
+	closer           io.Closer
+	scanner          *bufio.Scanner
+	line             int
+	lastLine         int
+	err              error
+	results          chan chan ndjsonResult
+	done             chan struct{}
+	closeOnce        sync.Once
+}
+
+// NDJSONError describes an error of a specific line of the input. The line
+// number starts with 1.
+type NDJSONError struct {
+	Line int
+	Err  error
+}
+
+type ndjsonResult struct {
+	line     int
+	resource hi.DynResourceAccessor
+	err      error
+}
+
+func (e *NDJSONError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Err)
+}
+
+func (e *NDJSONError) Unwrap() error {
+	return e.Err
+}
+
+// NewNDJSONReader returns a reader for the NDJSON input. If workers is
+// greater than 1, lines are parsed by the specified number of goroutines.
+// A maxLineSize of 0 uses DefaultNDJSONMaxLineSize.
+func NewNDJSONReader(typeDefContainer *common.TypeDefContainer, r io.Reader, workers int, maxLineSize int) (*NDJSONReader, error) {
+	if maxLineSize <= 0 {
+		maxLineSize = DefaultNDJSONMaxLineSize
+	}
+
+	reader := &NDJSONReader{typeDefContainer: typeDefContainer, workers: workers}
+	br := bufio.NewReader(r)
+	if magic, _ := br.Peek(len(gzipMagic)); bytes.Equal(magic, gzipMagic) {
+		gr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		reader.closer = gr
+		r = gr
+	} else {
+		r = br
+	}
+
+	bufferSize := bufio.MaxScanTokenSize
+	if bufferSize > maxLineSize {
+		bufferSize = maxLineSize
+	}
+	reader.scanner = bufio.NewScanner(r)
+	reader.scanner.Buffer(make([]byte, 0, bufferSize), maxLineSize)
+	if workers > 1 {
+		reader.startWorkers()
+	}
+	return reader, nil
+}
+
+func (r *NDJSONReader) TypeDefContainer() *common.TypeDefContainer {
+	return r.typeDefContainer
+}
+
+func (r *NDJSONReader) Workers() int {
+	return r.workers
+}
+
+// Line returns the line number of the resource or the error that has been
+// returned last by Next.
+func (r *NDJSONReader) Line() int {
+	return r.lastLine
+}
+
+// Next returns the next resource. If the line of the resource cannot be
+// parsed, an NDJSONError is returned and the next invocation continues with
+// the following line. Errors when reading the input terminate the reading
+// and are returned by all further invocations. When the end of the input
+// has been reached, io.EOF is returned.
+func (r *NDJSONReader) Next() (hi.DynResourceAccessor, error) {
+	var result ndjsonResult
+	if r.results == nil {
+		line, err := r.nextLine()
+		if err != nil {
+			return nil, err
+		}
+		result = r.parseLine(r.line, line)
+	} else {
+		c, ok := <-r.results
+		if !ok {
+			return nil, r.err
+		}
+		result = <-c
+	}
+
+	r.lastLine = result.line
+	return result.resource, result.err
+}
+
+// Close stops the workers and releases the resources of the reader. The
+// underlying reader is not closed.
+func (r *NDJSONReader) Close() error {
+	var err error
+	r.closeOnce.Do(func() {
+		if r.done != nil {
+			close(r.done)
+			for range r.results {
+			}
+		}
+		if r.closer != nil {
+			err = r.closer.Close()
+		}
+	})
+	return err
+}
+
+// nextLine returns the next non-empty line.
+func (r *NDJSONReader) nextLine() ([]byte, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	for r.scanner.Scan() {
+		r.line++
+		if line := bytes.TrimSpace(r.scanner.Bytes()); len(line) > 0 {
+			return line, nil
+		}
+	}
+
+	r.err = r.scanner.Err()
+	if errors.Is(r.err, bufio.ErrTooLong) {
+		r.err = &NDJSONError{r.line + 1, fmt.Errorf("line exceeds maximum size")}
+	} else if r.err == nil {
+		r.err = io.EOF
+	}
+	return nil, r.err
+}
+
+func (r *NDJSONReader) parseLine(number int, line []byte) ndjsonResult {
+	d := json.NewDecoder(bytes.NewReader(line))
+	d.UseNumber()
+	var data map[string]interface{}
+	if err := d.Decode(&data); err != nil {
+		return ndjsonResult{number, nil, &NDJSONError{number, err}}
+	}
+	if _, err := d.Token(); err != io.EOF {
+		return ndjsonResult{number, nil, &NDJSONError{number, fmt.Errorf("unexpected data after resource")}}
+	}
+
+	resource, err := dynamic.NewDynResource(r.typeDefContainer, data, nil)
+	if err != nil {
+		return ndjsonResult{number, nil, &NDJSONError{number, err}}
+	}
+	return ndjsonResult{number, resource, nil}
+}
+
+// startWorkers starts the goroutine that reads the lines and the workers
+// that parse them. The result channels are queued in the order of the lines
+// so that results are returned in order.
+func (r *NDJSONReader) startWorkers() {
+	type job struct {
+		line   int
+		data   []byte
+		result chan ndjsonResult
+	}
+	jobs := make(chan job)
+	r.results = make(chan chan ndjsonResult, r.workers)
+	r.done = make(chan struct{})
+
+	for i := 0; i < r.workers; i++ {
+		go func() {
+			for j := range jobs {
+				j.result <- r.parseLine(j.line, j.data)
+			}
+		}()
+	}
+
+	go func() {
+		defer close(r.results)
+		defer close(jobs)
+		for {
+			line, err := r.nextLine()
+			if err != nil {
+				return
+			}
+			j := job{r.line, append([]byte(nil), line...), make(chan ndjsonResult, 1)}
+			select {
+			case r.results <- j.result:
+			case <-r.done:
+				return
+			}
+			select {
+			case jobs <- j:
+			case <-r.done:
+				return
+			}
+		}
+	}()
+}
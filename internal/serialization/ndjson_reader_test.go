@@ -0,0 +1,228 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package serialization
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"strings"
+	"testing"
+)
+
+const ndjsonTestInput = `{"resourceType":"Patient","id":"p1"}
+
+{"resourceType":"Patient","id":"p2","gender":"female"}` + "\r\n" +
+	`{"resourceType":"Observation","id":"o1","status":"final","code":{"text":"Weight"},"valueQuantity":{"value":67.50}}` + "\n"
+
+func TestNDJSONReader(t *testing.T) {
+	r, err := NewNDJSONReader(r4.TypeDefContainer(), strings.NewReader(ndjsonTestInput), 0, 0)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer r.Close()
+	assert.Same(t, r4.TypeDefContainer(), r.TypeDefContainer())
+	assert.Equal(t, 0, r.Workers())
+
+	ids, lines, err := readNDJSONIDs(r)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"p1", "p2", "o1"}, ids)
+	assert.Equal(t, []int{1, 3, 4}, lines)
+}
+
+func TestNDJSONReaderGzip(t *testing.T) {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	_, _ = gw.Write([]byte(ndjsonTestInput))
+	_ = gw.Close()
+
+	r, err := NewNDJSONReader(r4.TypeDefContainer(), &buf, 0, 0)
+	if !assert.NoError(t, err) {
+		return
+	}
+	ids, _, err := readNDJSONIDs(r)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"p1", "p2", "o1"}, ids)
+	assert.NoError(t, r.Close())
+}
+
+func TestNDJSONReaderInvalidGzip(t *testing.T) {
+	_, err := NewNDJSONReader(r4.TypeDefContainer(), bytes.NewReader([]byte{0x1f, 0x8b, 0x00}), 0, 0)
+	assert.Error(t, err)
+}
+
+func TestNDJSONReaderDecimal(t *testing.T) {
+	r, err := NewNDJSONReader(r4.TypeDefContainer(), strings.NewReader(ndjsonTestInput), 0, 0)
+	if !assert.NoError(t, err) {
+		return
+	}
+	for i := 0; i < 2; i++ {
+		_, err = r.Next()
+		assert.NoError(t, err)
+	}
+	resource, err := r.Next()
+	if !assert.NoError(t, err) {
+		return
+	}
+	b, err := NewJSONSerializer(r4.TypeDefContainer(), false).Serialize(resource)
+	if assert.NoError(t, err) {
+		assert.Contains(t, string(b), `"valueQuantity":{"value":67.50}`)
+	}
+}
+
+func TestNDJSONReaderLineError(t *testing.T) {
+	input := `{"resourceType":"Patient","id":"p1"}
+{"resourceType":"Test"}
+{"resourceType":"Patient",
+{"resourceType":"Patient","id":"p2"} {}
+{"resourceType":"Patient","id":"p3"}
+`
+	for _, workers := range []int{0, 3} {
+		t.Run(fmt.Sprintf("workers %d", workers), func(t *testing.T) {
+			r, err := NewNDJSONReader(r4.TypeDefContainer(), strings.NewReader(input), workers, 0)
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer r.Close()
+
+			_, err = r.Next()
+			assert.NoError(t, err)
+			_, err = r.Next()
+			if assert.Error(t, err) {
+				assert.Equal(t, "line 2: resource type undefined: Test", err.Error())
+				var ndjsonError *NDJSONError
+				if assert.True(t, errors.As(err, &ndjsonError)) {
+					assert.Equal(t, 2, ndjsonError.Line)
+				}
+			}
+			assert.Equal(t, 2, r.Line())
+			_, err = r.Next()
+			if assert.Error(t, err) {
+				assert.Equal(t, "line 3: unexpected EOF", err.Error())
+			}
+			_, err = r.Next()
+			if assert.Error(t, err) {
+				assert.Equal(t, "line 4: unexpected data after resource", err.Error())
+			}
+			resource, err := r.Next()
+			if assert.NoError(t, err) {
+				assert.Equal(t, "Patient", resource.TypeName())
+				assert.Equal(t, 5, r.Line())
+			}
+			_, err = r.Next()
+			assert.Equal(t, io.EOF, err)
+			_, err = r.Next()
+			assert.Equal(t, io.EOF, err)
+		})
+	}
+}
+
+func TestNDJSONReaderMaxLineSize(t *testing.T) {
+	input := `{"resourceType":"Patient","id":"p1"}
+{"resourceType":"Patient","id":"p2","gender":"female"}
+{"resourceType":"Patient","id":"p3"}
+`
+	for _, workers := range []int{0, 2} {
+		t.Run(fmt.Sprintf("workers %d", workers), func(t *testing.T) {
+			r, err := NewNDJSONReader(r4.TypeDefContainer(), strings.NewReader(input), workers, 40)
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer r.Close()
+
+			ids, _, err := readNDJSONIDs(r)
+			assert.Equal(t, []string{"p1"}, ids)
+			if assert.Error(t, err) {
+				assert.Equal(t, "line 2: line exceeds maximum size", err.Error())
+			}
+			_, err = r.Next()
+			if assert.Error(t, err) {
+				assert.Equal(t, "line 2: line exceeds maximum size", err.Error())
+			}
+		})
+	}
+}
+
+func TestNDJSONReaderWorkersOrder(t *testing.T) {
+	var buf bytes.Buffer
+	var expected []string
+	for i := 0; i < 1000; i++ {
+		id := fmt.Sprintf("p%d", i)
+		expected = append(expected, id)
+		fmt.Fprintf(&buf, `{"resourceType":"Patient","id":"%s","name":[{"family":"Doe","given":["Jane","%d"]}]}`+"\n", id, i)
+	}
+
+	r, err := NewNDJSONReader(r4.TypeDefContainer(), &buf, 8, 0)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, 8, r.Workers())
+	ids, lines, err := readNDJSONIDs(r)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, ids)
+	assert.Equal(t, 1000, lines[999])
+	assert.NoError(t, r.Close())
+	assert.NoError(t, r.Close())
+}
+
+func TestNDJSONReaderCloseEarly(t *testing.T) {
+	var buf bytes.Buffer
+	for i := 0; i < 100; i++ {
+		fmt.Fprintf(&buf, `{"resourceType":"Patient","id":"p%d"}`+"\n", i)
+	}
+
+	r, err := NewNDJSONReader(r4.TypeDefContainer(), &buf, 4, 0)
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = r.Next()
+	assert.NoError(t, err)
+	assert.NoError(t, r.Close())
+}
+
+func readNDJSONIDs(r *NDJSONReader) ([]string, []int, error) {
+	var ids []string
+	var lines []int
+	for {
+		resource, err := r.Next()
+		if err == io.EOF {
+			return ids, lines, nil
+		}
+		if err != nil {
+			return ids, lines, err
+		}
+		id, _ := resource.StringPropValue("id")
+		ids = append(ids, id)
+		lines = append(lines, r.Line())
+	}
+}
@@ -0,0 +1,272 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package serialization
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"github.com/healthiop/hi"
+	"github.com/healthiop/hi/internal/common"
+	"github.com/healthiop/hi/internal/dynamic"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// BulkExportManifestFileName is the name of the manifest file that is
+	// written into the export directory.
+	BulkExportManifestFileName = "manifest.json"
+
+	operationOutcomeTypeName = "OperationOutcome"
+)
+
+// BulkExportOptions contains the options of a BulkExportWriter.
+type BulkExportOptions struct {
+	// BaseURL is prepended to the file names to get the URLs of the files in
+	// the manifest. If it is empty, the manifest contains the file names.
+	BaseURL string
+	// MaxFileSize is the maximum number of uncompressed bytes of a file. If
+	// a resource would exceed the size, a new file is started. A resource
+	// that exceeds the size alone is written into a file of its own. If it
+	// is 0, the size of the files is not limited.
+	MaxFileSize int64
+	// Gzip compresses the files with gzip.
+	Gzip bool
+	// RequiresAccessToken is written into the manifest.
+	RequiresAccessToken bool
+}
+
+// BulkExportManifest is the manifest of a FHIR Bulk Data export.
+type BulkExportManifest struct {
+	TransactionTime     string             `json:"transactionTime"`
+	Request             string             `json:"request"`
+	RequiresAccessToken bool               `json:"requiresAccessToken"`
+	Output              []BulkExportOutput `json:"output"`
+	Error               []BulkExportOutput `json:"error"`
+}
+
+// BulkExportOutput describes a file of a FHIR Bulk Data export.
+type BulkExportOutput struct {
+	Type  string `json:"type"`
+	URL   string `json:"url"`
+	Count int    `json:"count"`
+}
+
+// BulkExportWriter writes resources into NDJSON files in a directory as
+// they are provided by a FHIR Bulk Data export. The resources are
+// partitioned into one file per resource type (e.g. Patient.1.ndjson) and
+// the files are rolled over at the maximum file size (e.g.
+// Patient.2.ndjson). OperationOutcome resources are listed as errors in the
+// manifest. The writer must not be used concurrently.
+type BulkExportWriter struct {
+	serializer *JSONSerializer
+	dir        string
+	options    BulkExportOptions
+	files      map[string]*bulkExportFile
+	outputs    []*bulkExportFile
+	closed     bool
+}
+
+type bulkExportFile struct {
+	resourceType string
+	fileName     string
+	file         *os.File
+	gzipWriter   *gzip.Writer
+	writer       *bufio.Writer
+	size         int64
+	count        int
+}
+
+// NewBulkExportWriter returns a writer that writes the files into the
+// directory. The directory is created if it does not exist.
+func NewBulkExportWriter(typeDefContainer *common.TypeDefContainer, dir string, options BulkExportOptions) (*BulkExportWriter, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	if options.BaseURL != "" && !strings.HasSuffix(options.BaseURL, "/") {
+		options.BaseURL = options.BaseURL + "/"
+	}
+	return &BulkExportWriter{
+		serializer: NewJSONSerializer(typeDefContainer, false),
+		dir:        dir,
+		options:    options,
+		files:      make(map[string]*bulkExportFile),
+	}, nil
+}
+
+func (w *BulkExportWriter) TypeDefContainer() *common.TypeDefContainer {
+	return w.serializer.TypeDefContainer()
+}
+
+func (w *BulkExportWriter) Dir() string {
+	return w.dir
+}
+
+func (w *BulkExportWriter) Options() BulkExportOptions {
+	return w.options
+}
+
+// Write writes the resource into the file of its resource type. The
+// resource must have been created for the FHIR version of the writer.
+func (w *BulkExportWriter) Write(resource hi.DynResourceAccessor) error {
+	data, err := resourceData(w.serializer.TypeDefContainer(), resource)
+	if err != nil {
+		return err
+	}
+	return w.WriteData(data)
+}
+
+// WriteData writes the resource data into the file of its resource type.
+func (w *BulkExportWriter) WriteData(data map[string]interface{}) error {
+	if w.closed {
+		return fmt.Errorf("bulk export writer has been closed")
+	}
+	b, err := w.serializer.SerializeData(data)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	resourceType := data[dynamic.ResourceTypePropName].(string)
+	f := w.files[resourceType]
+	if f != nil && w.options.MaxFileSize > 0 && f.size+int64(len(b)) > w.options.MaxFileSize {
+		delete(w.files, resourceType)
+		if err := f.close(); err != nil {
+			return err
+		}
+		f = nil
+	}
+	if f == nil {
+		if f, err = w.createFile(resourceType); err != nil {
+			return err
+		}
+	}
+
+	if _, err := f.writer.Write(b); err != nil {
+		return err
+	}
+	f.size += int64(len(b))
+	f.count++
+	return nil
+}
+
+// Close closes all files and writes the manifest. The transaction time and
+// the request are written into the manifest that is returned.
+func (w *BulkExportWriter) Close(transactionTime time.Time, request string) (*BulkExportManifest, error) {
+	if w.closed {
+		return nil, fmt.Errorf("bulk export writer has been closed")
+	}
+	w.closed = true
+
+	var closeErr error
+	for _, f := range w.files {
+		if err := f.close(); err != nil && closeErr == nil {
+			closeErr = err
+		}
+	}
+	if closeErr != nil {
+		return nil, closeErr
+	}
+
+	manifest := &BulkExportManifest{
+		TransactionTime:     transactionTime.Format(time.RFC3339Nano),
+		Request:             request,
+		RequiresAccessToken: w.options.RequiresAccessToken,
+		Output:              make([]BulkExportOutput, 0),
+		Error:               make([]BulkExportOutput, 0),
+	}
+	for _, f := range w.outputs {
+		output := BulkExportOutput{
+			Type:  f.resourceType,
+			URL:   w.options.BaseURL + f.fileName,
+			Count: f.count,
+		}
+		if f.resourceType == operationOutcomeTypeName {
+			manifest.Error = append(manifest.Error, output)
+		} else {
+			manifest.Output = append(manifest.Output, output)
+		}
+	}
+
+	b, err := json.MarshalIndent(manifest, "", jsonIndent)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(filepath.Join(w.dir, BulkExportManifestFileName), append(b, '\n'), 0644); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func (w *BulkExportWriter) createFile(resourceType string) (*bulkExportFile, error) {
+	number := 1
+	for _, o := range w.outputs {
+		if o.resourceType == resourceType {
+			number++
+		}
+	}
+	fileName := fmt.Sprintf("%s.%d.ndjson", resourceType, number)
+	if w.options.Gzip {
+		fileName += ".gz"
+	}
+
+	file, err := os.Create(filepath.Join(w.dir, fileName))
+	if err != nil {
+		return nil, err
+	}
+	f := &bulkExportFile{resourceType: resourceType, fileName: fileName, file: file}
+	var out io.Writer = file
+	if w.options.Gzip {
+		f.gzipWriter = gzip.NewWriter(file)
+		out = f.gzipWriter
+	}
+	f.writer = bufio.NewWriter(out)
+
+	w.files[resourceType] = f
+	w.outputs = append(w.outputs, f)
+	return f, nil
+}
+
+func (f *bulkExportFile) close() error {
+	err := f.writer.Flush()
+	if f.gzipWriter != nil {
+		if e := f.gzipWriter.Close(); err == nil {
+			err = e
+		}
+	}
+	if e := f.file.Close(); err == nil {
+		err = e
+	}
+	return err
+}
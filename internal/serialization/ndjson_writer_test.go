@@ -0,0 +1,203 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package serialization
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/healthiop/hi/internal/dynamic"
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/healthiop/hi/internal/stu3"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var bulkExportTestTime = time.Date(2021, 5, 6, 10, 12, 13, 0, time.UTC)
+
+func TestBulkExportWriter(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "export")
+	w, err := NewBulkExportWriter(r4.TypeDefContainer(), dir, BulkExportOptions{
+		BaseURL:             "http://example.com/export",
+		RequiresAccessToken: true,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Same(t, r4.TypeDefContainer(), w.TypeDefContainer())
+	assert.Equal(t, dir, w.Dir())
+	assert.Equal(t, "http://example.com/export/", w.Options().BaseURL)
+
+	for _, data := range []map[string]interface{}{
+		{"resourceType": "Patient", "id": "p1"},
+		{"resourceType": "Observation", "id": "o1", "status": "final", "code": map[string]interface{}{"text": "Weight"}},
+		{"resourceType": "Patient", "id": "p2", "gender": "female"},
+		{"resourceType": "OperationOutcome", "issue": []interface{}{
+			map[string]interface{}{"severity": "error", "code": "not-found"},
+		}},
+	} {
+		assert.NoError(t, w.WriteData(data))
+	}
+
+	manifest, err := w.Close(bulkExportTestTime, "http://example.com/fhir/$export?_type=Patient,Observation")
+	if !assert.NoError(t, err) {
+		return
+	}
+	expected := &BulkExportManifest{
+		TransactionTime:     "2021-05-06T10:12:13Z",
+		Request:             "http://example.com/fhir/$export?_type=Patient,Observation",
+		RequiresAccessToken: true,
+		Output: []BulkExportOutput{
+			{"Patient", "http://example.com/export/Patient.1.ndjson", 2},
+			{"Observation", "http://example.com/export/Observation.1.ndjson", 1},
+		},
+		Error: []BulkExportOutput{
+			{"OperationOutcome", "http://example.com/export/OperationOutcome.1.ndjson", 1},
+		},
+	}
+	assert.Equal(t, expected, manifest)
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, BulkExportManifestFileName))
+	if assert.NoError(t, err) {
+		var written BulkExportManifest
+		assert.NoError(t, json.Unmarshal(b, &written))
+		assert.Equal(t, expected, &written)
+	}
+
+	b, err = ioutil.ReadFile(filepath.Join(dir, "Patient.1.ndjson"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{"resourceType":"Patient","id":"p1"}`+"\n"+
+			`{"resourceType":"Patient","id":"p2","gender":"female"}`+"\n", string(b))
+	}
+
+	err = w.WriteData(map[string]interface{}{"resourceType": "Patient"})
+	if assert.Error(t, err) {
+		assert.Equal(t, "bulk export writer has been closed", err.Error())
+	}
+	_, err = w.Close(bulkExportTestTime, "")
+	assert.Error(t, err)
+}
+
+func TestBulkExportWriterRollOverGzip(t *testing.T) {
+	dir := t.TempDir()
+	w, err := NewBulkExportWriter(r4.TypeDefContainer(), dir, BulkExportOptions{MaxFileSize: 111, Gzip: true})
+	if !assert.NoError(t, err) {
+		return
+	}
+	var expected []string
+	for i := 0; i < 7; i++ {
+		id := fmt.Sprintf("p%d", i)
+		expected = append(expected, id)
+		assert.NoError(t, w.WriteData(map[string]interface{}{"resourceType": "Patient", "id": id}))
+	}
+	manifest, err := w.Close(bulkExportTestTime, "http://example.com/fhir/Patient/$export")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []BulkExportOutput{
+		{"Patient", "Patient.1.ndjson.gz", 3},
+		{"Patient", "Patient.2.ndjson.gz", 3},
+		{"Patient", "Patient.3.ndjson.gz", 1},
+	}, manifest.Output)
+	assert.Equal(t, []BulkExportOutput{}, manifest.Error)
+
+	var ids []string
+	for _, o := range manifest.Output {
+		f, err := os.Open(filepath.Join(dir, o.URL))
+		if !assert.NoError(t, err) {
+			return
+		}
+		r, err := NewNDJSONReader(r4.TypeDefContainer(), f, 0, 0)
+		if assert.NoError(t, err) {
+			fileIDs, _, err := readNDJSONIDs(r)
+			assert.NoError(t, err)
+			ids = append(ids, fileIDs...)
+			assert.NoError(t, r.Close())
+		}
+		assert.NoError(t, f.Close())
+	}
+	assert.Equal(t, expected, ids)
+}
+
+func TestBulkExportWriterLargeResource(t *testing.T) {
+	dir := t.TempDir()
+	w, err := NewBulkExportWriter(r4.TypeDefContainer(), dir, BulkExportOptions{MaxFileSize: 10})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NoError(t, w.WriteData(map[string]interface{}{"resourceType": "Patient", "id": "p1"}))
+	assert.NoError(t, w.WriteData(map[string]interface{}{"resourceType": "Patient", "id": "p2"}))
+	manifest, err := w.Close(bulkExportTestTime, "")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []BulkExportOutput{
+			{"Patient", "Patient.1.ndjson", 1},
+			{"Patient", "Patient.2.ndjson", 1},
+		}, manifest.Output)
+	}
+}
+
+func TestBulkExportWriterWrite(t *testing.T) {
+	resource, err := dynamic.NewDynResource(r4.TypeDefContainer(), readTestData(t, "patient"), nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	w, err := NewBulkExportWriter(r4.TypeDefContainer(), t.TempDir(), BulkExportOptions{})
+	if assert.NoError(t, err) {
+		assert.NoError(t, w.Write(resource))
+		err = w.WriteData(map[string]interface{}{"resourceType": "Patient", "test": "1"})
+		if assert.Error(t, err) {
+			assert.Equal(t, "Patient: property test is not defined by type Patient", err.Error())
+		}
+		manifest, err := w.Close(bulkExportTestTime, "")
+		if assert.NoError(t, err) {
+			assert.Equal(t, []BulkExportOutput{{"Patient", "Patient.1.ndjson", 1}}, manifest.Output)
+		}
+	}
+
+	w, err = NewBulkExportWriter(stu3.TypeDefContainer(), t.TempDir(), BulkExportOptions{})
+	if assert.NoError(t, err) {
+		err = w.Write(resource)
+		if assert.Error(t, err) {
+			assert.Equal(t, "resource has FHIR version 4.0.1 instead of 3.0.2", err.Error())
+		}
+	}
+}
+
+func TestBulkExportWriterInvalidDir(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "test")
+	if !assert.NoError(t, ioutil.WriteFile(fileName, []byte{}, 0644)) {
+		return
+	}
+	_, err := NewBulkExportWriter(r4.TypeDefContainer(), fileName, BulkExportOptions{})
+	assert.Error(t, err)
+}
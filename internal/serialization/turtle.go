@@ -0,0 +1,495 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package serialization
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/healthiop/hi"
+	"github.com/healthiop/hi/internal/common"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	RDFNamespace      = "http://hl7.org/fhir/"
+	turtleIndent      = "  "
+	containedPropName = "contained"
+	fullURLPropName   = "fullUrl"
+	referencePropName = "reference"
+	referenceTypeName = "Reference"
+)
+
+// turtlePrefixes contains the prefixes that are declared by the Turtle output.
+var turtlePrefixes = []struct {
+	prefix string
+	iri    string
+}{
+	{"fhir", RDFNamespace},
+	{"owl", "http://www.w3.org/2002/07/owl#"},
+	{"rdf", "http://www.w3.org/1999/02/22-rdf-syntax-ns#"},
+	{"xsd", "http://www.w3.org/2001/XMLSchema#"},
+}
+
+// TurtleSerializer writes resources in FHIR RDF Turtle format. The
+// predicates are named by the path of the element in the type that defines
+// it (e.g. fhir:Resource.id or fhir:Bundle.entry.fullUrl). Primitive values
+// are written as typed literals and list items contain their fhir:index.
+//
+// A resource is identified by the full URL of its Bundle entry or, if the
+// base URL of the serializer is not empty, by the URL that results from the
+// base URL, the resource type and the id. Resources without IRI and contained
+// resources are written as blank nodes.
+type TurtleSerializer struct {
+	typeDefContainer *common.TypeDefContainer
+	baseURL          string
+	pathsOnce        sync.Once
+	paths            map[string]string
+}
+
+func NewTurtleSerializer(typeDefContainer *common.TypeDefContainer, baseURL string) *TurtleSerializer {
+	if baseURL != "" && !strings.HasSuffix(baseURL, "/") {
+		baseURL = baseURL + "/"
+	}
+	return &TurtleSerializer{typeDefContainer: typeDefContainer, baseURL: baseURL}
+}
+
+func (s *TurtleSerializer) TypeDefContainer() *common.TypeDefContainer {
+	return s.typeDefContainer
+}
+
+func (s *TurtleSerializer) BaseURL() string {
+	return s.baseURL
+}
+
+// Serialize returns the resource in FHIR RDF Turtle format. The resource
+// must have been created for the FHIR version of the serializer.
+func (s *TurtleSerializer) Serialize(resource hi.DynResourceAccessor) ([]byte, error) {
+	data, err := resourceData(s.typeDefContainer, resource)
+	if err != nil {
+		return nil, err
+	}
+	return s.SerializeData(data)
+}
+
+// SerializeData returns the resource data in FHIR RDF Turtle format. An
+// error is returned if the data contains properties that are not defined or
+// values that do not match the type definitions.
+func (s *TurtleSerializer) SerializeData(data map[string]interface{}) ([]byte, error) {
+	o, err := newJSONNormalizer(s.typeDefContainer).resource(data, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	for _, p := range turtlePrefixes {
+		fmt.Fprintf(&buf, "@prefix %s: <%s> .\n", p.prefix, p.iri)
+	}
+
+	w := &turtleWriter{serializer: s, buf: &buf}
+	iri := s.resourceIRI(o, "")
+	w.subject(iri, o, true)
+	for len(w.pending) > 0 {
+		r := w.pending[0]
+		w.pending = w.pending[1:]
+		w.subject(r.iri, r.o, false)
+	}
+
+	if iri != "" {
+		buf.WriteString("\n# - ontology header ------------------------------------------------------------\n\n")
+		fmt.Fprintf(&buf, "<%s.ttl> a owl:Ontology ;\n%sowl:imports fhir:fhir.ttl .\n", iri, turtleIndent)
+	}
+	return buf.Bytes(), nil
+}
+
+// Write writes the resource in FHIR RDF Turtle format to the writer.
+func (s *TurtleSerializer) Write(w io.Writer, resource hi.DynResourceAccessor) error {
+	b, err := s.Serialize(resource)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// resourceIRI returns the IRI of the resource. An empty string is returned
+// if the resource has no IRI.
+func (s *TurtleSerializer) resourceIRI(o jsonObject, fullURL string) string {
+	if fullURL != "" {
+		return fullURL
+	}
+	id, _ := memberValue(o, "id").(string)
+	if s.baseURL == "" || id == "" {
+		return ""
+	}
+	return s.baseURL + o[0].value.(string) + "/" + id
+}
+
+// referenceIRI returns the IRI of a referenced resource. Absolute
+// references are returned unchanged and relative references are resolved
+// with the base URL. An empty string is returned for references to
+// contained resources or if relative references cannot be resolved.
+func (s *TurtleSerializer) referenceIRI(ref string) string {
+	if strings.Contains(ref, "://") || strings.HasPrefix(ref, "urn:") {
+		return ref
+	}
+	if strings.HasPrefix(ref, "#") || s.baseURL == "" {
+		return ""
+	}
+	return s.baseURL + ref
+}
+
+// predicate returns the name of the predicate of the property of the
+// specified type. The name consists of the path of the type that defines
+// the property and the name of the property.
+func (s *TurtleSerializer) predicate(typeDef common.StructTypeDefAccessor, name string) string {
+	s.pathsOnce.Do(s.initPaths)
+
+	definingTypeDef := typeDef
+	for b := typeDef.Base(); b != nil; b = b.Base() {
+		if sb, ok := b.(common.StructTypeDefAccessor); ok && sb.PropByName(name) != nil {
+			definingTypeDef = sb
+		}
+	}
+	path := s.paths[definingTypeDef.InternalName()]
+	if path == "" {
+		path = definingTypeDef.InternalName()
+	}
+	return "fhir:" + path + "." + name
+}
+
+// initPaths determines the element paths of the anonymous types (e.g.
+// Bundle.entry.request for the type of the request of a Bundle entry). If an
+// anonymous type is used at several paths, the shortest path is used.
+func (s *TurtleSerializer) initPaths() {
+	s.paths = make(map[string]string)
+	var queue []common.StructTypeDefAccessor
+	names := s.typeDefContainer.TypeNames()
+	sort.Strings(names)
+	for _, name := range names {
+		if td, ok := s.typeDefContainer.TypeByName(name).(common.StructTypeDefAccessor); ok && !td.Anonymous() {
+			s.paths[td.InternalName()] = td.Name()
+			queue = append(queue, td)
+		}
+	}
+
+	for len(queue) > 0 {
+		td := queue[0]
+		queue = queue[1:]
+		for _, p := range td.Props() {
+			pt, ok := p.Type().(common.StructTypeDefAccessor)
+			if !ok || !pt.Anonymous() {
+				continue
+			}
+			if _, found := s.paths[pt.InternalName()]; !found {
+				s.paths[pt.InternalName()] = s.paths[td.InternalName()] + "." + p.Name()
+				queue = append(queue, pt)
+			}
+		}
+	}
+}
+
+type turtleResource struct {
+	iri string
+	o   jsonObject
+}
+
+// turtleNode is the subject or blank node whose predicates are written.
+type turtleNode struct {
+	depth int
+	first bool
+}
+
+// turtleWriter writes normalized JSON objects as Turtle. Resources with an
+// IRI that are contained in other resources are written after the resource
+// that contains them.
+type turtleWriter struct {
+	serializer *TurtleSerializer
+	buf        *bytes.Buffer
+	pending    []turtleResource
+	blankNodes int
+}
+
+func (w *turtleWriter) subject(iri string, o jsonObject, root bool) {
+	resourceType := o[0].value.(string)
+	w.buf.WriteByte('\n')
+	if iri == "" {
+		w.blankNodes++
+		fmt.Fprintf(w.buf, "_:b%d", w.blankNodes)
+	} else {
+		w.buf.WriteString(turtleIRI(iri))
+	}
+	w.buf.WriteString(" a fhir:")
+	w.buf.WriteString(resourceType)
+
+	n := &turtleNode{depth: 1}
+	if root {
+		w.predicate(n, "fhir:nodeRole")
+		w.buf.WriteString("fhir:treeRoot")
+	}
+	w.members(n, w.serializer.typeDefContainer.MandatoryStructTypeByName(resourceType), o[1:])
+	w.buf.WriteString(" .\n")
+}
+
+// members writes the members of the struct as predicates of the node.
+func (w *turtleWriter) members(n *turtleNode, typeDef common.StructTypeDefAccessor, o jsonObject) {
+	fullURL, _ := memberValue(o, fullURLPropName).(string)
+	for i, m := range o {
+		name := m.name
+		if strings.HasPrefix(name, "_") {
+			if i > 0 && o[i-1].name == name[1:] {
+				continue
+			}
+			name = name[1:]
+		}
+		propDef := typeDef.PropByName(name)
+		predicate := w.serializer.predicate(typeDef, name)
+
+		if primitiveTypeDef, ok := propDef.Type().(common.PrimitiveTypeDefAccessor); ok {
+			value := memberValue(o, name)
+			element := memberValue(o, "_"+name)
+			var link string
+			if ref, ok := value.(string); ok && name == referencePropName && typeDef.Name() == referenceTypeName {
+				link = w.serializer.referenceIRI(ref)
+			}
+			if !propDef.Array() {
+				w.primitive(n, predicate, primitiveTypeDef, value, element, -1, link)
+				continue
+			}
+			values, _ := value.([]interface{})
+			elements, _ := element.([]interface{})
+			count := len(values)
+			if count < len(elements) {
+				count = len(elements)
+			}
+			for j := 0; j < count; j++ {
+				var v, e interface{}
+				if j < len(values) {
+					v = values[j]
+				}
+				if j < len(elements) {
+					e = elements[j]
+				}
+				w.primitive(n, predicate, primitiveTypeDef, v, e, j, "")
+			}
+			continue
+		}
+
+		structTypeDef := propDef.Type().(common.StructTypeDefAccessor)
+		contained := name == containedPropName
+		if items, ok := m.value.([]interface{}); ok {
+			for j, item := range items {
+				w.structValue(n, predicate, structTypeDef, item.(jsonObject), j, "", contained)
+			}
+		} else {
+			w.structValue(n, predicate, structTypeDef, m.value.(jsonObject), -1, fullURL, contained)
+		}
+	}
+}
+
+// structValue writes a struct as blank node. Resources that have an IRI and
+// that are not contained are referenced by their IRI and written later.
+func (w *turtleWriter) structValue(n *turtleNode, predicate string, typeDef common.StructTypeDefAccessor, o jsonObject, index int, fullURL string, contained bool) {
+	w.predicate(n, predicate)
+	if typeDef.TypeKind() == common.ResourceTypeKind {
+		if iri := w.serializer.resourceIRI(o, fullURL); iri != "" && !contained {
+			w.buf.WriteString(turtleIRI(iri))
+			w.pending = append(w.pending, turtleResource{iri, o})
+			return
+		}
+	}
+
+	b := w.startBlankNode(n)
+	if typeDef.TypeKind() == common.ResourceTypeKind {
+		resourceType := o[0].value.(string)
+		w.predicate(b, "a")
+		w.buf.WriteString("fhir:")
+		w.buf.WriteString(resourceType)
+		typeDef = w.serializer.typeDefContainer.MandatoryStructTypeByName(resourceType)
+		o = o[1:]
+	}
+	if index >= 0 {
+		w.predicate(b, "fhir:index")
+		fmt.Fprintf(w.buf, "%d", index)
+	}
+	w.members(b, typeDef, o)
+	w.endBlankNode(n)
+}
+
+// primitive writes a primitive value as blank node with the value, the index
+// of the list item, the id and the extensions of the element of the value
+// and the link to the referenced resource. XHTML is written as literal.
+func (w *turtleWriter) primitive(n *turtleNode, predicate string, typeDef common.PrimitiveTypeDefAccessor, value interface{}, element interface{}, index int, link string) {
+	w.predicate(n, predicate)
+	if typeDef.Name() == xhtmlTypeName {
+		w.buf.WriteString(turtleString(value.(string)))
+		return
+	}
+	if element == nil && index < 0 && link == "" {
+		w.buf.WriteString("[ fhir:value ")
+		w.buf.WriteString(turtleLiteral(typeDef, value))
+		w.buf.WriteString(" ]")
+		return
+	}
+
+	b := w.startBlankNode(n)
+	if value != nil {
+		w.predicate(b, "fhir:value")
+		w.buf.WriteString(turtleLiteral(typeDef, value))
+	}
+	if index >= 0 {
+		w.predicate(b, "fhir:index")
+		fmt.Fprintf(w.buf, "%d", index)
+	}
+	if link != "" {
+		w.predicate(b, "fhir:link")
+		w.buf.WriteString(turtleIRI(link))
+	}
+	if element != nil {
+		w.members(b, w.serializer.typeDefContainer.ElementType(), element.(jsonObject))
+	}
+	w.endBlankNode(n)
+}
+
+// predicate starts a new predicate of the node. Predicates are separated by
+// semicolons.
+func (w *turtleWriter) predicate(n *turtleNode, predicate string) {
+	if n.first {
+		w.buf.WriteByte('\n')
+		n.first = false
+	} else {
+		w.buf.WriteString(" ;\n")
+	}
+	w.indent(n.depth)
+	w.buf.WriteString(predicate)
+	w.buf.WriteByte(' ')
+}
+
+func (w *turtleWriter) startBlankNode(n *turtleNode) *turtleNode {
+	w.buf.WriteByte('[')
+	return &turtleNode{depth: n.depth + 1, first: true}
+}
+
+func (w *turtleWriter) endBlankNode(n *turtleNode) {
+	w.buf.WriteByte('\n')
+	w.indent(n.depth)
+	w.buf.WriteByte(']')
+}
+
+func (w *turtleWriter) indent(depth int) {
+	for i := 0; i < depth; i++ {
+		w.buf.WriteString(turtleIndent)
+	}
+}
+
+// turtleXSDTypes contains the XML schema types of the primitive types whose
+// values are written as typed literals, including integers. Values of other
+// types are written as plain string literals unless one of their base types
+// is included. Boolean values are written as plain boolean literals.
+var turtleXSDTypes = map[string]string{
+	common.Base64BinaryTypeName: "xsd:base64Binary",
+	common.DecimalTypeName:      "xsd:decimal",
+	common.InstantTypeName:      "xsd:dateTime",
+	common.IntegerTypeName:      "xsd:integer",
+	common.PositiveIntTypeName:  "xsd:positiveInteger",
+	common.TimeTypeName:         "xsd:time",
+	common.UnsignedIntTypeName:  "xsd:nonNegativeInteger",
+	common.URITypeName:          "xsd:anyURI",
+}
+
+// turtleLiteral returns the literal of a primitive value. Dates with
+// reduced precision are written as xsd:gYear or xsd:gYearMonth.
+func turtleLiteral(typeDef common.PrimitiveTypeDefAccessor, value interface{}) string {
+	text := primitiveText(value)
+	if typeDef.SimpleType() == common.BoolSimpleType {
+		return text
+	}
+
+	var xsdType string
+	for td := common.TypeDefAccessor(typeDef); td != nil && xsdType == ""; td = td.Base() {
+		switch td.Name() {
+		case common.DateTypeName, common.DateTimeTypeName:
+			switch {
+			case len(text) <= 4:
+				xsdType = "xsd:gYear"
+			case len(text) <= 7:
+				xsdType = "xsd:gYearMonth"
+			case len(text) <= 10:
+				xsdType = "xsd:date"
+			default:
+				xsdType = "xsd:dateTime"
+			}
+		default:
+			xsdType = turtleXSDTypes[td.Name()]
+		}
+	}
+	if xsdType == "" {
+		return turtleString(text)
+	}
+	return turtleString(text) + "^^" + xsdType
+}
+
+func turtleString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+func turtleIRI(iri string) string {
+	var b strings.Builder
+	b.WriteByte('<')
+	for _, r := range iri {
+		if r <= 0x20 || strings.ContainsRune("<>\"{}|^`\\", r) {
+			fmt.Fprintf(&b, "\\u%04X", r)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('>')
+	return b.String()
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package serialization
+
+import (
+	"bytes"
+	"github.com/healthiop/hi/internal/dynamic"
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/healthiop/hi/internal/stu3"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"testing"
+)
+
+func TestTurtleSerializerBundle(t *testing.T) {
+	s := NewTurtleSerializer(r4.TypeDefContainer(), "http://example.com/fhir")
+	assert.Same(t, r4.TypeDefContainer(), s.TypeDefContainer())
+	assert.Equal(t, "http://example.com/fhir/", s.BaseURL())
+
+	b, err := s.SerializeData(readTestDataNumber(t, "bundle"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, string(readTestTurtleFile(t, "bundle")), string(b))
+	}
+}
+
+func TestTurtleSerializerWithoutBaseURL(t *testing.T) {
+	b, err := NewTurtleSerializer(r4.TypeDefContainer(), "").SerializeData(map[string]interface{}{
+		"resourceType": "Observation",
+		"id":           "obs1",
+		"status":       "final",
+		"code":         map[string]interface{}{"text": "Test \"1\"\nLine 2"},
+		"subject":      map[string]interface{}{"reference": "Patient/example"},
+		"focus": []interface{}{
+			map[string]interface{}{"reference": "http://example.com/fhir/Patient/other"},
+		},
+		"effectiveDateTime": "2021-05",
+		"issued":            "2021-05-06T10:12:13Z",
+		"valueInteger":      12,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, `@prefix fhir: <http://hl7.org/fhir/> .
+@prefix owl: <http://www.w3.org/2002/07/owl#> .
+@prefix rdf: <http://www.w3.org/1999/02/22-rdf-syntax-ns#> .
+@prefix xsd: <http://www.w3.org/2001/XMLSchema#> .
+
+_:b1 a fhir:Observation ;
+  fhir:nodeRole fhir:treeRoot ;
+  fhir:Resource.id [ fhir:value "obs1" ] ;
+  fhir:Observation.status [ fhir:value "final" ] ;
+  fhir:Observation.code [
+    fhir:CodeableConcept.text [ fhir:value "Test \"1\"\nLine 2" ]
+  ] ;
+  fhir:Observation.subject [
+    fhir:Reference.reference [ fhir:value "Patient/example" ]
+  ] ;
+  fhir:Observation.focus [
+    fhir:index 0 ;
+    fhir:Reference.reference [
+      fhir:value "http://example.com/fhir/Patient/other" ;
+      fhir:link <http://example.com/fhir/Patient/other>
+    ]
+  ] ;
+  fhir:Observation.effectiveDateTime [ fhir:value "2021-05"^^xsd:gYearMonth ] ;
+  fhir:Observation.issued [ fhir:value "2021-05-06T10:12:13Z"^^xsd:dateTime ] ;
+  fhir:Observation.valueInteger [ fhir:value "12"^^xsd:integer ] .
+`, string(b))
+	}
+}
+
+func TestTurtleSerializerReferenceLink(t *testing.T) {
+	b, err := NewTurtleSerializer(r4.TypeDefContainer(), "http://example.com/fhir/").SerializeData(map[string]interface{}{
+		"resourceType": "Observation",
+		"status":       "final",
+		"code":         map[string]interface{}{"text": "Test"},
+		"subject":      map[string]interface{}{"reference": "Patient/example"},
+	})
+	if assert.NoError(t, err) {
+		assert.Contains(t, string(b), "\n_:b1 a fhir:Observation ;\n")
+		assert.Contains(t, string(b), "  fhir:Observation.subject [\n"+
+			"    fhir:Reference.reference [\n"+
+			"      fhir:value \"Patient/example\" ;\n"+
+			"      fhir:link <http://example.com/fhir/Patient/example>\n"+
+			"    ]\n"+
+			"  ] .\n")
+		assert.NotContains(t, string(b), "owl:Ontology")
+	}
+}
+
+func TestTurtleSerializerSerialize(t *testing.T) {
+	resource, err := dynamic.NewDynResource(r4.TypeDefContainer(), readTestData(t, "patient"), nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	var buf bytes.Buffer
+	if assert.NoError(t, NewTurtleSerializer(r4.TypeDefContainer(), "http://example.com/fhir").Write(&buf, resource)) {
+		assert.Contains(t, buf.String(), "\n<http://example.com/fhir/Patient/example> a fhir:Patient ;\n"+
+			"  fhir:nodeRole fhir:treeRoot ;\n"+
+			"  fhir:Resource.id [ fhir:value \"example\" ] ;\n")
+	}
+
+	_, err = NewTurtleSerializer(stu3.TypeDefContainer(), "").Serialize(resource)
+	if assert.Error(t, err) {
+		assert.Equal(t, "resource has FHIR version 4.0.1 instead of 3.0.2", err.Error())
+	}
+}
+
+func TestTurtleSerializerInvalid(t *testing.T) {
+	_, err := NewTurtleSerializer(r4.TypeDefContainer(), "").SerializeData(map[string]interface{}{
+		"resourceType": "Patient",
+		"test":         "1",
+	})
+	if assert.Error(t, err) {
+		assert.Equal(t, "Patient: property test is not defined by type Patient", err.Error())
+	}
+}
+
+func TestTurtleSerializerPredicate(t *testing.T) {
+	s := NewTurtleSerializer(r4.TypeDefContainer(), "")
+	c := r4.TypeDefContainer()
+	assert.Equal(t, "fhir:Resource.id", s.predicate(c.MandatoryStructTypeByName("Patient"), "id"))
+	assert.Equal(t, "fhir:DomainResource.text", s.predicate(c.MandatoryStructTypeByName("Patient"), "text"))
+	assert.Equal(t, "fhir:Patient.contact.name", s.predicate(c.MandatoryStructTypeByName("Patient_Contact"), "name"))
+	assert.Equal(t, "fhir:Element.id", s.predicate(c.MandatoryStructTypeByName("Patient_Contact"), "id"))
+	assert.Equal(t, "fhir:Bundle.entry.request.method", s.predicate(c.MandatoryStructTypeByName("Bundle_Request"), "method"))
+}
+
+func TestTurtleIRI(t *testing.T) {
+	assert.Equal(t, `<http://example.com/a\u0020b\u003E>`, turtleIRI("http://example.com/a b>"))
+}
+
+func readTestTurtleFile(t *testing.T, name string) []byte {
+	b, err := ioutil.ReadFile("testdata/" + name + ".ttl.golden")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
@@ -0,0 +1,494 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package serialization
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"github.com/healthiop/hi"
+	"github.com/healthiop/hi/internal/common"
+	"github.com/healthiop/hi/internal/dynamic"
+	"io"
+	"io/ioutil"
+)
+
+const (
+	FHIRNamespace  = "http://hl7.org/fhir"
+	XHTMLNamespace = "http://www.w3.org/1999/xhtml"
+
+	xhtmlTypeName = "xhtml"
+	valueAttrName = "value"
+)
+
+// XMLParser parses resources in FHIR XML format into the same data that
+// results from resources in FHIR JSON format.
+type XMLParser struct {
+	typeDefContainer *common.TypeDefContainer
+}
+
+// XMLSyntaxError describes an error in the XML input. The line number starts
+// with 1.
+type XMLSyntaxError struct {
+	Line int
+	Msg  string
+}
+
+func (e *XMLSyntaxError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Msg)
+}
+
+func NewXMLParser(typeDefContainer *common.TypeDefContainer) *XMLParser {
+	return &XMLParser{typeDefContainer}
+}
+
+func (p *XMLParser) TypeDefContainer() *common.TypeDefContainer {
+	return p.typeDefContainer
+}
+
+// Parse parses a resource in FHIR XML format from the reader.
+func (p *XMLParser) Parse(r io.Reader) (hi.DynResourceAccessor, error) {
+	data, err := p.ParseData(r)
+	if err != nil {
+		return nil, err
+	}
+	return dynamic.NewDynResource(p.typeDefContainer, data, nil)
+}
+
+// ParseData parses a resource in FHIR XML format from the reader and
+// returns the resource data in the structure of FHIR JSON. Decimal values
+// are returned as json.Number to keep their exact representation. Errors
+// in the input are returned as XMLSyntaxError.
+func (p *XMLParser) ParseData(r io.Reader) (map[string]interface{}, error) {
+	input, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	xp := &xmlParsing{
+		typeDefContainer: p.typeDefContainer,
+		input:            input,
+		decoder:          xml.NewDecoder(bytes.NewReader(input)),
+	}
+	start, err := xp.rootElement()
+	if err != nil {
+		return nil, err
+	}
+	data, err := xp.resource(start, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := xp.end(); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+type xmlParsing struct {
+	typeDefContainer *common.TypeDefContainer
+	input            []byte
+	decoder          *xml.Decoder
+	tokenOffset      int64
+}
+
+// line returns the line number of the input offset.
+func (xp *xmlParsing) line(offset int64) int {
+	if offset > int64(len(xp.input)) {
+		offset = int64(len(xp.input))
+	}
+	return bytes.Count(xp.input[:offset], []byte{'\n'}) + 1
+}
+
+// errorf returns an error at the start of the most recently read token.
+func (xp *xmlParsing) errorf(format string, a ...interface{}) error {
+	return &XMLSyntaxError{xp.line(xp.tokenOffset), fmt.Sprintf(format, a...)}
+}
+
+func (xp *xmlParsing) token() (xml.Token, error) {
+	offset := xp.decoder.InputOffset()
+	t, err := xp.decoder.Token()
+	if err != nil {
+		var syntaxError *xml.SyntaxError
+		if errors.As(err, &syntaxError) {
+			return nil, &XMLSyntaxError{syntaxError.Line, syntaxError.Msg}
+		}
+		if err == io.EOF {
+			return nil, err
+		}
+		return nil, &XMLSyntaxError{xp.line(offset), err.Error()}
+	}
+	raw := xp.input[offset:xp.decoder.InputOffset()]
+	xp.tokenOffset = offset + int64(len(raw)-len(bytes.TrimLeft(raw, " \t\r\n")))
+	return t, nil
+}
+
+func (xp *xmlParsing) rootElement() (xml.StartElement, error) {
+	for {
+		t, err := xp.token()
+		if err == io.EOF {
+			return xml.StartElement{}, &XMLSyntaxError{xp.line(xp.decoder.InputOffset()), "document contains no resource"}
+		}
+		if err != nil {
+			return xml.StartElement{}, err
+		}
+		switch t := t.(type) {
+		case xml.StartElement:
+			return t, nil
+		case xml.CharData:
+			if len(bytes.TrimSpace(t)) > 0 {
+				return xml.StartElement{}, xp.errorf("unexpected text content")
+			}
+		}
+	}
+}
+
+func (xp *xmlParsing) end() error {
+	for {
+		t, err := xp.token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch t := t.(type) {
+		case xml.StartElement:
+			return xp.errorf("unexpected element after resource: %s", t.Name.Local)
+		case xml.CharData:
+			if len(bytes.TrimSpace(t)) > 0 {
+				return xp.errorf("unexpected text content after resource")
+			}
+		}
+	}
+}
+
+// resource parses the resource of the start element. If the expected type
+// is not nil, the resource type must extend the expected type.
+func (xp *xmlParsing) resource(start xml.StartElement, expected common.TypeDefAccessor) (map[string]interface{}, error) {
+	if start.Name.Space != FHIRNamespace {
+		return nil, xp.errorf("element %s has namespace %q instead of %q",
+			start.Name.Local, start.Name.Space, FHIRNamespace)
+	}
+	resourceType := start.Name.Local
+	typeDef, ok := xp.typeDefContainer.TypeByName(resourceType).(common.StructTypeDefAccessor)
+	if !ok || typeDef.TypeKind() != common.ResourceTypeKind {
+		return nil, xp.errorf("resource type undefined in FHIR %s: %s",
+			xp.typeDefContainer.SymbolicVersion(), resourceType)
+	}
+	if expected != nil && !typeDef.ExtendsTypeName(expected.InternalName()) {
+		return nil, xp.errorf("resource type %s is not allowed, expected %s", resourceType, expected.Name())
+	}
+	for _, a := range start.Attr {
+		if !isNamespaceAttr(a) {
+			return nil, xp.errorf("resource %s must not have attribute %s", resourceType, a.Name.Local)
+		}
+	}
+
+	data := map[string]interface{}{dynamic.ResourceTypePropName: resourceType}
+	if err := xp.structContent(typeDef, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// wrappedResource parses the single resource that is wrapped by the start
+// element of a property with a resource type (e.g. contained).
+func (xp *xmlParsing) wrappedResource(start xml.StartElement, typeDef common.TypeDefAccessor) (map[string]interface{}, error) {
+	for _, a := range start.Attr {
+		if !isNamespaceAttr(a) {
+			return nil, xp.errorf("element %s must not have attribute %s", start.Name.Local, a.Name.Local)
+		}
+	}
+
+	var data map[string]interface{}
+	for {
+		t, err := xp.contentToken()
+		if err != nil {
+			return nil, err
+		}
+		switch t := t.(type) {
+		case xml.StartElement:
+			if data != nil {
+				return nil, xp.errorf("element %s must contain a single resource", start.Name.Local)
+			}
+			if data, err = xp.resource(t, typeDef); err != nil {
+				return nil, err
+			}
+		case xml.EndElement:
+			if data == nil {
+				return nil, xp.errorf("element %s contains no resource", start.Name.Local)
+			}
+			return data, nil
+		}
+	}
+}
+
+// structContent parses the child elements of a struct into the data until
+// the end element of the struct has been read.
+func (xp *xmlParsing) structContent(typeDef common.StructTypeDefAccessor, data map[string]interface{}) error {
+	for {
+		t, err := xp.contentToken()
+		if err != nil {
+			return err
+		}
+		switch t := t.(type) {
+		case xml.StartElement:
+			if err := xp.prop(typeDef, t, data); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// contentToken returns the next start or end element. Comments and
+// whitespace are skipped.
+func (xp *xmlParsing) contentToken() (xml.Token, error) {
+	for {
+		t, err := xp.token()
+		if err == io.EOF {
+			return nil, &XMLSyntaxError{xp.line(xp.decoder.InputOffset()), "unexpected end of document"}
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := t.(type) {
+		case xml.StartElement, xml.EndElement:
+			return t, nil
+		case xml.CharData:
+			if len(bytes.TrimSpace(t)) > 0 {
+				return nil, xp.errorf("unexpected text content")
+			}
+		}
+	}
+}
+
+func (xp *xmlParsing) prop(typeDef common.StructTypeDefAccessor, start xml.StartElement, data map[string]interface{}) error {
+	name := start.Name.Local
+	propDef := typeDef.PropByName(name)
+	if propDef != nil && propDef.Type().Name() == xhtmlTypeName {
+		if start.Name.Space != XHTMLNamespace {
+			return xp.errorf("element %s has namespace %q instead of %q",
+				name, start.Name.Space, XHTMLNamespace)
+		}
+		return xp.xhtmlProp(propDef, data)
+	}
+	if start.Name.Space != FHIRNamespace {
+		return xp.errorf("element %s has namespace %q instead of %q",
+			name, start.Name.Space, FHIRNamespace)
+	}
+	if propDef == nil {
+		return xp.errorf("property %s is not defined by type %s", name, typeDef.InternalName())
+	}
+	if !propDef.Array() && (data[name] != nil || data["_"+name] != nil) {
+		return xp.errorf("property %s of type %s must not be repeated", name, typeDef.InternalName())
+	}
+
+	switch propTypeDef := propDef.Type().(type) {
+	case common.PrimitiveTypeDefAccessor:
+		value, element, err := xp.primitive(propTypeDef, start)
+		if err != nil {
+			return err
+		}
+		addPrimitive(data, propDef, value, element)
+		return nil
+	case common.StructTypeDefAccessor:
+		var value map[string]interface{}
+		var err error
+		if propTypeDef.TypeKind() == common.ResourceTypeKind {
+			value, err = xp.wrappedResource(start, propTypeDef)
+		} else {
+			value, err = xp.element(propTypeDef, start)
+		}
+		if err != nil {
+			return err
+		}
+		if propDef.Array() {
+			items, _ := data[name].([]interface{})
+			data[name] = append(items, value)
+		} else {
+			data[name] = value
+		}
+		return nil
+	default:
+		panic(fmt.Sprintf("unhandled type definition: %T", propTypeDef))
+	}
+}
+
+// element parses an element with a complex type. Attributes are mapped to
+// primitive properties (e.g. id and url).
+func (xp *xmlParsing) element(typeDef common.StructTypeDefAccessor, start xml.StartElement) (map[string]interface{}, error) {
+	data := make(map[string]interface{})
+	for _, a := range start.Attr {
+		if isNamespaceAttr(a) {
+			continue
+		}
+		propDef := typeDef.PropByName(a.Name.Local)
+		if a.Name.Space != "" || propDef == nil || propDef.Array() ||
+			propDef.Type().TypeKind() != common.PrimitiveTypeKind {
+			return nil, xp.errorf("element %s must not have attribute %s", start.Name.Local, a.Name.Local)
+		}
+		value, err := xp.primitiveValue(propDef.Type().(common.PrimitiveTypeDefAccessor), a.Value)
+		if err != nil {
+			return nil, err
+		}
+		data[a.Name.Local] = value
+	}
+	if err := xp.structContent(typeDef, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// primitive parses a primitive element. The value is contained in the value
+// attribute, the id and the extensions of the primitive are returned as
+// element data.
+func (xp *xmlParsing) primitive(typeDef common.PrimitiveTypeDefAccessor, start xml.StartElement) (interface{}, map[string]interface{}, error) {
+	var value interface{}
+	element := make(map[string]interface{})
+	for _, a := range start.Attr {
+		if isNamespaceAttr(a) {
+			continue
+		}
+		if a.Name.Space != "" {
+			return nil, nil, xp.errorf("element %s must not have attribute %s", start.Name.Local, a.Name.Local)
+		}
+		switch a.Name.Local {
+		case valueAttrName:
+			v, err := xp.primitiveValue(typeDef, a.Value)
+			if err != nil {
+				return nil, nil, err
+			}
+			value = v
+		case "id":
+			element["id"] = a.Value
+		default:
+			return nil, nil, xp.errorf("element %s must not have attribute %s", start.Name.Local, a.Name.Local)
+		}
+	}
+	if err := xp.structContent(xp.typeDefContainer.ElementType(), element); err != nil {
+		return nil, nil, err
+	}
+	if value == nil && len(element) == 0 {
+		return nil, nil, xp.errorf("element %s has neither a value nor an id or extensions", start.Name.Local)
+	}
+	if len(element) == 0 {
+		element = nil
+	}
+	return value, element, nil
+}
+
+func (xp *xmlParsing) primitiveValue(typeDef common.PrimitiveTypeDefAccessor, value string) (interface{}, error) {
+	switch typeDef.SimpleType() {
+	case common.BoolSimpleType:
+		switch value {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+		return nil, xp.errorf("invalid value of type %s: %s", typeDef.Name(), value)
+	case common.NumberSimpleType:
+		n := json.Number(value)
+		if _, err := n.Float64(); err != nil || !json.Valid([]byte(value)) {
+			return nil, xp.errorf("invalid value of type %s: %s", typeDef.Name(), value)
+		}
+		return n, nil
+	default:
+		return value, nil
+	}
+}
+
+// xhtmlProp reads the XHTML div of a narrative as it is contained in the
+// input.
+func (xp *xmlParsing) xhtmlProp(propDef *common.PropDef, data map[string]interface{}) error {
+	if data[propDef.Name()] != nil {
+		return xp.errorf("property %s must not be repeated", propDef.Name())
+	}
+	start := xp.tokenOffset
+	if err := xp.decoder.Skip(); err != nil {
+		var syntaxError *xml.SyntaxError
+		if errors.As(err, &syntaxError) {
+			return &XMLSyntaxError{syntaxError.Line, syntaxError.Msg}
+		}
+		return xp.errorf("%v", err)
+	}
+	data[propDef.Name()] = string(xp.input[start:xp.decoder.InputOffset()])
+	return nil
+}
+
+// addPrimitive adds the value and the element of a primitive to the data.
+// Lists of values and elements are aligned with nil.
+func addPrimitive(data map[string]interface{}, propDef *common.PropDef, value interface{}, element map[string]interface{}) {
+	name := propDef.Name()
+	if !propDef.Array() {
+		if value != nil {
+			data[name] = value
+		}
+		if element != nil {
+			data["_"+name] = element
+		}
+		return
+	}
+
+	values, _ := data[name].([]interface{})
+	elements, _ := data["_"+name].([]interface{})
+	count := len(values)
+	if count < len(elements) {
+		count = len(elements)
+	}
+	if value != nil || values != nil {
+		values = appendAligned(values, count, value)
+		data[name] = values
+	}
+	if element != nil || elements != nil {
+		var e interface{}
+		if element != nil {
+			e = element
+		}
+		elements = appendAligned(elements, count, e)
+		data["_"+name] = elements
+	}
+}
+
+// appendAligned appends the item to the list after the list has been filled
+// up with nil to the specified count.
+func appendAligned(items []interface{}, count int, item interface{}) []interface{} {
+	for len(items) < count {
+		items = append(items, nil)
+	}
+	return append(items, item)
+}
+
+func isNamespaceAttr(a xml.Attr) bool {
+	return a.Name.Space == "xmlns" || (a.Name.Space == "" && a.Name.Local == "xmlns")
+}
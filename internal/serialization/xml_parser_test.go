@@ -0,0 +1,149 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package serialization
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestXMLParserParseData(t *testing.T) {
+	p := NewXMLParser(r4.TypeDefContainer())
+	assert.Same(t, r4.TypeDefContainer(), p.TypeDefContainer())
+
+	data, err := p.ParseData(bytes.NewReader(readTestXMLFile(t, "bundle")))
+	if assert.NoError(t, err) {
+		assert.Equal(t, readTestDataNumber(t, "bundle"), data)
+	}
+}
+
+func TestXMLParserParse(t *testing.T) {
+	resource, err := NewXMLParser(r4.TypeDefContainer()).Parse(bytes.NewReader(readTestXMLFile(t, "bundle")))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "Bundle", resource.TypeName())
+	v, err := resource.StringPropValue("type")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "collection", v)
+	}
+}
+
+func TestXMLParserParseSerialize(t *testing.T) {
+	data, err := NewXMLParser(r4.TypeDefContainer()).ParseData(bytes.NewReader(readTestXMLFile(t, "bundle")))
+	if !assert.NoError(t, err) {
+		return
+	}
+	s := NewJSONSerializer(r4.TypeDefContainer(), false)
+	b1, err := s.SerializeData(data)
+	if !assert.NoError(t, err) {
+		return
+	}
+	b2, err := s.SerializeData(readTestDataNumber(t, "bundle"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, string(b2), string(b1))
+		assert.Contains(t, string(b1), `"value":67.50`)
+	}
+}
+
+func TestXMLParserParseInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		xml  string
+		err  string
+	}{
+		{"empty", "", "line 1: document contains no resource"},
+		{"syntax", "<Patient xmlns=\"http://hl7.org/fhir\">\n<id value=\"1\">\n</Patient>",
+			"line 3: element <id> closed by </Patient>"},
+		{"namespace", "<Patient>\n</Patient>",
+			"line 1: element Patient has namespace \"\" instead of \"http://hl7.org/fhir\""},
+		{"resource type", "<Test xmlns=\"http://hl7.org/fhir\"/>",
+			"line 1: resource type undefined in FHIR R4: Test"},
+		{"resource attribute", "<Patient xmlns=\"http://hl7.org/fhir\" id=\"1\"/>",
+			"line 1: resource Patient must not have attribute id"},
+		{"unknown property", "<Patient xmlns=\"http://hl7.org/fhir\">\n  <id value=\"1\"/>\n  <test value=\"1\"/>\n</Patient>",
+			"line 3: property test is not defined by type Patient"},
+		{"repeated", "<Patient xmlns=\"http://hl7.org/fhir\">\n  <id value=\"1\"/>\n  <id value=\"2\"/>\n</Patient>",
+			"line 3: property id of type Patient must not be repeated"},
+		{"boolean", "<Patient xmlns=\"http://hl7.org/fhir\">\n  <active value=\"yes\"/>\n</Patient>",
+			"line 2: invalid value of type boolean: yes"},
+		{"number", "<Patient xmlns=\"http://hl7.org/fhir\">\n  <multipleBirthInteger value=\"1a\"/>\n</Patient>",
+			"line 2: invalid value of type integer: 1a"},
+		{"empty primitive", "<Patient xmlns=\"http://hl7.org/fhir\">\n  <active/>\n</Patient>",
+			"line 2: element active has neither a value nor an id or extensions"},
+		{"primitive attribute", "<Patient xmlns=\"http://hl7.org/fhir\">\n  <active value=\"true\" url=\"x\"/>\n</Patient>",
+			"line 2: element active must not have attribute url"},
+		{"element attribute", "<Patient xmlns=\"http://hl7.org/fhir\">\n  <name value=\"x\"/>\n</Patient>",
+			"line 2: element name must not have attribute value"},
+		{"text", "<Patient xmlns=\"http://hl7.org/fhir\">\n  <name>\n    Doe\n  </name>\n</Patient>",
+			"line 3: unexpected text content"},
+		{"contained", "<Patient xmlns=\"http://hl7.org/fhir\">\n  <contained>\n  </contained>\n</Patient>",
+			"line 3: element contained contains no resource"},
+		{"contained multiple", "<Patient xmlns=\"http://hl7.org/fhir\">\n  <contained>\n    <Patient/>\n    <Patient/>\n  </contained>\n</Patient>",
+			"line 4: element contained must contain a single resource"},
+		{"div namespace", "<Patient xmlns=\"http://hl7.org/fhir\">\n  <text>\n    <div>Test</div>\n  </text>\n</Patient>",
+			"line 3: element div has namespace \"http://hl7.org/fhir\" instead of \"http://www.w3.org/1999/xhtml\""},
+		{"after resource", "<Patient xmlns=\"http://hl7.org/fhir\"/>\n<Patient xmlns=\"http://hl7.org/fhir\"/>",
+			"line 2: unexpected element after resource: Patient"},
+		{"unexpected end", "<Patient xmlns=\"http://hl7.org/fhir\">\n  <name>",
+			"line 2: unexpected EOF"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewXMLParser(r4.TypeDefContainer()).ParseData(strings.NewReader(tt.xml))
+			if assert.Error(t, err) {
+				assert.IsType(t, &XMLSyntaxError{}, err)
+				assert.Equal(t, tt.err, err.Error())
+			}
+		})
+	}
+}
+
+func readTestDataNumber(t *testing.T, name string) map[string]interface{} {
+	var data map[string]interface{}
+	d := json.NewDecoder(bytes.NewReader(readTestFile(t, name)))
+	d.UseNumber()
+	if err := d.Decode(&data); err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func readTestXMLFile(t *testing.T, name string) []byte {
+	b, err := ioutil.ReadFile("testdata/" + name + ".xml.golden")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
@@ -0,0 +1,330 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package serialization
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"github.com/healthiop/hi"
+	"github.com/healthiop/hi/internal/common"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	xmlIndent            = "  "
+	extensionURLPropName = "url"
+	elementIDPropName    = "id"
+)
+
+// XMLSerializer writes resources in FHIR XML format. Elements are written in
+// the order of the type definitions. Primitive values are written as value
+// attributes, the ids of elements and the URLs of extensions are written as
+// attributes and the div of a narrative is written as XHTML.
+type XMLSerializer struct {
+	typeDefContainer *common.TypeDefContainer
+	pretty           bool
+}
+
+func NewXMLSerializer(typeDefContainer *common.TypeDefContainer, pretty bool) *XMLSerializer {
+	return &XMLSerializer{typeDefContainer, pretty}
+}
+
+func (s *XMLSerializer) TypeDefContainer() *common.TypeDefContainer {
+	return s.typeDefContainer
+}
+
+func (s *XMLSerializer) Pretty() bool {
+	return s.pretty
+}
+
+// Serialize returns the resource in FHIR XML format. The resource must have
+// been created for the FHIR version of the serializer.
+func (s *XMLSerializer) Serialize(resource hi.DynResourceAccessor) ([]byte, error) {
+	data, err := resourceData(s.typeDefContainer, resource)
+	if err != nil {
+		return nil, err
+	}
+	return s.SerializeData(data)
+}
+
+// SerializeData returns the resource data in FHIR XML format. An error is
+// returned if the data contains properties that are not defined or values
+// that do not match the type definitions.
+func (s *XMLSerializer) SerializeData(data map[string]interface{}) ([]byte, error) {
+	o, err := newJSONNormalizer(s.typeDefContainer).resource(data, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	w := &xmlWriter{typeDefContainer: s.typeDefContainer, buf: &buf, pretty: s.pretty}
+	w.resource(o, 0)
+	if s.pretty {
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+// Write writes the resource in FHIR XML format to the writer.
+func (s *XMLSerializer) Write(w io.Writer, resource hi.DynResourceAccessor) error {
+	b, err := s.Serialize(resource)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// xmlWriter writes normalized JSON objects as FHIR XML. The objects have
+// already been checked against the type definitions.
+type xmlWriter struct {
+	typeDefContainer *common.TypeDefContainer
+	buf              *bytes.Buffer
+	pretty           bool
+}
+
+func (w *xmlWriter) resource(o jsonObject, depth int) {
+	resourceType := o[0].value.(string)
+	typeDef := w.typeDefContainer.MandatoryStructTypeByName(resourceType)
+
+	w.newLine(depth)
+	w.buf.WriteByte('<')
+	w.buf.WriteString(resourceType)
+	if depth == 0 {
+		w.attr("xmlns", FHIRNamespace)
+	}
+	w.buf.WriteByte('>')
+	w.members(typeDef, o[1:], depth+1)
+	w.end(resourceType, depth)
+}
+
+// members writes the members of a struct. The element of a primitive value
+// is written together with the value.
+func (w *xmlWriter) members(typeDef common.StructTypeDefAccessor, o jsonObject, depth int) {
+	for i, m := range o {
+		name := m.name
+		if strings.HasPrefix(name, "_") {
+			if i > 0 && o[i-1].name == name[1:] {
+				continue
+			}
+			name = name[1:]
+		}
+		propDef := typeDef.PropByName(name)
+
+		if primitiveTypeDef, ok := propDef.Type().(common.PrimitiveTypeDefAccessor); ok {
+			value := memberValue(o, name)
+			element := memberValue(o, "_"+name)
+			if !propDef.Array() {
+				w.primitive(name, primitiveTypeDef, value, element, depth)
+				continue
+			}
+			values, _ := value.([]interface{})
+			elements, _ := element.([]interface{})
+			count := len(values)
+			if count < len(elements) {
+				count = len(elements)
+			}
+			for j := 0; j < count; j++ {
+				var v, e interface{}
+				if j < len(values) {
+					v = values[j]
+				}
+				if j < len(elements) {
+					e = elements[j]
+				}
+				w.primitive(name, primitiveTypeDef, v, e, depth)
+			}
+			continue
+		}
+
+		structTypeDef := propDef.Type().(common.StructTypeDefAccessor)
+		if items, ok := m.value.([]interface{}); ok {
+			for _, item := range items {
+				w.structValue(name, structTypeDef, item.(jsonObject), depth)
+			}
+		} else {
+			w.structValue(name, structTypeDef, m.value.(jsonObject), depth)
+		}
+	}
+}
+
+func (w *xmlWriter) structValue(name string, typeDef common.StructTypeDefAccessor, o jsonObject, depth int) {
+	if typeDef.TypeKind() == common.ResourceTypeKind {
+		w.newLine(depth)
+		w.start(name)
+		w.buf.WriteByte('>')
+		w.resource(o, depth+1)
+		w.end(name, depth)
+		return
+	}
+	w.element(name, typeDef, o, depth)
+}
+
+// element writes an element with a complex type. The id of the element and
+// the URL of an extension are written as attributes.
+func (w *xmlWriter) element(name string, typeDef common.StructTypeDefAccessor, o jsonObject, depth int) {
+	w.newLine(depth)
+	w.start(name)
+	var children jsonObject
+	for _, m := range o {
+		if m.name == elementIDPropName ||
+			(m.name == extensionURLPropName && typeDef.ExtendsTypeName(common.ExtensionTypeName)) {
+			w.attr(m.name, primitiveText(m.value))
+		} else {
+			children = append(children, m)
+		}
+	}
+	if len(children) == 0 {
+		w.buf.WriteString("/>")
+		return
+	}
+	w.buf.WriteByte('>')
+	w.members(typeDef, children, depth+1)
+	w.end(name, depth)
+}
+
+// primitive writes a primitive value. The id and the extensions of the
+// element of the primitive value are written like the ones of an element.
+func (w *xmlWriter) primitive(name string, typeDef common.PrimitiveTypeDefAccessor, value interface{}, element interface{}, depth int) {
+	if typeDef.Name() == xhtmlTypeName {
+		w.newLine(depth)
+		w.buf.WriteString(xhtmlWithNamespace(value.(string)))
+		return
+	}
+
+	var o jsonObject
+	if element != nil {
+		o = element.(jsonObject)
+	}
+	if value != nil {
+		o = append(jsonObject{{valueAttrName, value}}, o...)
+	}
+
+	w.newLine(depth)
+	w.start(name)
+	var children jsonObject
+	for _, m := range o {
+		if m.name == valueAttrName || m.name == elementIDPropName {
+			w.attr(m.name, primitiveText(m.value))
+		} else {
+			children = append(children, m)
+		}
+	}
+	if len(children) == 0 {
+		w.buf.WriteString("/>")
+		return
+	}
+	w.buf.WriteByte('>')
+	w.members(w.typeDefContainer.ElementType(), children, depth+1)
+	w.end(name, depth)
+}
+
+func (w *xmlWriter) start(name string) {
+	w.buf.WriteByte('<')
+	w.buf.WriteString(name)
+}
+
+func (w *xmlWriter) end(name string, depth int) {
+	w.newLine(depth)
+	w.buf.WriteString("</")
+	w.buf.WriteString(name)
+	w.buf.WriteByte('>')
+}
+
+func (w *xmlWriter) attr(name string, value string) {
+	w.buf.WriteByte(' ')
+	w.buf.WriteString(name)
+	w.buf.WriteString(`="`)
+	if err := xml.EscapeText(w.buf, []byte(value)); err != nil {
+		panic(err)
+	}
+	w.buf.WriteByte('"')
+}
+
+func (w *xmlWriter) newLine(depth int) {
+	if w.pretty && (depth > 0 || w.buf.Len() > 0) {
+		if w.buf.Len() > 0 {
+			w.buf.WriteByte('\n')
+		}
+		for i := 0; i < depth; i++ {
+			w.buf.WriteString(xmlIndent)
+		}
+	}
+}
+
+func memberValue(o jsonObject, name string) interface{} {
+	for _, m := range o {
+		if m.name == name {
+			return m.value
+		}
+	}
+	return nil
+}
+
+func primitiveText(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		panic(fmt.Sprintf("unhandled primitive value: %T", value))
+	}
+}
+
+// xhtmlWithNamespace returns the XHTML div with the declaration of the XHTML
+// namespace, which may be missing in FHIR JSON. The start tag is parsed as
+// XML, so that attribute values are not mistaken for the end of the tag.
+func xhtmlWithNamespace(div string) string {
+	if !strings.HasPrefix(div, "<div") {
+		return div
+	}
+	d := xml.NewDecoder(strings.NewReader(div))
+	d.Strict = false
+	token, err := d.RawToken()
+	if err != nil {
+		return div
+	}
+	start, ok := token.(xml.StartElement)
+	if !ok || start.Name.Space != "" || start.Name.Local != "div" {
+		return div
+	}
+	for _, a := range start.Attr {
+		if a.Name.Space == "" && a.Name.Local == "xmlns" {
+			return div
+		}
+	}
+	return `<div xmlns="` + XHTMLNamespace + `"` + div[len("<div"):]
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package serialization
+
+import (
+	"bytes"
+	"github.com/healthiop/hi/internal/dynamic"
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/healthiop/hi/internal/stu3"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestXMLSerializerRoundTrip(t *testing.T) {
+	p := NewXMLParser(r4.TypeDefContainer())
+	s := NewXMLSerializer(r4.TypeDefContainer(), true)
+	assert.Same(t, r4.TypeDefContainer(), s.TypeDefContainer())
+	assert.True(t, s.Pretty())
+
+	data, err := p.ParseData(bytes.NewReader(readTestXMLFile(t, "bundle")))
+	if !assert.NoError(t, err) {
+		return
+	}
+	b, err := s.SerializeData(data)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, string(readTestXMLFile(t, "bundle_pretty")), string(b))
+
+	parsed, err := p.ParseData(bytes.NewReader(b))
+	if assert.NoError(t, err) {
+		assert.Equal(t, data, parsed)
+	}
+}
+
+func TestXMLSerializerRoundTripJSON(t *testing.T) {
+	data := readTestDataNumber(t, "patient")
+	b, err := NewXMLSerializer(r4.TypeDefContainer(), false).SerializeData(data)
+	if !assert.NoError(t, err) {
+		return
+	}
+	parsed, err := NewXMLParser(r4.TypeDefContainer()).ParseData(bytes.NewReader(b))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	s := NewJSONSerializer(r4.TypeDefContainer(), true)
+	expected, err := s.SerializeData(data)
+	if !assert.NoError(t, err) {
+		return
+	}
+	actual, err := s.SerializeData(parsed)
+	if assert.NoError(t, err) {
+		assert.Equal(t, string(expected), string(actual))
+	}
+}
+
+func TestXMLSerializerCompact(t *testing.T) {
+	b, err := NewXMLSerializer(r4.TypeDefContainer(), false).SerializeData(map[string]interface{}{
+		"resourceType": "Patient",
+		"gender":       "female",
+		"_gender": map[string]interface{}{
+			"id": "g1",
+		},
+		"active": true,
+		"name": []interface{}{
+			map[string]interface{}{"id": "n1", "family": "Doe & Sons"},
+		},
+		"extension": []interface{}{
+			map[string]interface{}{"url": "http://example.com/test", "valueDecimal": 1.5},
+		},
+		"text": map[string]interface{}{
+			"status": "generated",
+			"div":    "<div>Test</div>",
+		},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, `<Patient xmlns="http://hl7.org/fhir">`+
+			`<text><status value="generated"/><div xmlns="http://www.w3.org/1999/xhtml">Test</div></text>`+
+			`<extension url="http://example.com/test"><valueDecimal value="1.5"/></extension>`+
+			`<active value="true"/><name id="n1"><family value="Doe &amp; Sons"/></name>`+
+			`<gender value="female" id="g1"/></Patient>`, string(b))
+	}
+}
+
+func TestXMLSerializerSerialize(t *testing.T) {
+	resource, err := dynamic.NewDynResource(r4.TypeDefContainer(), readTestData(t, "patient"), nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	var buf bytes.Buffer
+	if assert.NoError(t, NewXMLSerializer(r4.TypeDefContainer(), true).Write(&buf, resource)) {
+		assert.Contains(t, buf.String(), "<Patient xmlns=\"http://hl7.org/fhir\">\n  <id value=\"example\"/>\n")
+	}
+
+	_, err = NewXMLSerializer(stu3.TypeDefContainer(), true).Serialize(resource)
+	if assert.Error(t, err) {
+		assert.Equal(t, "resource has FHIR version 4.0.1 instead of 3.0.2", err.Error())
+	}
+}
+
+func TestXMLSerializerInvalid(t *testing.T) {
+	_, err := NewXMLSerializer(r4.TypeDefContainer(), true).SerializeData(map[string]interface{}{
+		"resourceType": "Patient",
+		"test":         "1",
+	})
+	if assert.Error(t, err) {
+		assert.Equal(t, "Patient: property test is not defined by type Patient", err.Error())
+	}
+}
+
+func TestXHTMLWithNamespace(t *testing.T) {
+	assert.Equal(t, `<div xmlns="http://www.w3.org/1999/xhtml">Test</div>`, xhtmlWithNamespace(`<div>Test</div>`))
+	assert.Equal(t, `<div xmlns="http://www.w3.org/1999/xhtml"/>`, xhtmlWithNamespace(`<div/>`))
+	assert.Equal(t, `<div xmlns="http://www.w3.org/1999/xhtml" class="a">Test</div>`,
+		xhtmlWithNamespace(`<div xmlns="http://www.w3.org/1999/xhtml" class="a">Test</div>`))
+	assert.Equal(t, `<div xmlns="http://www.w3.org/1999/xhtml">Test</div>`,
+		xhtmlWithNamespace(`<div xmlns="http://www.w3.org/1999/xhtml">Test</div>`))
+	assert.Equal(t, `<div xmlns="http://www.w3.org/1999/xhtml" title="a/b">Test</div>`,
+		xhtmlWithNamespace(`<div title="a/b">Test</div>`))
+	assert.Equal(t, `<div xmlns="http://www.w3.org/1999/xhtml" title="a/b" class="a>b">Test</div>`,
+		xhtmlWithNamespace(`<div title="a/b" class="a>b">Test</div>`))
+	assert.Equal(t, `<div title="a/b" xmlns="http://www.w3.org/1999/xhtml">Test</div>`,
+		xhtmlWithNamespace(`<div title="a/b" xmlns="http://www.w3.org/1999/xhtml">Test</div>`))
+	assert.Equal(t, `<divx>Test</divx>`, xhtmlWithNamespace(`<divx>Test</divx>`))
+	assert.Equal(t, `Test`, xhtmlWithNamespace(`Test`))
+}
@@ -0,0 +1,520 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	apb "github.com/google/fhir/go/proto/google/fhir/proto/annotations_go_proto"
+	"github.com/healthiop/hi/internal/common"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+type propInfo struct {
+	name     string
+	typeName string
+	choice   string
+	min      int
+	array    bool
+	enum     []string
+}
+
+type typeInfo struct {
+	name       string
+	base       string
+	primitive  bool
+	anonymous  bool
+	pattern    string
+	simpleType common.SimpleType
+	// props contains the properties in the order of their definition.
+	props []propInfo
+	// constraints contains the FHIRPath expressions of the constraints.
+	constraints []string
+}
+
+type generator struct {
+	cfg      *config
+	types    map[string]*typeInfo
+	msgNames map[protoreflect.FullName]string
+}
+
+// generate returns the compact type definitions of the specified FHIR version.
+func generate(cfg *config) []byte {
+	g := &generator{
+		cfg:      cfg,
+		types:    make(map[string]*typeInfo),
+		msgNames: make(map[protoreflect.FullName]string),
+	}
+	g.build()
+	return common.MarshalCompactTypeDefs(g.container())
+}
+
+func (g *generator) find(name string) protoreflect.MessageDescriptor {
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(g.cfg.protoPackage + "." + name))
+	if err != nil {
+		panic(fmt.Sprintf("message %s not found: %v", name, err))
+	}
+	return d.(protoreflect.MessageDescriptor)
+}
+
+func (g *generator) build() {
+	g.types["Element"] = &typeInfo{name: "Element", props: []propInfo{
+		{name: "id", typeName: "string"},
+		{name: "extension", typeName: "Extension", array: true},
+	}}
+	g.types["Resource"] = &typeInfo{name: "Resource", props: []propInfo{
+		{name: "id", typeName: "id"},
+		{name: "meta", typeName: "Meta"},
+		{name: "implicitRules", typeName: "uri"},
+		{name: "language", typeName: "code"},
+	}}
+	g.types["DomainResource"] = &typeInfo{name: "DomainResource", base: "Resource", props: []propInfo{
+		{name: "text", typeName: "Narrative"},
+		{name: "contained", typeName: "Resource", array: true},
+		{name: "extension", typeName: "Extension", array: true},
+		{name: "modifierExtension", typeName: "Extension", array: true},
+	}}
+	g.types["BackboneElement"] = &typeInfo{name: "BackboneElement", base: "Element", props: []propInfo{
+		{name: "modifierExtension", typeName: "Extension", array: true},
+	}}
+
+	var primitiveMsgs, complexMsgs []protoreflect.MessageDescriptor
+	protoregistry.GlobalFiles.RangeFilesByPackage(protoreflect.FullName(g.cfg.protoPackage), func(fd protoreflect.FileDescriptor) bool {
+		path := fd.Path()
+		if strings.Contains(path, "profiles") || strings.Contains(path, "extensions") {
+			return true
+		}
+		msgs := fd.Messages()
+		for i := 0; i < msgs.Len(); i++ {
+			md := msgs.Get(i)
+			name := string(md.Name())
+			if g.cfg.skipped[name] || isSpecializedCode(md) {
+				continue
+			}
+			if bases := proto.GetExtension(md.Options(), apb.E_FhirProfileBase).([]string); len(bases) > 0 && !g.cfg.quantityBased[name] {
+				continue
+			}
+			switch structureDefinitionKind(md) {
+			case apb.StructureDefinitionKindValue_KIND_PRIMITIVE_TYPE:
+				primitiveMsgs = append(primitiveMsgs, md)
+			case apb.StructureDefinitionKindValue_KIND_COMPLEX_TYPE:
+				complexMsgs = append(complexMsgs, md)
+			}
+		}
+		return true
+	})
+
+	for _, md := range primitiveMsgs {
+		g.addPrimitive(md)
+	}
+	for _, md := range complexMsgs {
+		name := string(md.Name())
+		base := "Element"
+		if g.cfg.backboneBased[name] {
+			base = "BackboneElement"
+		} else if g.cfg.quantityBased[name] {
+			base = "Quantity"
+		}
+		g.addStruct(name, base, md, false)
+	}
+
+	fields := g.find("ContainedResource").Fields()
+	for i := 0; i < fields.Len(); i++ {
+		md := fields.Get(i).Message()
+		name := string(md.Name())
+		base := "DomainResource"
+		if g.cfg.resourceBased[name] {
+			base = "Resource"
+		}
+		g.addStruct(name, base, md, false)
+	}
+}
+
+func (g *generator) addPrimitive(md protoreflect.MessageDescriptor) {
+	name := lowerFirst(string(md.Name()))
+	base := "Element"
+	switch name {
+	case "code", "id", "markdown":
+		base = "string"
+	case "oid", "uuid", "url", "canonical":
+		base = "uri"
+	case "positiveInt", "unsignedInt":
+		base = "integer"
+	}
+	simpleType := common.StringSimpleType
+	switch name {
+	case "boolean":
+		simpleType = common.BoolSimpleType
+	case "decimal", "integer", "positiveInt", "unsignedInt":
+		simpleType = common.NumberSimpleType
+	}
+	pattern := proto.GetExtension(md.Options(), apb.E_ValueRegex).(string)
+	if p, found := g.cfg.patterns[name]; found {
+		pattern = p
+	}
+	if pattern != "" {
+		pattern = "^" + pattern + "$"
+	}
+	g.types[name] = &typeInfo{name: name, base: base, primitive: true, pattern: pattern, simpleType: simpleType}
+}
+
+func (g *generator) addStruct(name string, base string, md protoreflect.MessageDescriptor, anonymous bool) {
+	if _, found := g.types[name]; found {
+		panic(fmt.Sprintf("duplicate type %s", name))
+	}
+	t := &typeInfo{name: name, base: base, anonymous: anonymous}
+	g.types[name] = t
+	g.msgNames[md.FullName()] = name
+
+	root := name
+	if i := strings.Index(name, "_"); i > 0 {
+		root = name[:i]
+	}
+
+	reference := false
+	for _, f := range fieldsByNumber(md) {
+		if name == "Reference" && f.ContainingOneof() != nil && f.ContainingOneof().Name() == "reference" {
+			// the different kinds of references are a single string that is
+			// defined before the type of the reference
+			if !reference {
+				t.props = insertProp(t.props, "type", propInfo{name: "reference", typeName: "string"})
+				reference = true
+			}
+			continue
+		}
+		t.props = append(t.props, g.fieldProps(name, root, f)...)
+		g.addFieldConstraints(t, md, f)
+	}
+	messageConstraints := proto.GetExtension(md.Options(), apb.E_FhirPathMessageConstraint).([]string)
+	t.constraints = append(append([]string{}, messageConstraints...), t.constraints...)
+}
+
+// addFieldConstraints adds the constraints of the field. Constraints of a
+// backbone element are added to its type. Constraints of other fields are
+// added to the type that contains the field and are applied to all values of
+// the field (the name of the field is delimited since it may be a keyword,
+// e.g. div). Constraints that are repeated are added only once.
+func (g *generator) addFieldConstraints(t *typeInfo, md protoreflect.MessageDescriptor, f protoreflect.FieldDescriptor) {
+	fmd := f.Message()
+	for _, c := range proto.GetExtension(f.Options(), apb.E_FhirPathConstraint).([]string) {
+		target := t
+		if fmd.Parent() == md && !proto.GetExtension(fmd.Options(), apb.E_IsChoiceType).(bool) {
+			target = g.types[g.msgNames[fmd.FullName()]]
+		} else {
+			c = fmt.Sprintf("`%s`.all(%s)", f.JSONName(), c)
+		}
+		if !containsString(target.constraints, c) {
+			target.constraints = append(target.constraints, c)
+		}
+	}
+}
+
+func (g *generator) fieldProps(typeName string, root string, f protoreflect.FieldDescriptor) []propInfo {
+	jsonName := f.JSONName()
+	md := f.Message()
+	if md == nil {
+		panic(fmt.Sprintf("field %s is not a message", f.FullName()))
+	}
+	min := 0
+	if proto.GetExtension(f.Options(), apb.E_ValidationRequirement).(apb.Requirement) == apb.Requirement_REQUIRED_BY_FHIR {
+		min = 1
+	}
+
+	elementName := typeName + "." + jsonName
+
+	if proto.GetExtension(md.Options(), apb.E_IsChoiceType).(bool) {
+		var props []propInfo
+		fields := md.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			cf := fields.Get(i)
+			props = append(props, propInfo{
+				name:     jsonName + upperFirst(cf.JSONName()),
+				typeName: g.typeName(root, cf, cf.Message()),
+				choice:   jsonName,
+				min:      min,
+			})
+		}
+		for _, tn := range g.cfg.additionalChoiceTypes[elementName] {
+			props = append(props, propInfo{name: jsonName + tn, typeName: tn, choice: jsonName, min: min})
+		}
+		return props
+	}
+
+	p := propInfo{name: jsonName, min: min, array: f.IsList()}
+	if enum, found := g.cfg.enums[elementName]; found {
+		p.typeName = "string"
+		p.enum = enum
+	} else if g.cfg.unboundCodes[elementName] {
+		p.typeName = "code"
+	} else if isSpecializedCode(md) && md.Fields().ByName("value").Kind() == protoreflect.EnumKind {
+		p.typeName = "string"
+		p.enum = excludeCodes(enumCodes(md), g.cfg.excludedCodes[elementName])
+	} else {
+		p.typeName = g.typeName(root, f, md)
+	}
+	return []propInfo{p}
+}
+
+func (g *generator) typeName(root string, f protoreflect.FieldDescriptor, md protoreflect.MessageDescriptor) string {
+	if n, found := g.msgNames[md.FullName()]; found {
+		return n
+	}
+	name := string(md.Name())
+	if isSpecializedCode(md) {
+		return "code"
+	}
+	if parent, nested := md.Parent().(protoreflect.MessageDescriptor); nested &&
+		!(parent.Name() == "Reference" && name == "ReferenceId") {
+		typeName := root + "_" + upperFirst(f.JSONName())
+		for i := 1; g.types[typeName] != nil; i++ {
+			typeName = fmt.Sprintf("%s_%s%d", root, upperFirst(f.JSONName()), i)
+		}
+		g.addStruct(typeName, "BackboneElement", md, true)
+		return typeName
+	}
+
+	switch {
+	case name == "ContainedResource" || md.FullName() == "google.protobuf.Any":
+		return "Resource"
+	case name == "SimpleQuantity" || name == "MoneyQuantity":
+		return "Quantity"
+	case name == "ReferenceId":
+		return "string"
+	case name == "CodingWithFixedSystem" || name == "CodingWithFixedCode":
+		return "Coding"
+	}
+	if structureDefinitionKind(md) == apb.StructureDefinitionKindValue_KIND_PRIMITIVE_TYPE {
+		return lowerFirst(name)
+	}
+	return name
+}
+
+// container returns a type definition container with all types that have
+// been built.
+func (g *generator) container() *common.TypeDefContainer {
+	ordered := g.ordered()
+	typeDefs := make(map[string]common.TypeDefAccessor, len(ordered))
+	for _, t := range ordered {
+		var base common.TypeDefAccessor
+		if t.base != "" {
+			base = typeDefs[t.base]
+		}
+		if t.primitive {
+			var pattern *regexp.Regexp
+			if t.pattern != "" {
+				pattern = regexp.MustCompile(t.pattern)
+			}
+			typeDefs[t.name] = common.NewPrimitiveTypeDef(t.name, base, pattern, t.simpleType)
+		} else {
+			typeDefs[t.name] = common.NewStructTypeDef(t.name, base, t.anonymous)
+		}
+	}
+
+	for _, t := range ordered {
+		if t.primitive {
+			continue
+		}
+		var props []*common.PropDef
+		for _, p := range g.definedProps(t) {
+			td, found := typeDefs[p.typeName]
+			if !found {
+				panic(fmt.Sprintf("unknown type %s of %s.%s", p.typeName, t.name, p.name))
+			}
+			props = append(props, common.NewPropDef(p.name, td, p.choice, p.min, p.array, p.enum))
+		}
+		typeDefs[t.name].(common.InitializableStructTypeDefAccessor).InitProps(props)
+		if constraints := g.constraints(t); len(constraints) > 0 {
+			typeDefs[t.name].(common.InitializableStructTypeDefAccessor).InitConstraints(constraints)
+		}
+	}
+	return common.NewTypeDefContainer(g.cfg.symbolicVersion, g.cfg.version, typeDefs)
+}
+
+// constraints returns the constraints of the type. The protocol buffer
+// definitions contain neither the keys, nor the severities, nor the
+// descriptions of the constraints. The constraints are keyed by the name of
+// the type and their position, and have severity error.
+func (g *generator) constraints(t *typeInfo) []*common.Constraint {
+	constraints := append([]*common.Constraint{}, g.cfg.constraints[t.name]...)
+	for i, expression := range t.constraints {
+		constraints = append(constraints, common.NewConstraint(fmt.Sprintf("%s-%d", t.name, i+1),
+			common.ErrorConstraintSeverity, "", expression))
+	}
+	return constraints
+}
+
+// ordered returns all types ordered so that base types precede the types that
+// are derived from them.
+func (g *generator) ordered() []*typeInfo {
+	depth := func(t *typeInfo) int {
+		d := 0
+		for ; t.base != ""; t = g.types[t.base] {
+			d++
+		}
+		return d
+	}
+	all := make([]*typeInfo, 0, len(g.types))
+	for _, t := range g.types {
+		all = append(all, t)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		di, dj := depth(all[i]), depth(all[j])
+		if di != dj {
+			return di < dj
+		}
+		return all[i].name < all[j].name
+	})
+	return all
+}
+
+// definedProps returns all properties of the type including the inherited
+// ones in the order of their definition. Inherited properties precede the
+// properties of the type itself. If the type redefines an inherited property,
+// the redefinition replaces the inherited property at its position.
+func (g *generator) definedProps(t *typeInfo) []propInfo {
+	if t.base == "" || g.types[t.base].primitive {
+		return t.props
+	}
+	ownProps := make(map[string]propInfo, len(t.props))
+	for _, p := range t.props {
+		ownProps[p.name] = p
+	}
+	var props []propInfo
+	inherited := make(map[string]bool)
+	for _, p := range g.definedProps(g.types[t.base]) {
+		if own, found := ownProps[p.name]; found {
+			p = own
+		}
+		props = append(props, p)
+		inherited[p.name] = true
+	}
+	for _, p := range t.props {
+		if !inherited[p.name] {
+			props = append(props, p)
+		}
+	}
+	return props
+}
+
+// insertProp inserts the property before the property with the specified
+// name. The property is appended if there is no such property.
+func insertProp(props []propInfo, before string, p propInfo) []propInfo {
+	for i := range props {
+		if props[i].name == before {
+			return append(props[:i], append([]propInfo{p}, props[i:]...)...)
+		}
+	}
+	return append(props, p)
+}
+
+// fieldsByNumber returns the fields of the message ordered by their numbers,
+// which follow the order of the element definitions.
+func fieldsByNumber(md protoreflect.MessageDescriptor) []protoreflect.FieldDescriptor {
+	fields := make([]protoreflect.FieldDescriptor, md.Fields().Len())
+	for i := range fields {
+		fields[i] = md.Fields().Get(i)
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Number() < fields[j].Number()
+	})
+	return fields
+}
+
+func structureDefinitionKind(md protoreflect.MessageDescriptor) apb.StructureDefinitionKindValue {
+	return proto.GetExtension(md.Options(), apb.E_StructureDefinitionKind).(apb.StructureDefinitionKindValue)
+}
+
+// isSpecializedCode returns if the message is a code that is bound to a
+// value set.
+func isSpecializedCode(md protoreflect.MessageDescriptor) bool {
+	if md.Name() == "Code" {
+		return false
+	}
+	value := md.Fields().ByName("value")
+	if value == nil {
+		return false
+	}
+	if value.Kind() == protoreflect.EnumKind {
+		return true
+	}
+	return strings.HasSuffix(string(md.Name()), "Code")
+}
+
+func enumCodes(md protoreflect.MessageDescriptor) []string {
+	var codes []string
+	values := md.Fields().ByName("value").Enum().Values()
+	for i := 0; i < values.Len(); i++ {
+		ev := values.Get(i)
+		if ev.Number() == 0 {
+			// unspecified value
+			continue
+		}
+		code := proto.GetExtension(ev.Options(), apb.E_FhirOriginalCode).(string)
+		if code == "" {
+			code = strings.ReplaceAll(strings.ToLower(string(ev.Name())), "_", "-")
+		}
+		codes = append(codes, code)
+	}
+	return codes
+}
+
+func excludeCodes(codes []string, excluded []string) []string {
+	if len(excluded) == 0 {
+		return codes
+	}
+	result := make([]string, 0, len(codes))
+	for _, c := range codes {
+		if !containsString(excluded, c) {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func lowerFirst(s string) string {
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+func upperFirst(s string) string {
+	return strings.ToUpper(s[:1]) + s[1:]
+}
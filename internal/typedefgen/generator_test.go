@@ -0,0 +1,172 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package main
+
+import (
+	"bytes"
+	"github.com/healthiop/hi/internal/common"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func generatedTypeDefContainer(t *testing.T, version string) *common.TypeDefContainer {
+	tdc, err := common.NewCompactTypeDefContainer(generate(configs[version]))
+	if err != nil {
+		t.Fatalf("invalid generated type definitions: %v", err)
+	}
+	return tdc
+}
+
+func TestGenerateUpToDate(t *testing.T) {
+	for version, cfg := range configs {
+		t.Run(version, func(t *testing.T) {
+			// the R5 preview release is contained in package r5
+			pkg := strings.ToLower(strings.TrimSuffix(version, "-preview"))
+			data, err := os.ReadFile(filepath.Join("..", pkg, "type_defs.bin"))
+			if assert.NoError(t, err) {
+				assert.True(t, bytes.Equal(data, generate(cfg)),
+					"embedded type definitions are not up to date, run go generate")
+			}
+		})
+	}
+}
+
+func TestGenerateVersion(t *testing.T) {
+	tdc := generatedTypeDefContainer(t, "R4")
+	assert.Equal(t, "R4", tdc.SymbolicVersion())
+	assert.Equal(t, "4.0.1", tdc.VersionString())
+}
+
+func TestGenerateAdditionalChoiceTypes(t *testing.T) {
+	tdc := generatedTypeDefContainer(t, "R4")
+	p := tdc.MandatoryStructTypeByName("Extension").PropByName("valueMeta")
+	if assert.NotNil(t, p, "property expected") {
+		assert.Equal(t, "Meta", p.Type().Name())
+		assert.Equal(t, "value", p.Choice())
+	}
+}
+
+func TestGenerateEnum(t *testing.T) {
+	tdc := generatedTypeDefContainer(t, "R4")
+	p := tdc.MandatoryStructTypeByName("Patient").PropByName("gender")
+	if assert.NotNil(t, p, "property expected") {
+		assert.Equal(t, "string", p.Type().Name())
+		assert.Equal(t, []string{"male", "female", "other", "unknown"}, p.Enum())
+	}
+}
+
+func TestGeneratePropsDefinitionOrder(t *testing.T) {
+	names := func(tdc *common.TypeDefContainer, name string) []string {
+		var names []string
+		for _, p := range tdc.MandatoryStructTypeByName(name).Props() {
+			names = append(names, p.Name())
+		}
+		return names
+	}
+
+	tdc := generatedTypeDefContainer(t, "R4")
+	assert.Equal(t, []string{"id", "extension", "reference", "type", "identifier", "display"},
+		names(tdc, "Reference"))
+	assert.Equal(t, []string{"id", "extension", "value", "comparator", "unit", "system", "code"},
+		names(tdc, "Age"))
+	assert.Equal(t, []string{"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension",
+		"identifier", "active", "name", "telecom", "gender", "birthDate", "deceasedBoolean", "deceasedDateTime"},
+		names(tdc, "Patient")[:16])
+	value := names(tdc, "Extension")[3:]
+	assert.Equal(t, []string{"valueBase64Binary", "valueBoolean"}, value[:2])
+	assert.Equal(t, []string{"valueDosage", "valueMeta"}, value[len(value)-2:])
+
+	tdc = generatedTypeDefContainer(t, "STU3")
+	assert.Equal(t, []string{"id", "extension", "modifierExtension", "path", "min", "max"},
+		names(tdc, "ElementDefinition_Base"))
+}
+
+func TestGenerateMin(t *testing.T) {
+	for _, version := range []string{"STU3", "R4", "R5-preview"} {
+		observation := generatedTypeDefContainer(t, version).MandatoryStructTypeByName("Observation")
+		assert.Equal(t, 1, observation.PropByName("status").Min(), version)
+		assert.Equal(t, 1, observation.PropByName("code").Min(), version)
+		assert.Equal(t, 0, observation.PropByName("subject").Min(), version)
+		assert.Equal(t, 0, observation.PropByName("valueQuantity").Min(), version)
+	}
+}
+
+func TestGenerateCodeSettings(t *testing.T) {
+	tdc := generatedTypeDefContainer(t, "R4")
+	p := tdc.MandatoryStructTypeByName("Expression").PropByName("language")
+	assert.Equal(t, []string{"text/cql", "text/fhirpath", "application/x-fhir-query"}, p.Enum())
+	p = tdc.MandatoryStructTypeByName("Questionnaire_Item").PropByName("type")
+	assert.NotContains(t, p.Enum(), "question")
+	assert.Contains(t, p.Enum(), "group")
+	p = tdc.MandatoryStructTypeByName("ActivityDefinition").PropByName("intent")
+	assert.Equal(t, "code", p.Type().Name())
+	assert.Nil(t, p.Enum())
+}
+
+func TestGeneratePatternSettings(t *testing.T) {
+	tdc := generatedTypeDefContainer(t, "R4")
+	assert.Nil(t, tdc.MandatoryTypeByName("base64Binary").(common.PrimitiveTypeDefAccessor).Pattern())
+	assert.Equal(t, "^[A-Za-z0-9\\-.]{1,64}$",
+		tdc.MandatoryTypeByName("id").(common.PrimitiveTypeDefAccessor).Pattern().String())
+
+	tdc = generatedTypeDefContainer(t, "STU3")
+	assert.Equal(t, "^true|false$",
+		tdc.MandatoryTypeByName("boolean").(common.PrimitiveTypeDefAccessor).Pattern().String())
+}
+
+func TestGenerateConstraints(t *testing.T) {
+	tdc := generatedTypeDefContainer(t, "R4")
+	c := tdc.MandatoryStructTypeByName("Period").Constraints()
+	if assert.Len(t, c, 1) {
+		assert.Equal(t, "Period-1", c[0].Key())
+		assert.Equal(t, common.ErrorConstraintSeverity, c[0].Severity())
+		assert.Equal(t, "start.hasValue().not() or end.hasValue().not() or (start <= end)", c[0].Expression())
+	}
+	c = tdc.MandatoryStructTypeByName("Narrative").Constraints()
+	if assert.Len(t, c, 1, "repeated constraints must be added once") {
+		assert.Equal(t, "`div`.all(htmlChecks())", c[0].Expression())
+	}
+	c = tdc.MandatoryStructTypeByName("Element").Constraints()
+	if assert.Len(t, c, 1) {
+		assert.Equal(t, "ele-1", c[0].Key())
+	}
+}
+
+func TestGenerateBackboneFieldConstraints(t *testing.T) {
+	tdc := generatedTypeDefContainer(t, "STU3")
+	assert.Empty(t, tdc.MandatoryStructTypeByName("Patient").Constraints())
+	c := tdc.MandatoryStructTypeByName("Patient_Contact").Constraints()
+	if assert.Len(t, c, 1) {
+		assert.Equal(t, "Patient_Contact-1", c[0].Key())
+		assert.Equal(t, "name.exists() or telecom.exists() or address.exists() or organization.exists()", c[0].Expression())
+	}
+}
@@ -0,0 +1,337 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package main
+
+// The generated Go packages of the FHIR protocol buffer definitions register
+// their descriptors when they are imported.
+import (
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/codes_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/datatypes_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/account_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/activity_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/adverse_event_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/allergy_intolerance_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/appointment_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/appointment_response_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/audit_event_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/basic_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/binary_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/biologically_derived_product_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/body_structure_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/bundle_and_contained_resource_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/capability_statement_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/care_plan_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/care_team_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/catalog_entry_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/charge_item_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/charge_item_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/claim_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/claim_response_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/clinical_impression_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/code_system_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/communication_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/communication_request_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/compartment_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/composition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/concept_map_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/condition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/consent_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/contract_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/coverage_eligibility_request_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/coverage_eligibility_response_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/coverage_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/detected_issue_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/device_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/device_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/device_metric_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/device_request_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/device_use_statement_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/diagnostic_report_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/document_manifest_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/document_reference_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/domain_resource_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/effect_evidence_synthesis_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/encounter_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/endpoint_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/enrollment_request_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/enrollment_response_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/episode_of_care_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/event_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/evidence_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/evidence_variable_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/example_scenario_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/explanation_of_benefit_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/family_member_history_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/flag_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/goal_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/graph_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/group_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/guidance_response_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/healthcare_service_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/imaging_study_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/immunization_evaluation_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/immunization_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/immunization_recommendation_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/implementation_guide_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/insurance_plan_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/invoice_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/library_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/linkage_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/list_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/location_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/measure_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/measure_report_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/media_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/medication_administration_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/medication_dispense_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/medication_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/medication_knowledge_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/medication_request_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/medication_statement_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/medicinal_product_authorization_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/medicinal_product_contraindication_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/medicinal_product_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/medicinal_product_indication_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/medicinal_product_ingredient_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/medicinal_product_interaction_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/medicinal_product_manufactured_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/medicinal_product_packaged_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/medicinal_product_pharmaceutical_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/medicinal_product_undesirable_effect_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/message_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/message_header_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/metadata_resource_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/molecular_sequence_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/naming_system_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/nutrition_order_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/observation_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/observation_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/operation_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/operation_outcome_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/organization_affiliation_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/organization_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/parameters_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/patient_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/payment_notice_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/payment_reconciliation_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/person_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/plan_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/practitioner_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/practitioner_role_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/procedure_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/provenance_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/questionnaire_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/questionnaire_response_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/related_person_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/request_group_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/research_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/research_element_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/research_study_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/research_subject_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/resource_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/risk_assessment_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/risk_evidence_synthesis_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/schedule_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/search_parameter_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/service_request_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/slot_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/specimen_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/specimen_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/structure_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/structure_map_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/subscription_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/substance_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/substance_nucleic_acid_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/substance_polymer_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/substance_protein_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/substance_reference_information_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/substance_source_material_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/substance_specification_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/supply_delivery_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/supply_request_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/task_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/terminology_capabilities_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/test_report_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/test_script_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/value_set_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/verification_result_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r4/core/resources/vision_prescription_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/codes_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/datatypes_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/account_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/activity_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/administrable_product_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/adverse_event_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/allergy_intolerance_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/appointment_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/appointment_response_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/audit_event_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/basic_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/binary_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/biologically_derived_product_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/body_structure_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/bundle_and_contained_resource_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/canonical_resource_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/capability_statement2_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/capability_statement_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/care_plan_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/care_team_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/catalog_entry_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/charge_item_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/charge_item_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/claim_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/claim_response_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/clinical_impression_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/clinical_use_issue_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/code_system_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/communication_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/communication_request_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/compartment_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/composition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/concept_map_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/condition_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/condition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/consent_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/contract_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/coverage_eligibility_request_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/coverage_eligibility_response_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/coverage_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/detected_issue_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/device_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/device_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/device_metric_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/device_request_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/device_use_statement_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/diagnostic_report_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/document_manifest_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/document_reference_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/domain_resource_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/encounter_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/endpoint_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/enrollment_request_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/enrollment_response_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/episode_of_care_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/event_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/evidence_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/evidence_variable_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/example_scenario_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/explanation_of_benefit_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/family_member_history_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/flag_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/goal_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/graph_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/group_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/guidance_response_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/healthcare_service_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/imaging_study_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/immunization_evaluation_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/immunization_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/immunization_recommendation_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/implementation_guide_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/ingredient_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/insurance_plan_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/invoice_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/library_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/linkage_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/list_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/location_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/manufactured_item_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/measure_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/measure_report_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/medication_administration_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/medication_dispense_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/medication_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/medication_knowledge_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/medication_request_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/medication_usage_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/medicinal_product_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/message_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/message_header_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/metadata_resource_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/molecular_sequence_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/naming_system_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/nutrition_intake_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/nutrition_order_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/observation_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/observation_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/operation_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/operation_outcome_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/organization_affiliation_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/organization_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/packaged_product_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/parameters_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/patient_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/payment_notice_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/payment_reconciliation_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/person_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/plan_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/practitioner_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/practitioner_role_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/procedure_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/provenance_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/questionnaire_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/questionnaire_response_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/regulated_authorization_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/related_person_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/request_group_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/research_study_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/research_subject_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/resource_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/risk_assessment_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/schedule_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/search_parameter_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/service_request_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/slot_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/specimen_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/specimen_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/structure_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/structure_map_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/subscription_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/substance_definition_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/substance_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/substance_nucleic_acid_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/substance_polymer_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/substance_protein_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/substance_reference_information_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/substance_source_material_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/supply_delivery_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/supply_request_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/task_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/terminology_capabilities_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/test_report_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/test_script_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/topic_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/value_set_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/verification_result_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/r5/core/resources/vision_prescription_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/stu3/codes_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/stu3/datatypes_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/stu3/metadatatypes_go_proto"
+	_ "github.com/google/fhir/go/proto/google/fhir/proto/stu3/resources_go_proto"
+)
@@ -0,0 +1,234 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package main
+
+import "github.com/healthiop/hi/internal/common"
+
+// config contains the settings that are required to generate the type
+// definitions of one FHIR version from its protocol buffer definitions.
+type config struct {
+	// protoPackage is the package of the protocol buffer definitions.
+	protoPackage string
+	// symbolicVersion and version are stored with the type definitions.
+	symbolicVersion string
+	version         string
+	// resourceBased contains the resources that do not extend DomainResource.
+	resourceBased map[string]bool
+	// backboneBased contains the data types that extend BackboneElement.
+	backboneBased map[string]bool
+	// quantityBased contains the data types that are profiles of Quantity.
+	quantityBased map[string]bool
+	// skipped contains the messages that are no FHIR types.
+	skipped map[string]bool
+	// patterns contains the patterns of primitive types that replace the
+	// value regex of the protocol buffer definitions. An empty pattern
+	// removes the value regex.
+	patterns map[string]string
+	// The following settings contain elements by the name of the type and of
+	// the element separated by a dot.
+	// additionalChoiceTypes contains the types of choice elements that are not
+	// contained in the protocol buffer definitions.
+	additionalChoiceTypes map[string][]string
+	// enums contains the codes of elements that replace the codes of the
+	// protocol buffer definitions.
+	enums map[string][]string
+	// excludedCodes contains the codes that are removed from the codes of
+	// elements.
+	excludedCodes map[string][]string
+	// unboundCodes contains the code elements whose codes are not included.
+	unboundCodes map[string]bool
+	// constraints contains the constraints by the name of the type that are
+	// not contained in the protocol buffer definitions (e.g. the ones of
+	// Element and DomainResource). They precede the generated constraints.
+	constraints map[string][]*common.Constraint
+}
+
+var configs = map[string]*config{
+	"STU3": {
+		protoPackage:    "google.fhir.stu3.proto",
+		symbolicVersion: "STU3",
+		version:         "3.0.2",
+		resourceBased:   set("Binary", "Bundle", "Parameters"),
+		backboneBased:   set(),
+		quantityBased:   set("Age", "Count", "Distance", "Duration", "Money"),
+		skipped: set("SimpleQuantity", "CodingWithFixedSystem", "CodingWithFixedCode", "ReferenceId",
+			"Element", "BackboneElement", "Extension_ValueX"),
+		patterns: map[string]string{
+			"boolean": "true|false",
+			"code":    "[^\\s]+([\\s]?[^\\s]+)*",
+			"instant": "-?[0-9]{4}-(0[1-9]|1[0-2])-(0[0-9]|[1-2][0-9]|3[0-1])T([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9](\\.[0-9]+)?(Z|(\\+|-)((0[0-9]|1[0-3]):[0-5][0-9]|14:00))",
+		},
+		constraints: map[string][]*common.Constraint{
+			"Element":   {ele1Constraint},
+			"Extension": {ext1Constraint},
+			"DomainResource": {
+				common.NewConstraint("dom-1", common.ErrorConstraintSeverity,
+					"If the resource is contained in another resource, it SHALL NOT contain any narrative",
+					"contained.text.empty()"),
+				common.NewConstraint("dom-2", common.ErrorConstraintSeverity,
+					"If the resource is contained in another resource, it SHALL NOT contain nested Resources",
+					"contained.contained.empty()"),
+				common.NewConstraint("dom-3", common.ErrorConstraintSeverity,
+					"If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource",
+					"contained.where(('#'+id in %resource.descendants().reference).not()).empty()"),
+				common.NewConstraint("dom-4", common.ErrorConstraintSeverity,
+					"If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated",
+					"contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"),
+			},
+			"Reference": {
+				common.NewConstraint("ref-1", common.ErrorConstraintSeverity,
+					"SHALL have a contained resource if a local reference is provided",
+					"reference.startsWith('#').not() or (reference.substring(1).trace('url') in %resource.contained.id.trace('ids'))"),
+			},
+		},
+	},
+	"R4": {
+		protoPackage:    "google.fhir.r4.core",
+		symbolicVersion: "R4",
+		version:         "4.0.1",
+		resourceBased:   set("Binary", "Bundle", "Parameters"),
+		backboneBased:   set("Timing", "Dosage", "ElementDefinition"),
+		quantityBased:   set("Age", "Count", "Distance", "Duration"),
+		skipped:         set("SimpleQuantity", "MoneyQuantity", "ReferenceId", "Element", "BackboneElement"),
+		constraints:     r4Constraints,
+		// FHIR R4 allows Meta as type of extension values, the protocol
+		// buffer definitions do not.
+		additionalChoiceTypes: map[string][]string{
+			"Extension.value": {"Meta"},
+		},
+		// The following settings keep the R4 type definitions that have been
+		// used before they have been generated from the protocol buffer
+		// definitions.
+		patterns: map[string]string{
+			"base64Binary": "",
+			"dateTime":     "([0-9]([0-9]([0-9][1-9]|[1-9]0)|[1-9]00)|[1-9]000)(-(0[1-9]|1[0-2])(-(0[1-9]|[1-2][0-9]|3[0-1])(T([01][0-9]|2[0-3]):[0-5][0-9]:([0-5][0-9]|60)(\\.[0-9]+)?(Z|([+\\-])((0[0-9]|1[0-3]):[0-5][0-9]|14:00)))?)?)?",
+			"id":           "[A-Za-z0-9\\-.]{1,64}",
+			"instant":      "([0-9]([0-9]([0-9][1-9]|[1-9]0)|[1-9]00)|[1-9]000)-(0[1-9]|1[0-2])-(0[1-9]|[1-2][0-9]|3[0-1])T([01][0-9]|2[0-3]):[0-5][0-9]:([0-5][0-9]|60)(\\.[0-9]+)?(Z|([+\\-])((0[0-9]|1[0-3]):[0-5][0-9]|14:00))",
+		},
+		enums: map[string][]string{
+			"Expression.language": {"text/cql", "text/fhirpath", "application/x-fhir-query"},
+		},
+		excludedCodes: map[string][]string{
+			"Questionnaire_Item.type": {"question"},
+		},
+		unboundCodes: set(
+			"ActivityDefinition.intent", "ActivityDefinition.kind", "ActivityDefinition.priority",
+			"ActivityDefinition_Participant.type", "AppointmentResponse.participantStatus",
+			"CapabilityStatement_Resource.type", "CarePlan.intent", "CarePlan.status", "CarePlan_Detail.kind",
+			"ChargeItemDefinition_PriceComponent.type", "Claim.status", "ClaimResponse.outcome",
+			"ClaimResponse.status", "ClaimResponse.use", "ClinicalImpression.status", "CodeSystem_Filter.operator",
+			"Communication.priority", "Communication.status", "CommunicationRequest.priority",
+			"CommunicationRequest.status", "CompartmentDefinition_Resource.code", "Composition.confidentiality",
+			"Composition_RelatesTo.code", "Composition_Section.mode", "Contract.status",
+			"Contract_ContentDefinition.publicationStatus", "Coverage.status", "CoverageEligibilityRequest.status",
+			"CoverageEligibilityResponse.status", "DataRequirement.type", "DetectedIssue.status",
+			"DeviceRequest.intent", "DeviceRequest.priority", "DeviceRequest.status", "DocumentReference.docStatus",
+			"EnrollmentRequest.status", "EnrollmentResponse.status", "ExampleScenario_Instance.resourceType",
+			"ExplanationOfBenefit.outcome", "ExplanationOfBenefit.use", "GraphDefinition.start",
+			"GraphDefinition_Compartment.code", "GraphDefinition_Target.type", "Immunization.status",
+			"ImmunizationEvaluation.status", "ImplementationGuide_Global.type",
+			"Location_HoursOfOperation.daysOfWeek", "Media.status", "Medication.status",
+			"MedicationAdministration.status", "MedicationDispense.status", "MedicationKnowledge.status",
+			"MedicationRequest.intent", "MedicationRequest.priority", "MedicationRequest.status",
+			"MedicationStatement.status", "MessageDefinition_Focus.code", "NutritionOrder.intent",
+			"NutritionOrder.status", "OperationDefinition.resource", "OperationDefinition_Parameter.type",
+			"ParameterDefinition.type", "ParameterDefinition.use", "PaymentNotice.status",
+			"PaymentReconciliation.status", "PlanDefinition_Action.priority",
+			"PractitionerRole_AvailableTime.daysOfWeek", "Procedure.status", "Questionnaire.subjectType",
+			"RequestGroup.intent", "RequestGroup.priority", "RequestGroup.status",
+			"RequestGroup_Action.cardinalityBehavior", "RequestGroup_Action.groupingBehavior",
+			"RequestGroup_Action.precheckBehavior", "RequestGroup_Action.priority",
+			"RequestGroup_Action.requiredBehavior", "RequestGroup_Action.selectionBehavior",
+			"RequestGroup_Condition.kind", "RequestGroup_RelatedAction.relationship", "RiskAssessment.status",
+			"SearchParameter.base", "SearchParameter.target", "ServiceRequest.intent", "ServiceRequest.priority",
+			"ServiceRequest.status", "SupplyRequest.priority", "Task.priority", "TerminologyCapabilities.kind",
+			"TestScript_Assert.resource", "TestScript_Operation.resource", "Timing_Repeat.dayOfWeek",
+			"VerificationResult.status", "VisionPrescription.status",
+		),
+	},
+	// The protocol buffer definitions contain the R5 preview release 4.2.0
+	// only (e.g. with MedicationUsage instead of MedicationStatement). It must
+	// not be named like the R5 release 5.0.0.
+	"R5-preview": {
+		protoPackage:    "google.fhir.r5.core",
+		symbolicVersion: "R5-preview",
+		version:         "4.2.0",
+		resourceBased:   set("Binary", "Bundle", "Parameters"),
+		backboneBased: set("Timing", "Dosage", "ElementDefinition", "MarketingStatus", "ProductShelfLife",
+			"Availability", "MonetaryComponent", "VirtualServiceDetail"),
+		quantityBased: set("Age", "Count", "Distance", "Duration"),
+		skipped:       set("SimpleQuantity", "MoneyQuantity", "ReferenceId", "Element", "BackboneElement"),
+		constraints:   r4Constraints,
+	},
+}
+
+var ele1Constraint = common.NewConstraint("ele-1", common.ErrorConstraintSeverity,
+	"All FHIR elements must have a @value or children",
+	"hasValue() or (children().count() > id.count())")
+
+var ext1Constraint = common.NewConstraint("ext-1", common.ErrorConstraintSeverity,
+	"Must have either extensions or value[x], not both",
+	"extension.exists() != value.exists()")
+
+// r4Constraints contains the constraints of R4 and of the R5 preview release
+// that are not contained in the protocol buffer definitions.
+var r4Constraints = map[string][]*common.Constraint{
+	"Element":   {ele1Constraint},
+	"Extension": {ext1Constraint},
+	"DomainResource": {
+		common.NewConstraint("dom-2", common.ErrorConstraintSeverity,
+			"If the resource is contained in another resource, it SHALL NOT contain nested Resources",
+			"contained.contained.empty()"),
+		common.NewConstraint("dom-3", common.ErrorConstraintSeverity,
+			"If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource",
+			"contained.where((('#'+id in (%resource.descendants().reference | %resource.descendants().as(canonical) | %resource.descendants().as(uri) | %resource.descendants().as(url))) or descendants().where(reference = '#').exists() or descendants().where(as(canonical) = '#').exists() or descendants().where(as(canonical) = '#').exists()).not()).trace('unmatched', id).empty()"),
+		common.NewConstraint("dom-4", common.ErrorConstraintSeverity,
+			"If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated",
+			"contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"),
+		common.NewConstraint("dom-5", common.ErrorConstraintSeverity,
+			"If a resource is contained in another resource, it SHALL NOT have a security label",
+			"contained.meta.security.empty()"),
+		common.NewConstraint("dom-6", common.WarningConstraintSeverity,
+			"A resource should have narrative for robust management",
+			"text.`div`.exists()"),
+	},
+	"Reference": {
+		common.NewConstraint("ref-1", common.ErrorConstraintSeverity,
+			"SHALL have a contained resource if a local reference is provided",
+			"reference.startsWith('#').not() or (reference.substring(1).trace('url') in %rootResource.contained.id.trace('ids'))"),
+	},
+}
+
+func set(names ...string) map[string]bool {
+	m := make(map[string]bool, len(names))
+	for _, n := range names {
+		m[n] = true
+	}
+	return m
+}
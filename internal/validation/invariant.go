@@ -0,0 +1,290 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package validation
+
+import (
+	"fmt"
+	"github.com/healthiop/hi"
+	"github.com/healthiop/hi/internal/common"
+	"github.com/healthiop/hi/internal/dynamic"
+	gohipath "github.com/healthiop/hipath"
+	"github.com/healthiop/hipath/hipathsys"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// existsRegexp matches invocations of the FHIRPath function exists without
+// criteria. hipath cannot evaluate them outside a loop, so they are rewritten
+// into the equivalent invocation exists(true). Other FHIRPath functions that
+// are not supported by hipath are not rewritten, so constraints that use them
+// are reported as constraints that cannot be evaluated.
+var existsRegexp = regexp.MustCompile(`\bexists\(\s*\)`)
+
+// pathLiteralRegexp matches string literals, delimited identifiers and
+// comments of FHIRPath expressions, which must not be rewritten.
+var pathLiteralRegexp = regexp.MustCompile(`'(?:[^'\\]|\\.)*'|` + "`(?:[^`\\\\]|\\\\.)*`" + `|//[^\n]*|/\*(?s:.*?)\*/`)
+
+// InvariantValidator evaluates the constraints of the type definitions on
+// all values of a resource. The constraints of a type include the ones of its
+// base types. Primitive values are checked with the constraints of Element.
+type InvariantValidator struct {
+	typeDefContainer *common.TypeDefContainer
+	model            *dynamic.PathDynModel
+	lock             sync.RWMutex
+	paths            map[*common.Constraint]*compiledConstraint
+}
+
+type compiledConstraint struct {
+	path *gohipath.Path
+	err  error
+}
+
+type invariantValidation struct {
+	*InvariantValidator
+	outcome     *Outcome
+	unevaluated map[string]bool
+}
+
+func NewInvariantValidator(typeDefContainer *common.TypeDefContainer) *InvariantValidator {
+	return &InvariantValidator{
+		typeDefContainer: typeDefContainer,
+		model:            dynamic.NewPathDynModel(typeDefContainer),
+		paths:            make(map[*common.Constraint]*compiledConstraint),
+	}
+}
+
+func (v *InvariantValidator) TypeDefContainer() *common.TypeDefContainer {
+	return v.typeDefContainer
+}
+
+// Validate evaluates all constraints on the specified resource. The resource
+// must have been created for the FHIR version of the validator. Each failed
+// constraint results in an issue with the severity of the constraint.
+// Constraints that cannot be evaluated result in a single warning.
+func (v *InvariantValidator) Validate(resource hi.DynResourceAccessor) (*Outcome, error) {
+	if resource.VersionString() != v.typeDefContainer.VersionString() {
+		return nil, fmt.Errorf("resource has FHIR version %s instead of %s",
+			resource.VersionString(), v.typeDefContainer.VersionString())
+	}
+
+	va := &invariantValidation{v, NewOutcome(), make(map[string]bool)}
+	if err := va.validateNode(resource, resource.TypeName(), resource, resource); err != nil {
+		return nil, err
+	}
+	return va.outcome, nil
+}
+
+// ValidateData evaluates all constraints on the specified resource data. An
+// error is returned if the data cannot be accessed as a resource.
+func (v *InvariantValidator) ValidateData(data map[string]interface{}) (*Outcome, error) {
+	resource, err := dynamic.NewDynResource(v.typeDefContainer, data, nil)
+	if err != nil {
+		return nil, err
+	}
+	return v.Validate(resource)
+}
+
+// compiledPath returns the compiled expression of the constraint.
+func (v *InvariantValidator) compiledPath(c *common.Constraint) *compiledConstraint {
+	v.lock.RLock()
+	cc := v.paths[c]
+	v.lock.RUnlock()
+	if cc != nil {
+		return cc
+	}
+
+	cc = &compiledConstraint{}
+	if path, err := gohipath.Compile(rewritePath(c.Expression())); err != nil {
+		cc.err = err
+	} else {
+		cc.path = path
+	}
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.paths[c] = cc
+	return cc
+}
+
+// rewritePath rewrites the expression of a constraint into an equivalent
+// expression that can be evaluated by hipath. String literals, delimited identifiers and
+// comments are kept unchanged.
+func rewritePath(expression string) string {
+	var b strings.Builder
+	pos := 0
+	for _, loc := range pathLiteralRegexp.FindAllStringIndex(expression, -1) {
+		b.WriteString(existsRegexp.ReplaceAllString(expression[pos:loc[0]], "exists(true)"))
+		b.WriteString(expression[loc[0]:loc[1]])
+		pos = loc[1]
+	}
+	b.WriteString(existsRegexp.ReplaceAllString(expression[pos:], "exists(true)"))
+	return b.String()
+}
+
+// evaluateCondition evaluates the path on the node. The condition is
+// satisfied if the result is empty or true. An error is returned if the
+// result is not a single boolean.
+func evaluateCondition(model *dynamic.PathDynModel, path *gohipath.Path, node interface{}, resource interface{}, rootResource interface{}) (bool, error) {
+	ctx := dynamic.NewPathDynContext(model, node, map[string]interface{}{
+		"resource":     resource,
+		"rootResource": rootResource,
+	})
+	result, err := path.Execute(ctx, node)
+	if err != nil {
+		return false, err
+	}
+	if result.Empty() {
+		return true, nil
+	}
+	b, ok := result.Get(0).(hipathsys.BooleanAccessor)
+	if result.Count() > 1 || !ok {
+		return false, fmt.Errorf("result is not a single boolean")
+	}
+	return b.Bool(), nil
+}
+
+func (va *invariantValidation) validateNode(node interface{}, location string, resource interface{}, rootResource interface{}) error {
+	for _, c := range va.constraints(node) {
+		va.evaluate(c, node, location, resource, rootResource)
+	}
+
+	children, err := va.model.NamedChildren(node)
+	if err != nil {
+		return fmt.Errorf("cannot access %s: %w", location, err)
+	}
+	for _, child := range children {
+		childLocation := childLocation(location, child)
+		childResource := resource
+		if r, ok := dynamic.ResourceOf(child.Node); ok {
+			childResource = r
+		}
+		if err := va.validateNode(child.Node, childLocation, childResource, rootResource); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// childLocation returns the location of the child, which includes the index
+// of the child if the property is a list.
+func childLocation(location string, child dynamic.PathChild) string {
+	location = location + "." + child.Name
+	if child.Index >= 0 {
+		location = fmt.Sprintf("%s[%d]", location, child.Index)
+	}
+	return location
+}
+
+func (va *invariantValidation) evaluate(c *common.Constraint, node interface{}, location string, resource interface{}, rootResource interface{}) {
+	cc := va.compiledPath(c)
+	if cc.err != nil {
+		va.unevaluatedIssue(c, location, cc.err)
+		return
+	}
+
+	satisfied, err := evaluateCondition(va.model, cc.path, node, resource, rootResource)
+	if err != nil {
+		va.unevaluatedIssue(c, location, err)
+		return
+	}
+	if !satisfied {
+		// generated constraints have no description
+		human := c.Human()
+		if human == "" {
+			human = c.Expression()
+		}
+		va.outcome.Issues = append(va.outcome.Issues, &Issue{
+			Severity:    IssueSeverity(c.Severity()),
+			Code:        InvariantIssueType,
+			Diagnostics: fmt.Sprintf("%s: %s", c.Key(), human),
+			Expression:  location,
+			Key:         c.Key(),
+		})
+	}
+}
+
+// unevaluatedIssue reports a constraint that cannot be evaluated. The issue
+// is reported only once for each constraint.
+func (va *invariantValidation) unevaluatedIssue(c *common.Constraint, location string, err error) {
+	if va.unevaluated[c.Key()] {
+		return
+	}
+	va.unevaluated[c.Key()] = true
+	va.outcome.Issues = append(va.outcome.Issues, &Issue{
+		Severity:    WarningSeverity,
+		Code:        ProcessingIssueType,
+		Diagnostics: fmt.Sprintf("constraint %s cannot be evaluated: %s", c.Key(), pathErrorMessage(err)),
+		Expression:  location,
+		Key:         c.Key(),
+	})
+}
+
+// pathErrorMessage returns the message of the error including the messages
+// of its items, which name for example the functions that are not supported.
+func pathErrorMessage(err error) string {
+	e, ok := err.(*hipathsys.Error)
+	if !ok || len(e.Items()) == 0 {
+		return err.Error()
+	}
+	msgs := make([]string, len(e.Items()))
+	for i, item := range e.Items() {
+		msgs[i] = item.Msg()
+	}
+	return fmt.Sprintf("%s: %s", e.Error(), strings.Join(msgs, "; "))
+}
+
+// constraints returns the constraints of the type of the node and its base
+// types. The constraints of the base types are returned first.
+func (va *invariantValidation) constraints(node interface{}) []*common.Constraint {
+	if a, ok := node.(hipathsys.AnyAccessor); ok {
+		node = a.Source()
+	}
+	typeDefRetriever, ok := node.(dynamic.DynTypeDefRetriever)
+	if !ok {
+		return nil
+	}
+
+	var typeDefs []common.StructTypeDefAccessor
+	typeDef := typeDefRetriever.Type()
+	if typeDef.TypeKind() == common.PrimitiveTypeKind && !typeDef.ExtendsTypeName(common.ElementTypeName) {
+		typeDefs = append(typeDefs, va.typeDefContainer.ElementType())
+	}
+	for td := typeDef; td != nil; td = td.Base() {
+		if s, ok := td.(common.StructTypeDefAccessor); ok {
+			typeDefs = append(typeDefs, s)
+		}
+	}
+
+	var constraints []*common.Constraint
+	for i := len(typeDefs) - 1; i >= 0; i-- {
+		constraints = append(constraints, typeDefs[i].Constraints()...)
+	}
+	return constraints
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package validation
+
+import (
+	"github.com/healthiop/hi/internal/common"
+	"github.com/healthiop/hi/internal/dynamic"
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/healthiop/hi/internal/stu3"
+	gohipath "github.com/healthiop/hipath"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestInvariantValidatorValid(t *testing.T) {
+	outcome, err := NewInvariantValidator(r4.TypeDefContainer()).ValidateData(readTestData(t, "observation_valid"))
+	if assert.NoError(t, err) {
+		// the functions hasValue, not and htmlChecks are not supported
+		keys := make([]string, 0, len(outcome.Issues))
+		for _, issue := range outcome.Issues {
+			assert.Equal(t, WarningSeverity, issue.Severity)
+			assert.Equal(t, ProcessingIssueType, issue.Code)
+			keys = append(keys, issue.Key)
+		}
+		assert.Equal(t, []string{"dom-3", "ele-1", "Narrative-1", "ref-1", "Period-1"}, keys)
+		assert.True(t, outcome.Valid())
+	}
+}
+
+func TestInvariantValidatorInvalid(t *testing.T) {
+	outcome, err := NewInvariantValidator(r4.TypeDefContainer()).ValidateData(readTestData(t, "observation_invariants"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.False(t, outcome.Valid())
+
+	type result struct {
+		severity   IssueSeverity
+		code       IssueType
+		key        string
+		expression string
+	}
+	results := make([]result, 0, len(outcome.Issues))
+	for _, issue := range outcome.Issues {
+		results = append(results, result{issue.Severity, issue.Code, issue.Key, issue.Expression})
+	}
+	// constraints that use the functions hasValue and not cannot be evaluated
+	assert.Equal(t, []result{
+		{ErrorSeverity, InvariantIssueType, "dom-2", "Observation"},
+		{WarningSeverity, ProcessingIssueType, "dom-3", "Observation"},
+		{ErrorSeverity, InvariantIssueType, "dom-4", "Observation"},
+		{WarningSeverity, InvariantIssueType, "dom-6", "Observation"},
+		{ErrorSeverity, InvariantIssueType, "Observation-1", "Observation"},
+		{ErrorSeverity, InvariantIssueType, "Observation-2", "Observation"},
+		{WarningSeverity, ProcessingIssueType, "ele-1", "Observation.id"},
+		{WarningSeverity, InvariantIssueType, "dom-6", "Observation.contained[0]"},
+		{WarningSeverity, InvariantIssueType, "dom-6", "Observation.contained[0].contained[0]"},
+		{ErrorSeverity, InvariantIssueType, "Organization-1", "Observation.contained[0].contained[0]"},
+		{WarningSeverity, InvariantIssueType, "dom-6", "Observation.contained[1]"},
+		{ErrorSeverity, InvariantIssueType, "ext-1", "Observation.status.extension[0]"},
+		{WarningSeverity, ProcessingIssueType, "ref-1", "Observation.subject"},
+		{WarningSeverity, ProcessingIssueType, "Period-1", "Observation.effectivePeriod"},
+		{ErrorSeverity, InvariantIssueType, "Quantity-1", "Observation.valueQuantity"},
+		{ErrorSeverity, InvariantIssueType, "Observation_ReferenceRange-1", "Observation.referenceRange[0]"},
+	}, results)
+	assert.Equal(t, "Observation-1: dataAbsentReason.empty() or value.empty()",
+		outcome.Issues[4].Diagnostics)
+	assert.Equal(t, "dom-2: If the resource is contained in another resource, it SHALL NOT contain nested Resources",
+		outcome.Issues[0].Diagnostics)
+}
+
+func TestInvariantValidatorPrimitiveElement(t *testing.T) {
+	outcome, err := NewInvariantValidator(r4.TypeDefContainer()).ValidateData(map[string]interface{}{
+		"resourceType": "Basic",
+		"_created":     map[string]interface{}{"id": "created"},
+	})
+	// the element constraint is first checked on the primitive element
+	if assert.NoError(t, err) && assert.Len(t, outcome.Issues, 3) {
+		assert.Equal(t, "dom-3", outcome.Issues[0].Key)
+		assert.Equal(t, "dom-6", outcome.Issues[1].Key)
+		assert.Equal(t, &Issue{WarningSeverity, ProcessingIssueType,
+			"constraint ele-1 cannot be evaluated: error when parsing path expression: " +
+				"executor has not been defined: hasValue", "Basic.created", "ele-1"}, outcome.Issues[2])
+	}
+}
+
+func TestInvariantValidatorResourceVariable(t *testing.T) {
+	outcome, err := NewInvariantValidator(r4.TypeDefContainer()).ValidateData(map[string]interface{}{
+		"resourceType": "StructureDefinition",
+		"kind":         "resource",
+		"type":         "Observation",
+		"snapshot": map[string]interface{}{
+			"element": []interface{}{map[string]interface{}{"id": "Patient", "path": "Patient"}},
+		},
+	})
+	// %resource refers to the structure definition and not to the snapshot
+	if assert.NoError(t, err) {
+		var issue *Issue
+		for _, i := range outcome.Issues {
+			if i.Key == "StructureDefinition_Snapshot-2" {
+				issue = i
+			}
+		}
+		if assert.NotNil(t, issue) {
+			assert.Equal(t, InvariantIssueType, issue.Code)
+			assert.Equal(t, "StructureDefinition.snapshot", issue.Expression)
+		}
+	}
+}
+
+func TestInvariantValidatorUnevaluated(t *testing.T) {
+	v := NewInvariantValidator(r4.TypeDefContainer())
+	va := &invariantValidation{v, NewOutcome(), make(map[string]bool)}
+	c := common.NewConstraint("txt-1", common.ErrorConstraintSeverity,
+		"The narrative SHALL contain only the basic html formatting elements", "htmlChecks()")
+	node, err := dynamic.NewDynResource(r4.TypeDefContainer(), map[string]interface{}{"resourceType": "Basic"}, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	va.evaluate(c, node, "Basic.text", node, node)
+	va.evaluate(c, node, "Basic.text", node, node)
+	if assert.Len(t, va.outcome.Issues, 1) {
+		issue := va.outcome.Issues[0]
+		assert.Equal(t, WarningSeverity, issue.Severity)
+		assert.Equal(t, ProcessingIssueType, issue.Code)
+		assert.Equal(t, "txt-1", issue.Key)
+		assert.Equal(t, "constraint txt-1 cannot be evaluated: error when parsing path expression: "+
+			"executor has not been defined: htmlChecks", issue.Diagnostics)
+	}
+	assert.True(t, va.outcome.Valid())
+}
+
+func TestInvariantValidatorVersion(t *testing.T) {
+	r, err := dynamic.NewDynResource(stu3.TypeDefContainer(), map[string]interface{}{"resourceType": "Patient"}, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	outcome, err := NewInvariantValidator(r4.TypeDefContainer()).Validate(r)
+	assert.Nil(t, outcome)
+	if assert.Error(t, err) {
+		assert.Equal(t, "resource has FHIR version 3.0.2 instead of 4.0.1", err.Error())
+	}
+}
+
+func TestInvariantValidatorInvalidData(t *testing.T) {
+	outcome, err := NewInvariantValidator(r4.TypeDefContainer()).ValidateData(map[string]interface{}{
+		"resourceType": "Basic",
+		"code":         "test",
+	})
+	assert.Nil(t, outcome)
+	if assert.Error(t, err) {
+		assert.Equal(t, "cannot access Basic: struct property code of type Basic contains invalid data with type string", err.Error())
+	}
+}
+
+func TestRewritePath(t *testing.T) {
+	assert.Equal(t, "extension.exists(true) != value.exists(true)",
+		rewritePath("extension.exists() != value.exists(true)"))
+	assert.Equal(t, "reference.substring(1).trace('url') in %rootResource.contained.id.trace('ids', id)",
+		rewritePath("reference.substring(1).trace('url') in %rootResource.contained.id.trace('ids', id)"))
+}
+
+func TestRewritePathUnsupportedFunctions(t *testing.T) {
+	assert.Equal(t, "start.hasValue().not() or (start <= end)",
+		rewritePath("start.hasValue().not() or (start <= end)"))
+	assert.Equal(t, "descendants().as(canonical) | where(as(FHIR.uri) = '#')",
+		rewritePath("descendants().as(canonical) | where(as(FHIR.uri) = '#')"))
+}
+
+func TestRewritePathLiterals(t *testing.T) {
+	assert.Equal(t, `code = 'it\'s exists()' or code.exists(true)`,
+		rewritePath(`code = 'it\'s exists()' or code.exists()`))
+	assert.Equal(t, "`exists()`.exists(true) // exists()",
+		rewritePath("`exists()`.exists() // exists()"))
+	assert.Equal(t, "code /* exists() */.exists(true)",
+		rewritePath("code /* exists() */.exists()"))
+}
+
+func TestEvaluateExistsWithoutCriteria(t *testing.T) {
+	path, err := gohipath.Compile(rewritePath("name.exists() and birthDate.exists() = false"))
+	if !assert.Nil(t, err) {
+		return
+	}
+	resource, err2 := dynamic.NewDynResource(r4.TypeDefContainer(), map[string]interface{}{
+		"resourceType": "Patient",
+		"name":         []interface{}{map[string]interface{}{"family": "Smith"}},
+	}, nil)
+	if !assert.NoError(t, err2) {
+		return
+	}
+	satisfied, err2 := evaluateCondition(dynamic.NewPathDynModel(r4.TypeDefContainer()), path, resource, resource, resource)
+	if assert.NoError(t, err2) {
+		assert.True(t, satisfied)
+	}
+}
+
+func TestConstraintsCached(t *testing.T) {
+	v := NewInvariantValidator(r4.TypeDefContainer())
+	c := common.NewConstraint("test-1", common.ErrorConstraintSeverity, "", "children().exists()")
+	cc := v.compiledPath(c)
+	assert.NoError(t, cc.err)
+	assert.NotNil(t, cc.path)
+	assert.Same(t, cc, v.compiledPath(c))
+}
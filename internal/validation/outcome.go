@@ -0,0 +1,146 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package validation
+
+import (
+	"fmt"
+	"github.com/healthiop/hi"
+	"github.com/healthiop/hi/internal/dynamic"
+	"github.com/healthiop/hi/internal/r4"
+)
+
+const operationOutcomeTypeName = "OperationOutcome"
+
+// IssueSeverity is the severity of an issue as defined by the FHIR value set
+// issue-severity.
+type IssueSeverity string
+
+const (
+	FatalSeverity       IssueSeverity = "fatal"
+	ErrorSeverity       IssueSeverity = "error"
+	WarningSeverity     IssueSeverity = "warning"
+	InformationSeverity IssueSeverity = "information"
+)
+
+// IssueType is the type of an issue as defined by the FHIR value set
+// issue-type. Only the types that are reported by this package are defined.
+type IssueType string
+
+const (
+	InvalidIssueType       IssueType = "invalid"
+	StructureIssueType     IssueType = "structure"
+	RequiredIssueType      IssueType = "required"
+	ValueIssueType         IssueType = "value"
+	InvariantIssueType     IssueType = "invariant"
+	CodeInvalidIssueType   IssueType = "code-invalid"
+	BusinessRuleIssueType  IssueType = "business-rule"
+	InformationalIssueType IssueType = "informational"
+	ProcessingIssueType    IssueType = "processing"
+)
+
+// Issue is a single issue of an outcome. The expression is a FHIRPath
+// expression that locates the affected value, e.g. Patient.name[0].given[1].
+// The key identifies the violated constraint or rule, if any.
+type Issue struct {
+	Severity    IssueSeverity
+	Code        IssueType
+	Diagnostics string
+	Expression  string
+	Key         string
+}
+
+// Outcome collects the issues of a validation.
+type Outcome struct {
+	Issues []*Issue
+}
+
+func NewOutcome() *Outcome {
+	return &Outcome{}
+}
+
+// AddIssue adds an issue with the specified properties to the outcome.
+func (o *Outcome) AddIssue(severity IssueSeverity, code IssueType, expression string, diagnostics string) {
+	o.Issues = append(o.Issues, &Issue{Severity: severity, Code: code, Diagnostics: diagnostics, Expression: expression})
+}
+
+// Append adds all issues of the specified outcome to this outcome.
+func (o *Outcome) Append(other *Outcome) {
+	o.Issues = append(o.Issues, other.Issues...)
+}
+
+// Valid returns if the outcome contains no issue with severity fatal or error.
+func (o *Outcome) Valid() bool {
+	for _, issue := range o.Issues {
+		if issue.Severity == FatalSeverity || issue.Severity == ErrorSeverity {
+			return false
+		}
+	}
+	return true
+}
+
+// Data returns the outcome as data of an R4 OperationOutcome. Since an
+// OperationOutcome requires at least one issue, an informational issue is
+// added if the outcome contains no issues.
+func (o *Outcome) Data() map[string]interface{} {
+	issues := make([]interface{}, 0, len(o.Issues))
+	for _, issue := range o.Issues {
+		m := map[string]interface{}{
+			"severity": string(issue.Severity),
+			"code":     string(issue.Code),
+		}
+		if issue.Diagnostics != "" {
+			m["diagnostics"] = issue.Diagnostics
+		}
+		if issue.Expression != "" {
+			m["expression"] = []interface{}{issue.Expression}
+		}
+		issues = append(issues, m)
+	}
+	if len(issues) == 0 {
+		issues = append(issues, map[string]interface{}{
+			"severity":    string(InformationSeverity),
+			"code":        string(InformationalIssueType),
+			"diagnostics": "no issues have been detected",
+		})
+	}
+
+	return map[string]interface{}{
+		dynamic.ResourceTypePropName: operationOutcomeTypeName,
+		"issue":                      issues,
+	}
+}
+
+// OperationOutcome returns the outcome as an R4 OperationOutcome resource.
+func (o *Outcome) OperationOutcome() hi.DynResourceAccessor {
+	r, err := dynamic.NewDynResource(r4.TypeDefContainer(), o.Data(), nil)
+	if err != nil {
+		panic(fmt.Sprintf("cannot create operation outcome: %v", err))
+	}
+	return r
+}
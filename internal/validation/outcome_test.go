@@ -0,0 +1,67 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package validation
+
+import (
+	"github.com/healthiop/hi/internal/dynamic"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestOutcomeWithoutIssues(t *testing.T) {
+	o := NewOutcome()
+	assert.True(t, o.Valid())
+	assert.Equal(t, map[string]interface{}{
+		"resourceType": "OperationOutcome",
+		"issue": []interface{}{
+			map[string]interface{}{
+				"severity":    "information",
+				"code":        "informational",
+				"diagnostics": "no issues have been detected",
+			},
+		},
+	}, o.Data())
+}
+
+func TestOutcomeOperationOutcome(t *testing.T) {
+	o := NewOutcome()
+	o.AddIssue(WarningSeverity, ValueIssueType, "Patient.id", "test")
+	assert.True(t, o.Valid())
+	other := NewOutcome()
+	other.AddIssue(FatalSeverity, StructureIssueType, "", "fatal")
+	o.Append(other)
+	assert.False(t, o.Valid())
+
+	r := o.OperationOutcome()
+	assert.Equal(t, "OperationOutcome", r.TypeName())
+	assert.Equal(t, "4.0.1", r.VersionString())
+	if assert.Implements(t, (*dynamic.DynDataRetriever)(nil), r) {
+		assert.Equal(t, o.Data(), r.(dynamic.DynDataRetriever).Data())
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package validation
+
+import (
+	"fmt"
+	"github.com/healthiop/hi"
+	"github.com/healthiop/hi/internal/common"
+	"github.com/healthiop/hi/internal/dynamic"
+	gohipath "github.com/healthiop/hipath"
+	"gopkg.in/yaml.v3"
+	"os"
+	"sync"
+)
+
+// RuleSet is a named set of business rules. Rule sets can be defined in JSON
+// or YAML, e.g.
+//
+//	name: medication
+//	rules:
+//	  - key: med-1
+//	    resourceType: MedicationRequest
+//	    condition: status != 'active' or dosageInstruction.exists()
+//	    severity: error
+//	    message: active MedicationRequest must have dosageInstruction
+type RuleSet struct {
+	Name  string  `yaml:"name"`
+	Rules []*Rule `yaml:"rules"`
+}
+
+// Rule is a business rule that applies to all resources of the resource
+// type and its derived types. The condition is a FHIRPath expression that is
+// evaluated on the resource and that must not result in false. Conditions
+// that use functions that are not supported by hipath (e.g. not and hasValue)
+// are invalid. If the severity is empty, the rule has error severity.
+type Rule struct {
+	Key          string        `yaml:"key"`
+	ResourceType string        `yaml:"resourceType"`
+	Condition    string        `yaml:"condition"`
+	Severity     IssueSeverity `yaml:"severity"`
+	Message      string        `yaml:"message"`
+}
+
+// RuleEngine evaluates business rules of rule sets on resources.
+type RuleEngine struct {
+	typeDefContainer *common.TypeDefContainer
+	model            *dynamic.PathDynModel
+	lock             sync.RWMutex
+	rules            []*compiledRule
+}
+
+type compiledRule struct {
+	*Rule
+	typeDef common.TypeDefAccessor
+	path    *gohipath.Path
+}
+
+// ParseRuleSet parses a rule set in JSON or YAML format.
+func ParseRuleSet(data []byte) (*RuleSet, error) {
+	var ruleSet RuleSet
+	if err := yaml.Unmarshal(data, &ruleSet); err != nil {
+		return nil, fmt.Errorf("invalid rule set: %w", err)
+	}
+	return &ruleSet, nil
+}
+
+// ReadRuleSetFile reads a rule set in JSON or YAML format from the file with
+// the specified name.
+func ReadRuleSetFile(fileName string) (*RuleSet, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	ruleSet, err := ParseRuleSet(data)
+	if err != nil {
+		return nil, fmt.Errorf("file %s contains %w", fileName, err)
+	}
+	return ruleSet, nil
+}
+
+func NewRuleEngine(typeDefContainer *common.TypeDefContainer) *RuleEngine {
+	return &RuleEngine{
+		typeDefContainer: typeDefContainer,
+		model:            dynamic.NewPathDynModel(typeDefContainer),
+	}
+}
+
+func (e *RuleEngine) TypeDefContainer() *common.TypeDefContainer {
+	return e.typeDefContainer
+}
+
+// AddRuleSet compiles the rules of the rule set and adds them to the engine.
+// If any rule is invalid, an error is returned and no rule is added.
+func (e *RuleEngine) AddRuleSet(ruleSet *RuleSet) error {
+	rules := make([]*compiledRule, 0, len(ruleSet.Rules))
+	for i, r := range ruleSet.Rules {
+		cr, err := e.compileRule(r)
+		if err != nil {
+			key := r.Key
+			if key == "" {
+				key = fmt.Sprintf("#%d", i+1)
+			}
+			return fmt.Errorf("rule %s of rule set %s is invalid: %w", key, ruleSet.Name, err)
+		}
+		rules = append(rules, cr)
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.rules = append(e.rules, rules...)
+	return nil
+}
+
+func (e *RuleEngine) compileRule(r *Rule) (*compiledRule, error) {
+	if r.Key == "" {
+		return nil, fmt.Errorf("no key has been specified")
+	}
+	if r.Condition == "" {
+		return nil, fmt.Errorf("no condition has been specified")
+	}
+	if r.Message == "" {
+		return nil, fmt.Errorf("no message has been specified")
+	}
+	switch r.Severity {
+	case "", FatalSeverity, ErrorSeverity, WarningSeverity, InformationSeverity:
+	default:
+		return nil, fmt.Errorf("invalid severity: %s", r.Severity)
+	}
+
+	typeDef := e.typeDefContainer.TypeByName(r.ResourceType)
+	if typeDef == nil || typeDef.TypeKind() != common.ResourceTypeKind {
+		return nil, fmt.Errorf("resource type undefined in FHIR %s: %s",
+			e.typeDefContainer.SymbolicVersion(), r.ResourceType)
+	}
+	path, err := gohipath.Compile(rewritePath(r.Condition))
+	if err != nil {
+		return nil, fmt.Errorf("invalid condition: %s", pathErrorMessage(err))
+	}
+	return &compiledRule{r, typeDef, path}, nil
+}
+
+// Evaluate evaluates all rules that apply to the resource and to the
+// resources that are contained in it (e.g. contained resources and resources
+// of bundle entries). The resource must have been created for the FHIR
+// version of the engine. Each violated rule results in an issue with the
+// severity and message of the rule. Rules that cannot be evaluated result in
+// a warning.
+func (e *RuleEngine) Evaluate(resource hi.DynResourceAccessor) (*Outcome, error) {
+	if resource.VersionString() != e.typeDefContainer.VersionString() {
+		return nil, fmt.Errorf("resource has FHIR version %s instead of %s",
+			resource.VersionString(), e.typeDefContainer.VersionString())
+	}
+
+	e.lock.RLock()
+	rules := e.rules
+	e.lock.RUnlock()
+
+	outcome := NewOutcome()
+	if err := e.evaluateNode(rules, outcome, resource, resource.TypeName(), resource); err != nil {
+		return nil, err
+	}
+	return outcome, nil
+}
+
+// evaluateNode evaluates the rules on the node if it is a resource and on
+// all resources that are contained in the node.
+func (e *RuleEngine) evaluateNode(rules []*compiledRule, outcome *Outcome, node interface{}, location string, rootResource interface{}) error {
+	if resource, ok := dynamic.ResourceOf(node); ok {
+		e.evaluateResource(rules, outcome, resource, location, rootResource)
+	}
+
+	children, err := e.model.NamedChildren(node)
+	if err != nil {
+		return fmt.Errorf("cannot access %s: %w", location, err)
+	}
+	for _, child := range children {
+		if err := e.evaluateNode(rules, outcome, child.Node, childLocation(location, child), rootResource); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *RuleEngine) evaluateResource(rules []*compiledRule, outcome *Outcome, resource hi.DynResourceAccessor, location string, rootResource interface{}) {
+	typeDef := e.typeDefContainer.TypeByName(resource.TypeName())
+	for _, r := range rules {
+		if typeDef == nil || !typeDef.ExtendsTypeName(r.typeDef.InternalName()) {
+			continue
+		}
+		satisfied, err := evaluateCondition(e.model, r.path, resource, resource, rootResource)
+		if err != nil {
+			outcome.Issues = append(outcome.Issues, &Issue{
+				Severity:    WarningSeverity,
+				Code:        ProcessingIssueType,
+				Diagnostics: fmt.Sprintf("rule %s cannot be evaluated: %v", r.Key, err),
+				Expression:  location,
+				Key:         r.Key,
+			})
+		} else if !satisfied {
+			severity := r.Severity
+			if severity == "" {
+				severity = ErrorSeverity
+			}
+			outcome.Issues = append(outcome.Issues, &Issue{
+				Severity:    severity,
+				Code:        BusinessRuleIssueType,
+				Diagnostics: r.Message,
+				Expression:  location,
+				Key:         r.Key,
+			})
+		}
+	}
+}
+
+// EvaluateData evaluates all rules that apply to the resource data. An error
+// is returned if the data cannot be accessed as a resource.
+func (e *RuleEngine) EvaluateData(data map[string]interface{}) (*Outcome, error) {
+	resource, err := dynamic.NewDynResource(e.typeDefContainer, data, nil)
+	if err != nil {
+		return nil, err
+	}
+	return e.Evaluate(resource)
+}
@@ -0,0 +1,269 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package validation
+
+import (
+	"github.com/healthiop/hi/internal/dynamic"
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/healthiop/hi/internal/stu3"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestReadRuleSetFileYAML(t *testing.T) {
+	ruleSet, err := ReadRuleSetFile("testdata/rules.yaml.golden")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "medication", ruleSet.Name)
+		if assert.Len(t, ruleSet.Rules, 3) {
+			assert.Equal(t, &Rule{
+				Key:          "med-1",
+				ResourceType: "MedicationRequest",
+				Condition:    "status != 'active' or dosageInstruction.exists()",
+				Severity:     ErrorSeverity,
+				Message:      "active MedicationRequest must have dosageInstruction",
+			}, ruleSet.Rules[0])
+		}
+	}
+}
+
+func TestParseRuleSetJSON(t *testing.T) {
+	ruleSet, err := ParseRuleSet(readTestFile(t, "rules"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "patient", ruleSet.Name)
+		if assert.Len(t, ruleSet.Rules, 2) {
+			assert.Equal(t, "pat-r1", ruleSet.Rules[0].Key)
+			assert.Equal(t, IssueSeverity(""), ruleSet.Rules[0].Severity)
+		}
+	}
+}
+
+func TestParseRuleSetInvalid(t *testing.T) {
+	_, err := ParseRuleSet([]byte("rules: test"))
+	assert.Error(t, err)
+}
+
+func TestReadRuleSetFileMissing(t *testing.T) {
+	_, err := ReadRuleSetFile("testdata/missing.yaml")
+	assert.Error(t, err)
+}
+
+func TestRuleEngineEvaluate(t *testing.T) {
+	e := NewRuleEngine(r4.TypeDefContainer())
+	assert.Same(t, r4.TypeDefContainer(), e.TypeDefContainer())
+	ruleSet, err := ReadRuleSetFile("testdata/rules.yaml.golden")
+	if !assert.NoError(t, err) {
+		return
+	}
+	if !assert.NoError(t, e.AddRuleSet(ruleSet)) {
+		return
+	}
+
+	outcome, err := e.EvaluateData(readTestData(t, "medication_request"))
+	if assert.NoError(t, err) {
+		assert.False(t, outcome.Valid())
+		assert.Equal(t, []*Issue{
+			{ErrorSeverity, BusinessRuleIssueType, "active MedicationRequest must have dosageInstruction", "MedicationRequest", "med-1"},
+			{InformationSeverity, BusinessRuleIssueType, "MedicationRequest should have a note", "MedicationRequest", "med-2"},
+			{WarningSeverity, BusinessRuleIssueType, "resource should have a narrative", "MedicationRequest", "res-1"},
+		}, outcome.Issues)
+	}
+
+	outcome, err = e.EvaluateData(readTestData(t, "patient_valid"))
+	if assert.NoError(t, err) {
+		assert.True(t, outcome.Valid())
+		assert.Equal(t, []*Issue{
+			{WarningSeverity, BusinessRuleIssueType, "resource should have a narrative", "Patient", "res-1"},
+			{WarningSeverity, BusinessRuleIssueType, "resource should have a narrative", "Patient.contained[0]", "res-1"},
+		}, outcome.Issues)
+	}
+}
+
+func TestRuleEngineEvaluateBundle(t *testing.T) {
+	e := NewRuleEngine(r4.TypeDefContainer())
+	ruleSet, err := ParseRuleSet([]byte(`
+name: bundle
+rules:
+  - key: pat-1
+    resourceType: Patient
+    condition: "%resource.id = 'p1' and %rootResource.type = 'collection'"
+    message: patient must have ID p1
+`))
+	if !assert.NoError(t, err) || !assert.NoError(t, e.AddRuleSet(ruleSet)) {
+		return
+	}
+
+	outcome, err := e.EvaluateData(map[string]interface{}{
+		"resourceType": "Bundle",
+		"type":         "collection",
+		"entry": []interface{}{
+			map[string]interface{}{"resource": map[string]interface{}{"resourceType": "Patient", "id": "p1"}},
+			map[string]interface{}{"resource": map[string]interface{}{"resourceType": "Patient", "id": "p2"}},
+		},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []*Issue{
+			{ErrorSeverity, BusinessRuleIssueType, "patient must have ID p1", "Bundle.entry[1].resource", "pat-1"},
+		}, outcome.Issues)
+	}
+}
+
+func TestRuleEngineEvaluateSatisfied(t *testing.T) {
+	e := NewRuleEngine(r4.TypeDefContainer())
+	ruleSet, err := ReadRuleSetFile("testdata/rules.yaml.golden")
+	if !assert.NoError(t, err) || !assert.NoError(t, e.AddRuleSet(ruleSet)) {
+		return
+	}
+
+	data := readTestData(t, "medication_request")
+	data["status"] = "draft"
+	data["note"] = []interface{}{map[string]interface{}{"text": "Test"}}
+	data["text"] = map[string]interface{}{
+		"status": "generated",
+		"div":    "<div xmlns=\"http://www.w3.org/1999/xhtml\">Test</div>",
+	}
+	outcome, err := e.EvaluateData(data)
+	if assert.NoError(t, err) {
+		assert.Empty(t, outcome.Issues)
+	}
+}
+
+func TestRuleEngineEvaluateUnevaluated(t *testing.T) {
+	e := NewRuleEngine(r4.TypeDefContainer())
+	ruleSet, err := ParseRuleSet(readTestFile(t, "rules"))
+	if !assert.NoError(t, err) || !assert.NoError(t, e.AddRuleSet(ruleSet)) {
+		return
+	}
+
+	data := readTestData(t, "patient_valid")
+	data["birthDate"] = "1970-01-01"
+	outcome, err := e.EvaluateData(data)
+	if assert.NoError(t, err) && assert.Len(t, outcome.Issues, 1) {
+		issue := outcome.Issues[0]
+		assert.Equal(t, WarningSeverity, issue.Severity)
+		assert.Equal(t, ProcessingIssueType, issue.Code)
+		assert.Equal(t, "pat-r2", issue.Key)
+		assert.Equal(t, "Patient", issue.Expression)
+		assert.Contains(t, issue.Diagnostics, "rule pat-r2 cannot be evaluated")
+	}
+}
+
+func TestRuleEngineEvaluateViolated(t *testing.T) {
+	e := NewRuleEngine(r4.TypeDefContainer())
+	ruleSet, err := ParseRuleSet(readTestFile(t, "rules"))
+	if !assert.NoError(t, err) || !assert.NoError(t, e.AddRuleSet(ruleSet)) {
+		return
+	}
+
+	data := readTestData(t, "patient_valid")
+	data["birthDate"] = "2200-01-01"
+	outcome, err := e.EvaluateData(data)
+	if assert.NoError(t, err) && assert.Len(t, outcome.Issues, 2) {
+		assert.Equal(t, &Issue{ErrorSeverity, BusinessRuleIssueType, "birth date must be plausible", "Patient", "pat-r1"}, outcome.Issues[0])
+		assert.Equal(t, "pat-r2", outcome.Issues[1].Key)
+	}
+}
+
+func TestRuleEngineEvaluateLiterals(t *testing.T) {
+	e := NewRuleEngine(r4.TypeDefContainer())
+	err := e.AddRuleSet(&RuleSet{Name: "test", Rules: []*Rule{
+		{Key: "r-1", ResourceType: "Patient", Condition: "name.family != 'exists().not()' and name.exists()", Message: "test"},
+	}})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	data := readTestData(t, "patient_valid")
+	data["name"] = []interface{}{map[string]interface{}{"family": "exists().not()"}}
+	outcome, err := e.EvaluateData(data)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []*Issue{
+			{ErrorSeverity, BusinessRuleIssueType, "test", "Patient", "r-1"},
+		}, outcome.Issues)
+	}
+
+	data["name"] = []interface{}{map[string]interface{}{"family": "Smith"}}
+	outcome, err = e.EvaluateData(data)
+	if assert.NoError(t, err) {
+		assert.Empty(t, outcome.Issues)
+	}
+}
+
+func TestRuleEngineAddRuleSetInvalid(t *testing.T) {
+	valid := Rule{Key: "r-1", ResourceType: "Patient", Condition: "active", Message: "test"}
+	tests := []struct {
+		name   string
+		modify func(r *Rule)
+		err    string
+	}{
+		{"key", func(r *Rule) { r.Key = "" }, "rule #2 of rule set test is invalid: no key has been specified"},
+		{"condition", func(r *Rule) { r.Condition = "" }, "rule r-1 of rule set test is invalid: no condition has been specified"},
+		{"message", func(r *Rule) { r.Message = "" }, "rule r-1 of rule set test is invalid: no message has been specified"},
+		{"severity", func(r *Rule) { r.Severity = "fatality" }, "rule r-1 of rule set test is invalid: invalid severity: fatality"},
+		{"type", func(r *Rule) { r.ResourceType = "Test" }, "rule r-1 of rule set test is invalid: resource type undefined in FHIR R4: Test"},
+		{"non-resource", func(r *Rule) { r.ResourceType = "HumanName" }, "rule r-1 of rule set test is invalid: resource type undefined in FHIR R4: HumanName"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := valid
+			tt.modify(&r)
+			e := NewRuleEngine(r4.TypeDefContainer())
+			err := e.AddRuleSet(&RuleSet{Name: "test", Rules: []*Rule{&valid, &r}})
+			if assert.Error(t, err) {
+				assert.Equal(t, tt.err, err.Error())
+			}
+			assert.Empty(t, e.rules)
+		})
+	}
+}
+
+func TestRuleEngineAddRuleSetInvalidCondition(t *testing.T) {
+	e := NewRuleEngine(r4.TypeDefContainer())
+	err := e.AddRuleSet(&RuleSet{Name: "test", Rules: []*Rule{
+		{Key: "r-1", ResourceType: "Patient", Condition: "name.where(", Message: "test"},
+	}})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "rule r-1 of rule set test is invalid: invalid condition")
+	}
+}
+
+func TestRuleEngineEvaluateVersion(t *testing.T) {
+	resource, err := dynamic.NewDynResource(r4.TypeDefContainer(), readTestData(t, "patient_valid"), nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = NewRuleEngine(stu3.TypeDefContainer()).Evaluate(resource)
+	if assert.Error(t, err) {
+		assert.Equal(t, "resource has FHIR version 4.0.1 instead of 3.0.2", err.Error())
+	}
+}
+
+func TestRuleEngineEvaluateInvalidData(t *testing.T) {
+	_, err := NewRuleEngine(r4.TypeDefContainer()).EvaluateData(map[string]interface{}{"resourceType": "Test"})
+	assert.Error(t, err)
+}
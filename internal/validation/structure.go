@@ -0,0 +1,392 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/healthiop/hi"
+	"github.com/healthiop/hi/internal/common"
+	"github.com/healthiop/hi/internal/dynamic"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// StructureValidator validates the structure of resource data against the
+// type definitions of a FHIR version. It reports unknown properties, values
+// with unexpected JSON types, invalid primitive values, invalid resource
+// types, and empty objects and arrays.
+type StructureValidator struct {
+	typeDefContainer *common.TypeDefContainer
+}
+
+type structureValidation struct {
+	*StructureValidator
+	outcome *Outcome
+}
+
+func NewStructureValidator(typeDefContainer *common.TypeDefContainer) *StructureValidator {
+	return &StructureValidator{typeDefContainer}
+}
+
+func (v *StructureValidator) TypeDefContainer() *common.TypeDefContainer {
+	return v.typeDefContainer
+}
+
+// Validate validates the structure of the specified resource. The resource
+// must have been created for the FHIR version of the validator.
+func (v *StructureValidator) Validate(resource hi.DynResourceAccessor) (*Outcome, error) {
+	if resource.VersionString() != v.typeDefContainer.VersionString() {
+		return nil, fmt.Errorf("resource has FHIR version %s instead of %s",
+			resource.VersionString(), v.typeDefContainer.VersionString())
+	}
+	dataRetriever, ok := resource.(dynamic.DynDataRetriever)
+	if !ok {
+		return nil, fmt.Errorf("resource data cannot be accessed: %T", resource)
+	}
+	return v.ValidateData(dataRetriever.Data()), nil
+}
+
+// ValidateData validates the structure of the specified resource data. All
+// detected issues are contained in the returned outcome.
+func (v *StructureValidator) ValidateData(data map[string]interface{}) *Outcome {
+	va := &structureValidation{v, NewOutcome()}
+	va.validateResource(data, nil, "")
+	return va.outcome
+}
+
+func (va *structureValidation) issue(severity IssueSeverity, code IssueType, expression string, format string, a ...interface{}) {
+	va.outcome.AddIssue(severity, code, expression, fmt.Sprintf(format, a...))
+}
+
+// validateResource validates the resource data at the specified path. An
+// empty path marks the validated resource itself. The resource type must be
+// derived from the expected type if one has been specified.
+func (va *structureValidation) validateResource(data map[string]interface{}, expected common.TypeDefAccessor, path string) {
+	value, found := data[dynamic.ResourceTypePropName]
+	resourceType, ok := value.(string)
+	location := joinPath(path, dynamic.ResourceTypePropName)
+	if path == "" {
+		location = common.ResourceTypeName + "." + dynamic.ResourceTypePropName
+	}
+	if !found {
+		va.issue(ErrorSeverity, StructureIssueType, location, "resource contains no resource type")
+		return
+	}
+	if !ok {
+		va.issue(ErrorSeverity, StructureIssueType, location,
+			"resource type must be a string but has JSON type %s", jsonTypeName(value))
+		return
+	}
+
+	typeDef := va.typeDefContainer.TypeByName(resourceType)
+	if typeDef == nil {
+		if renamed := va.typeDefContainer.RenamedTypeNames(resourceType); len(renamed) > 0 {
+			va.issue(ErrorSeverity, InvalidIssueType, location, "resource type undefined in FHIR %s: %s (use %s)",
+				va.typeDefContainer.SymbolicVersion(), resourceType, strings.Join(renamed, " or "))
+		} else {
+			va.issue(ErrorSeverity, InvalidIssueType, location, "resource type undefined: %s", resourceType)
+		}
+		return
+	}
+	structTypeDef, ok := typeDef.(common.StructTypeDefAccessor)
+	if !ok || structTypeDef.TypeKind() != common.ResourceTypeKind {
+		va.issue(ErrorSeverity, InvalidIssueType, location, "no resource type: %s", resourceType)
+		return
+	}
+	if expected != nil && !structTypeDef.ExtendsTypeName(expected.InternalName()) {
+		va.issue(ErrorSeverity, InvalidIssueType, location,
+			"resource type %s is not allowed, expected %s", resourceType, expected.Name())
+		return
+	}
+
+	if path == "" {
+		path = resourceType
+	}
+	va.validateStruct(structTypeDef, data, path)
+}
+
+// validateStruct validates the properties of the struct data. Primitive
+// values and their elements, which are stored with a leading underscore, are
+// validated together.
+func (va *structureValidation) validateStruct(typeDef common.StructTypeDefAccessor, data map[string]interface{}, path string) {
+	if len(data) == 0 {
+		va.issue(ErrorSeverity, StructureIssueType, path, "object must not be empty")
+		return
+	}
+
+	names := make([]string, 0, len(data))
+	for name := range data {
+		if name == dynamic.ResourceTypePropName && typeDef.TypeKind() == common.ResourceTypeKind {
+			continue
+		}
+		if strings.HasPrefix(name, "_") {
+			if _, found := data[name[1:]]; found {
+				continue
+			}
+		}
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return strings.TrimPrefix(names[i], "_") < strings.TrimPrefix(names[j], "_")
+	})
+
+	for _, name := range names {
+		propName := strings.TrimPrefix(name, "_")
+		location := joinPath(path, propName)
+		propDef := typeDef.PropByName(propName)
+		if propDef == nil {
+			va.issue(ErrorSeverity, StructureIssueType, location,
+				"property %s is not defined by type %s", propName, typeName(typeDef))
+			continue
+		}
+
+		if propDef.Type().TypeKind() == common.PrimitiveTypeKind {
+			value, valueFound := data[propName]
+			element, elementFound := data["_"+propName]
+			va.validatePrimitiveProp(propDef, value, valueFound, element, elementFound, location)
+			continue
+		}
+		if _, found := data["_"+propName]; found {
+			va.issue(ErrorSeverity, StructureIssueType, location,
+				"property %s of type %s is not primitive and cannot have an element _%s",
+				propName, typeName(typeDef), propName)
+		}
+		if value, found := data[propName]; found {
+			va.validateProp(propDef, value, location)
+		}
+	}
+}
+
+func (va *structureValidation) validateProp(propDef *common.PropDef, value interface{}, location string) {
+	if !propDef.Array() {
+		va.validateValue(propDef, value, location)
+		return
+	}
+
+	items, ok := va.arrayValue(value, location)
+	if !ok {
+		return
+	}
+	for i, item := range items {
+		va.validateValue(propDef, item, fmt.Sprintf("%s[%d]", location, i))
+	}
+}
+
+func (va *structureValidation) validatePrimitiveProp(propDef *common.PropDef, value interface{}, valueFound bool,
+	element interface{}, elementFound bool, location string) {
+	if !propDef.Array() {
+		if valueFound {
+			va.validateValue(propDef, value, location)
+		}
+		if elementFound {
+			va.validateElement(element, location)
+		}
+		return
+	}
+
+	var values, elements []interface{}
+	ok := true
+	if valueFound {
+		values, ok = va.arrayValue(value, location)
+	}
+	if elementFound {
+		var elementsOk bool
+		elements, elementsOk = va.arrayValue(element, location)
+		ok = ok && elementsOk
+	}
+	if !ok {
+		return
+	}
+	if valueFound && elementFound && len(values) != len(elements) {
+		va.issue(ErrorSeverity, StructureIssueType, location,
+			"primitive list has %d value(s) but %d element(s)", len(values), len(elements))
+		return
+	}
+
+	count := len(values)
+	if count < len(elements) {
+		count = len(elements)
+	}
+	for i := 0; i < count; i++ {
+		itemLocation := fmt.Sprintf("%s[%d]", location, i)
+		var value, element interface{}
+		if i < len(values) {
+			value = values[i]
+		}
+		if i < len(elements) {
+			element = elements[i]
+		}
+		if value == nil && element == nil {
+			va.issue(ErrorSeverity, StructureIssueType, itemLocation, "list item must not be null")
+			continue
+		}
+		if value != nil {
+			va.validateValue(propDef, value, itemLocation)
+		}
+		if element != nil {
+			va.validateElement(element, itemLocation)
+		}
+	}
+}
+
+func (va *structureValidation) arrayValue(value interface{}, location string) ([]interface{}, bool) {
+	items, ok := value.([]interface{})
+	if !ok {
+		va.issue(ErrorSeverity, StructureIssueType, location,
+			"value must be an array but has JSON type %s", jsonTypeName(value))
+		return nil, false
+	}
+	if len(items) == 0 {
+		va.issue(ErrorSeverity, StructureIssueType, location, "array must not be empty")
+		return nil, false
+	}
+	return items, true
+}
+
+func (va *structureValidation) validateValue(propDef *common.PropDef, value interface{}, location string) {
+	if _, ok := value.([]interface{}); ok {
+		va.issue(ErrorSeverity, StructureIssueType, location, "value must not be an array")
+		return
+	}
+	if value == nil {
+		va.issue(ErrorSeverity, StructureIssueType, location, "value must not be null")
+		return
+	}
+
+	switch typeDef := propDef.Type().(type) {
+	case common.PrimitiveTypeDefAccessor:
+		va.validatePrimitiveValue(typeDef, propDef.Enum(), value, location)
+	case common.StructTypeDefAccessor:
+		data, ok := value.(map[string]interface{})
+		if !ok {
+			va.issue(ErrorSeverity, StructureIssueType, location,
+				"value must be an object but has JSON type %s", jsonTypeName(value))
+			return
+		}
+		if typeDef.TypeKind() == common.ResourceTypeKind {
+			va.validateResource(data, typeDef, location)
+		} else {
+			va.validateStruct(typeDef, data, location)
+		}
+	}
+}
+
+func (va *structureValidation) validateElement(element interface{}, location string) {
+	data, ok := element.(map[string]interface{})
+	if !ok {
+		va.issue(ErrorSeverity, StructureIssueType, location,
+			"primitive element must be an object but has JSON type %s", jsonTypeName(element))
+		return
+	}
+	va.validateStruct(va.typeDefContainer.ElementType(), data, location)
+}
+
+func (va *structureValidation) validatePrimitiveValue(typeDef common.PrimitiveTypeDefAccessor, enum []string, value interface{}, location string) {
+	var s string
+	switch typeDef.SimpleType() {
+	case common.StringSimpleType:
+		v, ok := value.(string)
+		if !ok {
+			va.issue(ErrorSeverity, StructureIssueType, location,
+				"value of type %s must be a string but has JSON type %s", typeDef.Name(), jsonTypeName(value))
+			return
+		}
+		s = v
+	case common.NumberSimpleType:
+		switch v := value.(type) {
+		case float64:
+			s = strconv.FormatFloat(v, 'f', -1, 64)
+		case json.Number:
+			s = v.String()
+		default:
+			va.issue(ErrorSeverity, StructureIssueType, location,
+				"value of type %s must be a number but has JSON type %s", typeDef.Name(), jsonTypeName(value))
+			return
+		}
+	case common.BoolSimpleType:
+		if _, ok := value.(bool); !ok {
+			va.issue(ErrorSeverity, StructureIssueType, location,
+				"value of type %s must be a boolean but has JSON type %s", typeDef.Name(), jsonTypeName(value))
+		}
+		return
+	}
+
+	if pattern := typeDef.Pattern(); pattern != nil && !pattern.MatchString(s) {
+		va.issue(ErrorSeverity, ValueIssueType, location, "value is not a valid %s: %s", typeDef.Name(), s)
+		return
+	}
+	if len(enum) > 0 && !containsString(enum, s) {
+		va.issue(ErrorSeverity, CodeInvalidIssueType, location,
+			"code is not allowed: %s (allowed: %s)", s, strings.Join(enum, ", "))
+	}
+}
+
+func joinPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func typeName(typeDef common.TypeDefAccessor) string {
+	if typeDef.Anonymous() {
+		return typeDef.InternalName()
+	}
+	return typeDef.Name()
+}
+
+func jsonTypeName(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case float64, json.Number:
+		return "number"
+	case bool:
+		return "boolean"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package validation
+
+import (
+	"encoding/json"
+	"github.com/healthiop/hi/internal/dynamic"
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/healthiop/hi/internal/stu3"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"testing"
+)
+
+func TestStructureValidatorValid(t *testing.T) {
+	v := NewStructureValidator(r4.TypeDefContainer())
+	outcome := v.ValidateData(readTestData(t, "patient_valid"))
+	assert.Empty(t, outcome.Issues)
+	assert.True(t, outcome.Valid())
+}
+
+func TestStructureValidatorInvalid(t *testing.T) {
+	v := NewStructureValidator(r4.TypeDefContainer())
+	outcome := v.ValidateData(readTestData(t, "patient_invalid"))
+	assert.False(t, outcome.Valid())
+	assert.Len(t, outcome.Issues, 16)
+	assert.Equal(t, readTestData(t, "patient_invalid_outcome"), jsonRoundTrip(t, outcome.Data()))
+}
+
+func TestStructureValidatorResource(t *testing.T) {
+	r, err := dynamic.NewDynResource(r4.TypeDefContainer(), readTestData(t, "patient_invalid"), nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	outcome, err := NewStructureValidator(r4.TypeDefContainer()).Validate(r)
+	if assert.NoError(t, err) {
+		assert.Len(t, outcome.Issues, 16)
+	}
+}
+
+func TestStructureValidatorResourceVersion(t *testing.T) {
+	r, err := dynamic.NewDynResource(stu3.TypeDefContainer(), map[string]interface{}{"resourceType": "Patient"}, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	outcome, err := NewStructureValidator(r4.TypeDefContainer()).Validate(r)
+	assert.Nil(t, outcome)
+	if assert.Error(t, err) {
+		assert.Equal(t, "resource has FHIR version 3.0.2 instead of 4.0.1", err.Error())
+	}
+}
+
+func TestStructureValidatorResourceType(t *testing.T) {
+	tests := []struct {
+		data       map[string]interface{}
+		code       IssueType
+		expression string
+		message    string
+	}{
+		{map[string]interface{}{"id": "test"}, StructureIssueType, "Resource.resourceType",
+			"resource contains no resource type"},
+		{map[string]interface{}{"resourceType": 10.0}, StructureIssueType, "Resource.resourceType",
+			"resource type must be a string but has JSON type number"},
+		{map[string]interface{}{"resourceType": "Test"}, InvalidIssueType, "Resource.resourceType",
+			"resource type undefined: Test"},
+		{map[string]interface{}{"resourceType": "BodySite"}, InvalidIssueType, "Resource.resourceType",
+			"resource type undefined in FHIR R4: BodySite (use BodyStructure)"},
+		{map[string]interface{}{"resourceType": "HumanName"}, InvalidIssueType, "Resource.resourceType",
+			"no resource type: HumanName"},
+		{map[string]interface{}{"resourceType": "Bundle", "entry": []interface{}{
+			map[string]interface{}{"resource": map[string]interface{}{"resourceType": "Patient_Contact"}},
+		}}, InvalidIssueType, "Bundle.entry[0].resource.resourceType", "no resource type: Patient_Contact"},
+	}
+
+	v := NewStructureValidator(r4.TypeDefContainer())
+	for _, test := range tests {
+		outcome := v.ValidateData(test.data)
+		if assert.Len(t, outcome.Issues, 1, test.message) {
+			assert.Equal(t, &Issue{ErrorSeverity, test.code, test.message, test.expression, ""}, outcome.Issues[0])
+		}
+	}
+}
+
+func TestStructureValidatorEmptyResource(t *testing.T) {
+	outcome := NewStructureValidator(r4.TypeDefContainer()).ValidateData(map[string]interface{}{
+		"resourceType": "Basic",
+		"code":         map[string]interface{}{"coding": []interface{}{map[string]interface{}{}}},
+		"_id":          map[string]interface{}{"extension": []interface{}{}},
+		"_code":        map[string]interface{}{},
+	})
+	if assert.Len(t, outcome.Issues, 3) {
+		assert.Equal(t, &Issue{ErrorSeverity, StructureIssueType,
+			"property code of type Basic is not primitive and cannot have an element _code", "Basic.code", ""}, outcome.Issues[0])
+		assert.Equal(t, &Issue{ErrorSeverity, StructureIssueType, "object must not be empty", "Basic.code.coding[0]", ""}, outcome.Issues[1])
+		assert.Equal(t, &Issue{ErrorSeverity, StructureIssueType, "array must not be empty", "Basic.id.extension", ""}, outcome.Issues[2])
+	}
+}
+
+func jsonRoundTrip(t *testing.T, data map[string]interface{}) map[string]interface{} {
+	b, err := json.Marshal(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var result map[string]interface{}
+	if err := json.Unmarshal(b, &result); err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+func readTestData(t *testing.T, name string) map[string]interface{} {
+	var data map[string]interface{}
+	if err := json.Unmarshal(readTestFile(t, name), &data); err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func readTestFile(t *testing.T, name string) []byte {
+	b, err := ioutil.ReadFile("testdata/" + name + ".json.golden")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
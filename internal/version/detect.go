@@ -0,0 +1,258 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package version
+
+import (
+	"fmt"
+	"github.com/healthiop/hi/internal/common"
+	"mime"
+	"strings"
+)
+
+const (
+	FHIRVersionMIMEParam = "fhirversion"
+	coreCanonicalPrefix  = "http://hl7.org/fhir/"
+	structureDefPath     = "StructureDefinition/"
+	resourceTypePropName = "resourceType"
+	fhirCommentsPropName = "fhir_comments"
+)
+
+// AmbiguousVersionError is returned if more than one registered FHIR version
+// matches the payload. The candidates contain the symbolic versions of the
+// matching type definition containers.
+type AmbiguousVersionError struct {
+	Candidates []string
+}
+
+func (e *AmbiguousVersionError) Error() string {
+	return fmt.Sprintf("FHIR version is ambiguous: %s", strings.Join(e.Candidates, ", "))
+}
+
+// Detect returns the type definition container that matches the specified
+// resource data. The fhirVersion parameter of the MIME type (if specified)
+// takes precedence over the profiles in meta.profile, which in turn take
+// precedence over the structure of the data. If more than one version
+// matches, an AmbiguousVersionError is returned.
+func (r *Registry) Detect(mimeType string, data map[string]interface{}) (*common.TypeDefContainer, error) {
+	if c, err := r.ByMIMEType(mimeType); c != nil || err != nil {
+		return c, err
+	}
+	if c, err := r.ByProfiles(data); c != nil || err != nil {
+		return c, err
+	}
+	return r.ByStructure(data)
+}
+
+// ByMIMEType returns the type definition container that matches the
+// fhirVersion parameter of the specified MIME type. If the MIME type is
+// empty or does not contain the parameter, nil is returned.
+func (r *Registry) ByMIMEType(mimeType string) (*common.TypeDefContainer, error) {
+	if len(mimeType) == 0 {
+		return nil, nil
+	}
+
+	_, params, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		return nil, fmt.Errorf("invalid MIME type %s: %v", mimeType, err)
+	}
+	version, found := params[FHIRVersionMIMEParam]
+	if !found {
+		return nil, nil
+	}
+
+	c := r.ByVersion(version)
+	if c == nil {
+		return nil, fmt.Errorf("unsupported FHIR version in MIME type: %s", version)
+	}
+	return c, nil
+}
+
+// ByProfiles returns the type definition container that matches the core
+// profiles in meta.profile of the specified resource data. Only versioned
+// core profiles (e.g. http://hl7.org/fhir/StructureDefinition/Patient|4.0.1
+// or http://hl7.org/fhir/R4/StructureDefinition/Patient) are considered. If
+// there are no such profiles, nil is returned.
+func (r *Registry) ByProfiles(data map[string]interface{}) (*common.TypeDefContainer, error) {
+	meta, ok := data["meta"].(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	profiles, ok := meta["profile"].([]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	var candidates []*common.TypeDefContainer
+	for _, p := range profiles {
+		if profile, ok := p.(string); ok {
+			if version := coreProfileVersion(profile); len(version) > 0 {
+				if c := r.ByVersion(version); c != nil {
+					candidates = appendCandidate(candidates, c)
+				}
+			}
+		}
+	}
+	return singleCandidate(candidates)
+}
+
+// ByStructure returns the type definition container of the only registered
+// FHIR version whose type definitions contain all resource types, properties
+// and required codes used by the specified resource data.
+func (r *Registry) ByStructure(data map[string]interface{}) (*common.TypeDefContainer, error) {
+	if _, ok := data[resourceTypePropName].(string); !ok {
+		return nil, fmt.Errorf("data contains no resource type")
+	}
+
+	var candidates []*common.TypeDefContainer
+	for _, c := range r.Containers() {
+		if compatibleResource(c, data) {
+			candidates = append(candidates, c)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("data is not compatible with any registered FHIR version")
+	}
+	return singleCandidate(candidates)
+}
+
+func coreProfileVersion(profile string) string {
+	if !strings.HasPrefix(profile, coreCanonicalPrefix) {
+		return ""
+	}
+	path := profile[len(coreCanonicalPrefix):]
+
+	if pos := strings.LastIndexByte(path, '|'); pos >= 0 {
+		if strings.HasPrefix(path, structureDefPath) {
+			return path[pos+1:]
+		}
+		return ""
+	}
+
+	pos := strings.IndexByte(path, '/')
+	if pos <= 0 || !strings.HasPrefix(path[pos+1:], structureDefPath) {
+		return ""
+	}
+	return path[:pos]
+}
+
+func appendCandidate(candidates []*common.TypeDefContainer, c *common.TypeDefContainer) []*common.TypeDefContainer {
+	for _, existing := range candidates {
+		if existing == c {
+			return candidates
+		}
+	}
+	return append(candidates, c)
+}
+
+func singleCandidate(candidates []*common.TypeDefContainer) (*common.TypeDefContainer, error) {
+	switch len(candidates) {
+	case 0:
+		return nil, nil
+	case 1:
+		return candidates[0], nil
+	}
+
+	symbolicVersions := make([]string, len(candidates))
+	for i, c := range candidates {
+		symbolicVersions[i] = c.SymbolicVersion()
+	}
+	return nil, &AmbiguousVersionError{symbolicVersions}
+}
+
+func compatibleResource(c *common.TypeDefContainer, data map[string]interface{}) bool {
+	resourceType, ok := data[resourceTypePropName].(string)
+	if !ok {
+		return false
+	}
+	typeDef, ok := c.TypeByName(resourceType).(common.StructTypeDefAccessor)
+	if !ok || typeDef.TypeKind() != common.ResourceTypeKind {
+		return false
+	}
+	return compatibleStruct(c, typeDef, data)
+}
+
+func compatibleStruct(c *common.TypeDefContainer, typeDef common.StructTypeDefAccessor, data map[string]interface{}) bool {
+	for name, value := range data {
+		if name == resourceTypePropName || name == fhirCommentsPropName {
+			continue
+		}
+
+		if strings.HasPrefix(name, "_") {
+			if typeDef.PropByName(name[1:]) == nil || !compatibleElement(c, value) {
+				return false
+			}
+		} else if prop := typeDef.PropByName(name); prop == nil || !compatibleValue(c, prop, value) {
+			return false
+		}
+	}
+	return true
+}
+
+func compatibleElement(c *common.TypeDefContainer, value interface{}) bool {
+	switch v := value.(type) {
+	case []interface{}:
+		for _, item := range v {
+			if !compatibleElement(c, item) {
+				return false
+			}
+		}
+	case map[string]interface{}:
+		return compatibleStruct(c, c.ElementType(), v)
+	}
+	return true
+}
+
+func compatibleValue(c *common.TypeDefContainer, prop *common.PropDef, value interface{}) bool {
+	switch v := value.(type) {
+	case []interface{}:
+		for _, item := range v {
+			if !compatibleValue(c, prop, item) {
+				return false
+			}
+		}
+	case map[string]interface{}:
+		if prop.Type().TypeKind() == common.ResourceTypeKind {
+			return compatibleResource(c, v)
+		}
+		if typeDef, ok := prop.Type().(common.StructTypeDefAccessor); ok {
+			return compatibleStruct(c, typeDef, v)
+		}
+		return false
+	case string:
+		if enum := prop.Enum(); len(enum) > 0 {
+			for _, code := range enum {
+				if code == v {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	return true
+}
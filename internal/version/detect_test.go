@@ -0,0 +1,212 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package version
+
+import (
+	"encoding/json"
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/healthiop/hi/internal/stu3"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+var coreProfileVersionTests = []struct {
+	profile string
+	version string
+}{
+	{"http://hl7.org/fhir/StructureDefinition/Patient|4.0.1", "4.0.1"},
+	{"http://hl7.org/fhir/R4/StructureDefinition/Patient", "R4"},
+	{"http://hl7.org/fhir/3.0/StructureDefinition/Patient", "3.0"},
+	{"http://hl7.org/fhir/StructureDefinition/Patient", ""},
+	{"http://hl7.org/fhir/us/core/StructureDefinition/us-core-patient|3.1.1", ""},
+	{"http://example.com/StructureDefinition/Patient|4.0.1", ""},
+}
+
+func TestCoreProfileVersion(t *testing.T) {
+	for _, tt := range coreProfileVersionTests {
+		t.Run(tt.profile, func(t *testing.T) {
+			assert.Equal(t, tt.version, coreProfileVersion(tt.profile))
+		})
+	}
+}
+
+func TestByMIMEType(t *testing.T) {
+	c, err := DefaultRegistry().ByMIMEType("application/fhir+json; fhirVersion=4.0")
+	assert.NoError(t, err)
+	assert.Same(t, r4.TypeDefContainer(), c)
+}
+
+func TestByMIMETypeNoVersion(t *testing.T) {
+	c, err := DefaultRegistry().ByMIMEType("application/fhir+json")
+	assert.NoError(t, err)
+	assert.Nil(t, c)
+}
+
+func TestByMIMETypeUnsupported(t *testing.T) {
+	c, err := DefaultRegistry().ByMIMEType("application/fhir+json; fhirVersion=1.0")
+	assert.Nil(t, c)
+	if assert.Error(t, err) {
+		assert.Equal(t, "unsupported FHIR version in MIME type: 1.0", err.Error())
+	}
+}
+
+func TestByMIMETypeInvalid(t *testing.T) {
+	c, err := DefaultRegistry().ByMIMEType("application/fhir+json; fhirVersion")
+	assert.Nil(t, c)
+	assert.Error(t, err)
+}
+
+func TestByProfiles(t *testing.T) {
+	data := readTestData(t, "patient.json.golden")
+	data["meta"] = map[string]interface{}{
+		"profile": []interface{}{
+			"http://example.com/StructureDefinition/test",
+			"http://hl7.org/fhir/StructureDefinition/Patient|3.0.2",
+		},
+	}
+	c, err := DefaultRegistry().ByProfiles(data)
+	assert.NoError(t, err)
+	assert.Same(t, stu3.TypeDefContainer(), c)
+}
+
+func TestByProfilesConflicting(t *testing.T) {
+	data := readTestData(t, "patient.json.golden")
+	data["meta"] = map[string]interface{}{
+		"profile": []interface{}{
+			"http://hl7.org/fhir/StructureDefinition/Patient|3.0.2",
+			"http://hl7.org/fhir/R4/StructureDefinition/Patient",
+		},
+	}
+	c, err := DefaultRegistry().ByProfiles(data)
+	assert.Nil(t, c)
+	if assert.IsType(t, (*AmbiguousVersionError)(nil), err) {
+		assert.Equal(t, []string{"STU3", "R4"}, err.(*AmbiguousVersionError).Candidates)
+	}
+}
+
+func TestByProfilesNone(t *testing.T) {
+	c, err := DefaultRegistry().ByProfiles(readTestData(t, "patient.json.golden"))
+	assert.NoError(t, err)
+	assert.Nil(t, c)
+}
+
+func TestByStructureSTU3(t *testing.T) {
+	c, err := DefaultRegistry().ByStructure(readTestData(t, "medication_statement_stu3.json.golden"))
+	assert.NoError(t, err)
+	assert.Same(t, stu3.TypeDefContainer(), c)
+}
+
+func TestByStructureR4(t *testing.T) {
+	c, err := DefaultRegistry().ByStructure(readTestData(t, "medication_statement_r4.json.golden"))
+	assert.NoError(t, err)
+	assert.Same(t, r4.TypeDefContainer(), c)
+}
+
+func TestByStructureBundle(t *testing.T) {
+	c, err := DefaultRegistry().ByStructure(readTestData(t, "bundle_r4.json.golden"))
+	assert.NoError(t, err)
+	assert.Same(t, r4.TypeDefContainer(), c)
+}
+
+func TestByStructureAmbiguous(t *testing.T) {
+	c, err := DefaultRegistry().ByStructure(readTestData(t, "patient.json.golden"))
+	assert.Nil(t, c)
+	if assert.IsType(t, (*AmbiguousVersionError)(nil), err) {
+		assert.Equal(t, []string{"STU3", "R4"}, err.(*AmbiguousVersionError).Candidates)
+		assert.Equal(t, "FHIR version is ambiguous: STU3, R4", err.Error())
+	}
+}
+
+func TestByStructureIncompatible(t *testing.T) {
+	data := readTestData(t, "patient.json.golden")
+	data["test"] = "value"
+	c, err := DefaultRegistry().ByStructure(data)
+	assert.Nil(t, c)
+	if assert.Error(t, err) {
+		assert.Equal(t, "data is not compatible with any registered FHIR version", err.Error())
+	}
+}
+
+func TestByStructureNoResourceType(t *testing.T) {
+	c, err := DefaultRegistry().ByStructure(map[string]interface{}{"id": "test"})
+	assert.Nil(t, c)
+	if assert.Error(t, err) {
+		assert.Equal(t, "data contains no resource type", err.Error())
+	}
+}
+
+func TestDetectMIMETypePrecedence(t *testing.T) {
+	c, err := DefaultRegistry().Detect("application/fhir+json; fhirVersion=3.0",
+		readTestData(t, "medication_statement_r4.json.golden"))
+	assert.NoError(t, err)
+	assert.Same(t, stu3.TypeDefContainer(), c)
+}
+
+func TestDetectProfilePrecedence(t *testing.T) {
+	data := readTestData(t, "patient.json.golden")
+	data["meta"] = map[string]interface{}{
+		"profile": []interface{}{"http://hl7.org/fhir/R4/StructureDefinition/Patient"},
+	}
+	c, err := DefaultRegistry().Detect("application/fhir+json", data)
+	assert.NoError(t, err)
+	assert.Same(t, r4.TypeDefContainer(), c)
+}
+
+func TestDetectStructure(t *testing.T) {
+	c, err := DefaultRegistry().Detect("", readTestData(t, "medication_statement_stu3.json.golden"))
+	assert.NoError(t, err)
+	assert.Same(t, stu3.TypeDefContainer(), c)
+}
+
+func TestDetectAmbiguous(t *testing.T) {
+	c, err := DefaultRegistry().Detect("", readTestData(t, "patient.json.golden"))
+	assert.Nil(t, c)
+	assert.IsType(t, (*AmbiguousVersionError)(nil), err)
+}
+
+func readTestData(t *testing.T, fileName string) map[string]interface{} {
+	var data map[string]interface{}
+	if err := json.Unmarshal(readTestFile(t, fileName), &data); err != nil {
+		t.Fatal(err)
+		return nil
+	}
+	return data
+}
+
+func readTestFile(t *testing.T, fileName string) []byte {
+	golden := filepath.Join("testdata", fileName)
+	if content, err := ioutil.ReadFile(golden); err != nil {
+		t.Fatal(err)
+		return nil
+	} else {
+		return content
+	}
+}
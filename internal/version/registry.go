@@ -0,0 +1,162 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package version
+
+import (
+	"fmt"
+	"github.com/healthiop/hi/internal/common"
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/healthiop/hi/internal/stu3"
+	"strings"
+	"sync"
+)
+
+type Registry struct {
+	lock            sync.RWMutex
+	containers      []*common.TypeDefContainer
+	bySymbolic      map[string]*common.TypeDefContainer
+	byVersionString map[string]*common.TypeDefContainer
+	byMajorMinor    map[string]*common.TypeDefContainer
+}
+
+var defaultRegistryOnce sync.Once
+var defaultRegistry *Registry
+
+// DefaultRegistry returns the registry that contains all released FHIR
+// versions that are supported by this module. The R5 preview release is not
+// contained, since resources of R5 must not be handled as resources of the
+// preview release.
+func DefaultRegistry() *Registry {
+	defaultRegistryOnce.Do(func() {
+		defaultRegistry = NewRegistry(
+			stu3.TypeDefContainer(),
+			r4.TypeDefContainer())
+	})
+	return defaultRegistry
+}
+
+// NewRegistry creates a registry that contains the specified type definition
+// containers. The order of the containers is kept (e.g. for the candidates
+// of an ambiguous version).
+func NewRegistry(containers ...*common.TypeDefContainer) *Registry {
+	r := &Registry{
+		bySymbolic:      make(map[string]*common.TypeDefContainer),
+		byVersionString: make(map[string]*common.TypeDefContainer),
+		byMajorMinor:    make(map[string]*common.TypeDefContainer),
+	}
+	for _, c := range containers {
+		if err := r.Register(c); err != nil {
+			panic(err.Error())
+		}
+	}
+	return r
+}
+
+// Register adds the specified type definition container to the registry.
+// An error is returned if the symbolic version or the version string has
+// already been registered.
+func (r *Registry) Register(container *common.TypeDefContainer) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	symbolic := strings.ToUpper(container.SymbolicVersion())
+	if _, found := r.bySymbolic[symbolic]; found {
+		return fmt.Errorf("symbolic version has already been registered: %s", container.SymbolicVersion())
+	}
+	if _, found := r.byVersionString[container.VersionString()]; found {
+		return fmt.Errorf("version has already been registered: %s", container.VersionString())
+	}
+
+	r.containers = append(r.containers, container)
+	r.bySymbolic[symbolic] = container
+	r.byVersionString[container.VersionString()] = container
+	if majorMinor := majorMinorVersion(container.VersionString()); majorMinor != "" {
+		if _, found := r.byMajorMinor[majorMinor]; !found {
+			r.byMajorMinor[majorMinor] = container
+		}
+	}
+	return nil
+}
+
+// Containers returns all registered type definition containers in the order
+// of their registration.
+func (r *Registry) Containers() []*common.TypeDefContainer {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	containers := make([]*common.TypeDefContainer, len(r.containers))
+	copy(containers, r.containers)
+	return containers
+}
+
+// BySymbolicVersion returns the type definition container with the specified
+// symbolic version (e.g. R4). The symbolic version is case insensitive. If
+// no such container has been registered, nil is returned.
+func (r *Registry) BySymbolicVersion(symbolicVersion string) *common.TypeDefContainer {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return r.bySymbolic[strings.ToUpper(symbolicVersion)]
+}
+
+// ByVersionString returns the type definition container with the specified
+// version string (e.g. 4.0.1). If no such container has been registered, nil
+// is returned.
+func (r *Registry) ByVersionString(versionString string) *common.TypeDefContainer {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return r.byVersionString[versionString]
+}
+
+// ByVersion returns the type definition container that matches the specified
+// version. The version may either be a complete version string (e.g. 4.0.1),
+// a version string with only major and minor version (e.g. 4.0, as used by
+// the fhirVersion MIME type parameter) or a symbolic version (e.g. R4). If no
+// such container has been registered, nil is returned.
+func (r *Registry) ByVersion(version string) *common.TypeDefContainer {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	if c := r.byVersionString[version]; c != nil {
+		return c
+	}
+	if c := r.byMajorMinor[version]; c != nil {
+		return c
+	}
+	return r.bySymbolic[strings.ToUpper(version)]
+}
+
+func majorMinorVersion(versionString string) string {
+	parts := strings.SplitN(versionString, ".", 3)
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[0] + "." + parts[1]
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2020-2021, Volker Schmidt (volker@volsch.eu)
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+//
+// 1. Redistributions of source code must retain the above copyright notice, this
+//    list of conditions and the following disclaimer.
+//
+// 2. Redistributions in binary form must reproduce the above copyright notice,
+//    this list of conditions and the following disclaimer in the documentation
+//    and/or other materials provided with the distribution.
+//
+// 3. Neither the name of the copyright holder nor the names of its
+//    contributors may be used to endorse or promote products derived from
+//    this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+// DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+// FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+// DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+// SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+// CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+// OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+package version
+
+import (
+	"github.com/healthiop/hi/internal/r4"
+	"github.com/healthiop/hi/internal/r5"
+	"github.com/healthiop/hi/internal/stu3"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDefaultRegistrySingleton(t *testing.T) {
+	assert.Same(t, DefaultRegistry(), DefaultRegistry(), "registry must be a singleton")
+}
+
+func TestDefaultRegistryContainers(t *testing.T) {
+	containers := DefaultRegistry().Containers()
+	if assert.Len(t, containers, 2) {
+		assert.Same(t, stu3.TypeDefContainer(), containers[0])
+		assert.Same(t, r4.TypeDefContainer(), containers[1])
+	}
+}
+
+func TestRegistryBySymbolicVersion(t *testing.T) {
+	r := DefaultRegistry()
+	assert.Same(t, r4.TypeDefContainer(), r.BySymbolicVersion("R4"))
+	assert.Same(t, stu3.TypeDefContainer(), r.BySymbolicVersion("stu3"))
+	assert.Nil(t, r.BySymbolicVersion("R5"))
+	assert.Nil(t, r.BySymbolicVersion("R2"))
+}
+
+func TestRegistryR5Preview(t *testing.T) {
+	r := NewRegistry(r4.TypeDefContainer(), r5.TypeDefContainer())
+	assert.Same(t, r5.TypeDefContainer(), r.BySymbolicVersion("R5-preview"))
+	assert.Same(t, r5.TypeDefContainer(), r.ByVersion("4.2"))
+	assert.Nil(t, r.BySymbolicVersion("R5"))
+	assert.Nil(t, r.ByVersion("5.0.0"), "R5 preview must not be used for R5 release")
+	assert.Nil(t, r.ByVersion("5.0"), "R5 preview must not be used for R5 release")
+}
+
+func TestRegistryByVersionString(t *testing.T) {
+	r := DefaultRegistry()
+	assert.Same(t, r4.TypeDefContainer(), r.ByVersionString("4.0.1"))
+	assert.Same(t, stu3.TypeDefContainer(), r.ByVersionString("3.0.2"))
+	assert.Nil(t, r.ByVersionString("4.0"))
+}
+
+func TestRegistryByVersion(t *testing.T) {
+	r := DefaultRegistry()
+	assert.Same(t, r4.TypeDefContainer(), r.ByVersion("4.0.1"))
+	assert.Same(t, r4.TypeDefContainer(), r.ByVersion("4.0"))
+	assert.Same(t, r4.TypeDefContainer(), r.ByVersion("R4"))
+	assert.Same(t, stu3.TypeDefContainer(), r.ByVersion("3.0"))
+	assert.Nil(t, r.ByVersion("4.2"), "R5 preview must not be registered by default")
+	assert.Nil(t, r.ByVersion("1.0"))
+}
+
+func TestRegistryRegisterDuplicate(t *testing.T) {
+	r := NewRegistry(r4.TypeDefContainer())
+	err := r.Register(r4.TypeDefContainer())
+	if assert.Error(t, err) {
+		assert.Equal(t, "symbolic version has already been registered: R4", err.Error())
+	}
+	assert.Len(t, r.Containers(), 1)
+}
+
+func TestNewRegistryDuplicate(t *testing.T) {
+	assert.Panics(t, func() {
+		NewRegistry(r4.TypeDefContainer(), r4.TypeDefContainer())
+	})
+}